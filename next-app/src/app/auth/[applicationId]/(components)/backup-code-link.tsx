@@ -0,0 +1,20 @@
+"use client";
+
+import Link from "next/link";
+import { useParams, useSearchParams } from "next/navigation";
+
+// BackupCodeLink lets users who lost their MFA device finish the current
+// sign-in with a backup code instead, keeping the pending challenge.
+export function BackupCodeLink() {
+  const applicationId = useParams().applicationId as string;
+  const searchParams = useSearchParams();
+
+  return (
+    <Link
+      href={`/auth/${applicationId}/mfa-backup-code?${searchParams.toString()}`}
+      className="text-sm text-muted-foreground text-center hover:underline mx-auto"
+    >
+      Lost your device? Use a backup code
+    </Link>
+  );
+}
@@ -29,9 +29,10 @@ import { LoadingSpinner } from "@/components/ui/loading-spinner";
 
 import { ErrorAlert } from "@/components/error-alert";
 
-import { authorizeApi } from "@/services/auth/authorize";
+import { authorizeApi, submitAuthorizeCallback } from "@/services/auth/authorize";
 import { verifyAppMfaApi } from "@/services/auth/verify-app-mfa";
 import { MfaModal } from "../../(components)/mfa-modal";
+import { BackupCodeLink } from "../../(components)/backup-code-link";
 
 export function AuthForm() {
   const applicationId = useParams().applicationId as string;
@@ -51,6 +52,7 @@ export function AuthForm() {
   const userId = searchParams.get("user_id") || "";
   const mfaId = searchParams.get("mfa_id") || "";
   const nonce = searchParams.get("nonce") || "";
+  const requestId = searchParams.get("request_id") || "";
 
   const urlParams = new URLSearchParams({
     redirect_uri: redirectUri,
@@ -63,6 +65,7 @@ export function AuthForm() {
     email,
     mfa_id: mfaId,
     ...(nonce ? { nonce } : {}),
+    ...(requestId ? { request_id: requestId } : {}),
   });
 
   const form = useForm<z.infer<typeof formSchema>>({
@@ -111,6 +114,17 @@ export function AuthForm() {
       return;
     }
 
+    // Started from GET /oauth2/authorize: let the server issue the code
+    // and redirect back to the client.
+    if (requestId) {
+      submitAuthorizeCallback({
+        requestId,
+        sessionCode: verifyMfaData.sessionCode,
+        email: email.trim(),
+      });
+      return;
+    }
+
     const [authorizeData, authorizeErr] = await authorizeApi({
       email: email.trim(),
       sessionCode: verifyMfaData.sessionCode,
@@ -201,6 +215,8 @@ export function AuthForm() {
         </form>
       </Form>
 
+      <BackupCodeLink />
+
       <MfaModal />
     </div>
   );
@@ -0,0 +1,199 @@
+"use client";
+
+import { z } from "zod";
+import { toast } from "sonner";
+import { useState } from "react";
+import { useForm } from "react-hook-form";
+import { useParams, useRouter, useSearchParams } from "next/navigation";
+
+import {
+  FormControl,
+  Form,
+  FormDescription,
+  FormField,
+  FormItem,
+  FormLabel,
+  FormMessage,
+} from "@/components/ui/form";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+
+import { formSchema } from "./auth-schema";
+import { zodResolver } from "@hookform/resolvers/zod";
+import { LoadingSpinner } from "@/components/ui/loading-spinner";
+
+import { ErrorAlert } from "@/components/error-alert";
+
+import { authorizeApi, submitAuthorizeCallback } from "@/services/auth/authorize";
+import { verifyBackupCodeMfaApi } from "@/services/auth/verify-backup-code-mfa";
+
+export function AuthForm() {
+  const applicationId = useParams().applicationId as string;
+  const searchParams = useSearchParams();
+  const router = useRouter();
+
+  const redirectUri = searchParams.get("redirect_uri") || "/";
+  const codeChallengeMethod = searchParams.get("code_challenge_method") || "";
+  const responseType = searchParams.get("response_type") || "";
+  const scope = searchParams.get("scope") || "";
+  const state = searchParams.get("state") || "";
+  const email = searchParams.get("email") || "";
+  const codeChallenge = searchParams.get("code_challenge") || "";
+
+  const changePasswordCode = searchParams.get("change_password_code") || "";
+  const userId = searchParams.get("user_id") || "";
+  const mfaId = searchParams.get("mfa_id") || "";
+  const nonce = searchParams.get("nonce") || "";
+  const requestId = searchParams.get("request_id") || "";
+
+  const urlParams = new URLSearchParams({
+    redirect_uri: redirectUri,
+    response_type: responseType,
+    scope,
+    code_challenge_method: codeChallengeMethod,
+    code_challenge: codeChallenge,
+    state,
+    email,
+    ...(nonce ? { nonce } : {}),
+    ...(requestId ? { request_id: requestId } : {}),
+  });
+
+  const form = useForm<z.infer<typeof formSchema>>({
+    resolver: zodResolver(formSchema),
+    defaultValues: {
+      code: "",
+    },
+  });
+
+  const [isLoading, setIsLoading] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  async function onSubmit(values: z.infer<typeof formSchema>) {
+    setIsLoading(true);
+
+    const [verifyMfaData, verifyMfaErr] = await verifyBackupCodeMfaApi({
+      email: email.trim(),
+      applicationId,
+      code: values.code,
+      mfaId,
+    });
+
+    if (verifyMfaErr) {
+      console.error(verifyMfaErr);
+      setError(verifyMfaErr?.response?.data.message || "An error occurred");
+      setIsLoading(false);
+      setTimeout(() => setError(null), 6000);
+      return;
+    }
+
+    if (!verifyMfaData) {
+      setError("An error occurred");
+      setIsLoading(false);
+      setTimeout(() => setError(null), 6000);
+      return;
+    }
+
+    if (verifyMfaData.warning) {
+      toast.warning(verifyMfaData.warning);
+    }
+
+    if (changePasswordCode && userId) {
+      urlParams.append("session_code", verifyMfaData.sessionCode);
+      urlParams.append("change_password_code", changePasswordCode);
+      urlParams.append("user_id", userId);
+
+      router.push(
+        `/auth/${applicationId}/update-password?${urlParams.toString()}`,
+      );
+      return;
+    }
+
+    // Started from GET /oauth2/authorize: let the server issue the code
+    // and redirect back to the client.
+    if (requestId) {
+      submitAuthorizeCallback({
+        requestId,
+        sessionCode: verifyMfaData.sessionCode,
+        email: email.trim(),
+      });
+      return;
+    }
+
+    const [authorizeData, authorizeErr] = await authorizeApi({
+      email: email.trim(),
+      sessionCode: verifyMfaData.sessionCode,
+      applicationId,
+      redirectUri,
+      responseType,
+      scope,
+      codeChallengeMethod,
+      codeChallenge,
+      state,
+      nonce: nonce || undefined,
+    });
+
+    if (authorizeErr) {
+      console.error(authorizeErr);
+      setError(authorizeErr?.response?.data.message || "An error occurred");
+      setIsLoading(false);
+      setTimeout(() => setError(null), 6000);
+      return;
+    }
+
+    if (!authorizeData) {
+      setError("An error occurred");
+      setIsLoading(false);
+      setTimeout(() => setError(null), 6000);
+      return;
+    }
+
+    setIsLoading(false);
+
+    toast.success("You have successfully signed in");
+
+    window.location.href = `${redirectUri}?code=${authorizeData.authorizationCode}&state=${state}&redirect_uri=${redirectUri}&client_id=${applicationId}`;
+  }
+
+  return (
+    <div className="grid gap-4">
+      {error && <ErrorAlert message={error} title="An error occurred..." />}
+
+      <Form {...form}>
+        <form onSubmit={form.handleSubmit(onSubmit)} className="space-y-3">
+          <FormField
+            control={form.control}
+            name="code"
+            render={({ field }) => (
+              <FormItem>
+                <FormLabel>Backup code</FormLabel>
+                <FormControl>
+                  <Input
+                    placeholder="XXXX-XXXX"
+                    autoComplete="one-time-code"
+                    autoCapitalize="characters"
+                    spellCheck={false}
+                    {...field}
+                  />
+                </FormControl>
+
+                <FormDescription>
+                  Each backup code can only be used once.
+                </FormDescription>
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+
+          <Button
+            type="submit"
+            disabled={isLoading}
+            className="w-full relative"
+          >
+            {isLoading && <LoadingSpinner className="absolute left-4" />}
+            Confirm Code
+          </Button>
+        </form>
+      </Form>
+    </div>
+  );
+}
@@ -0,0 +1,8 @@
+import { z } from "zod";
+
+export const formSchema = z.object({
+  code: z
+    .string()
+    .trim()
+    .regex(/^[A-Za-z0-9]{4}-?[A-Za-z0-9]{4}$/, "Enter one of your backup codes"),
+});
@@ -0,0 +1,24 @@
+import { Skeleton } from "@/components/ui/skeleton";
+import { Background } from "../(components)/background";
+
+export default function LoadingBackupCodePage() {
+  return (
+    <Background application={null} page="one-time-password">
+      <div className="flex flex-col space-y-2 text-center">
+        <h1 className="text-2xl font-semibold tracking-tight">
+          Use a Backup Code
+        </h1>
+
+        <p className="text-muted-foreground text-sm">
+          Enter one of the backup codes you saved when setting up two factor
+          authentication
+        </p>
+      </div>
+
+      <div className="flex flex-col gap-3">
+        <Skeleton className="w-full h-[2.25rem]" />
+        <Skeleton className="w-full h-[2.25rem]" />
+      </div>
+    </Background>
+  );
+}
@@ -0,0 +1,37 @@
+import { AuthForm } from "./(components)/auth-form";
+import { Background } from "../(components)/background";
+import { ErrorAlert } from "@/components/error-alert";
+
+import { getApplicationAuthDataService } from "@/services/auth/get-application-auth-data";
+
+type Props = {
+  params: Promise<{ applicationId: string }>;
+};
+
+export default async function BackupCodePage({ params }: Props) {
+  const { applicationId } = await params;
+
+  const [application, err] = await getApplicationAuthDataService({
+    applicationId,
+  });
+
+  return (
+    <Background application={application} page="one-time-password">
+      <div className="flex flex-col space-y-2 text-center">
+        <h1 className="text-2xl font-semibold tracking-tight">
+          Use a Backup Code
+        </h1>
+        <p className="text-muted-foreground text-sm">
+          Enter one of the backup codes you saved when setting up two factor
+          authentication
+        </p>
+      </div>
+
+      {err ? (
+        <ErrorAlert message={err.message} title="An error occurred..." />
+      ) : (
+        <AuthForm />
+      )}
+    </Background>
+  );
+}
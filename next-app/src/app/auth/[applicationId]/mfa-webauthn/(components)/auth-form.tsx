@@ -9,8 +9,9 @@ import { Button } from "@/components/ui/button";
 import { LoadingSpinner } from "@/components/ui/loading-spinner";
 import { ErrorAlert } from "@/components/error-alert";
 
-import { authorizeApi } from "@/services/auth/authorize";
+import { authorizeApi, submitAuthorizeCallback } from "@/services/auth/authorize";
 import { verifyWebAuthnMfaApi } from "@/services/auth/verify-webauthn-mfa";
+import { BackupCodeLink } from "../../(components)/backup-code-link";
 
 export function AuthForm() {
   const applicationId = useParams().applicationId as string;
@@ -26,6 +27,7 @@ export function AuthForm() {
   const codeChallenge = searchParams.get("code_challenge") || "";
   const mfaId = searchParams.get("mfa_id") || "";
   const nonce = searchParams.get("nonce") || "";
+  const requestId = searchParams.get("request_id") || "";
 
   const [isLoading, setIsLoading] = useState(false);
   const [error, setError] = useState<string | null>(null);
@@ -104,6 +106,17 @@ export function AuthForm() {
 
     sessionStorage.removeItem(`webauthn_options_${mfaId}`);
 
+    // Started from GET /oauth2/authorize: let the server issue the code
+    // and redirect back to the client.
+    if (requestId) {
+      submitAuthorizeCallback({
+        requestId,
+        sessionCode: verifyData.sessionCode,
+        email: email.trim(),
+      });
+      return;
+    }
+
     const [authorizeData, authorizeErr] = await authorizeApi({
       email: email.trim(),
       sessionCode: verifyData.sessionCode,
@@ -141,6 +154,7 @@ export function AuthForm() {
     mfaId,
     nonce,
     redirectUri,
+    requestId,
     responseType,
     scope,
     searchParams,
@@ -163,6 +177,7 @@ export function AuthForm() {
       code_challenge: codeChallenge,
       state,
       ...(nonce ? { nonce } : {}),
+      ...(requestId ? { request_id: requestId } : {}),
     });
     router.push(`/auth/${applicationId}/sign-in?${urlParams.toString()}`);
   }
@@ -201,6 +216,7 @@ export function AuthForm() {
             >
               Sign in again
             </Button>
+            <BackupCodeLink />
           </>
         )}
       </div>
@@ -26,7 +26,7 @@ import { GoogleLogo } from "@/app/dashboard/[tenantId]/application/[applicationI
 import { formSchema } from "./auth-schema";
 import { zodResolver } from "@hookform/resolvers/zod";
 
-import { authorizeApi } from "@/services/auth/authorize";
+import { authorizeApi, submitAuthorizeCallback } from "@/services/auth/authorize";
 import { ApplicationAuthData } from "@/services/auth/get-application-auth-data";
 
 import { ErrorAlert } from "@/components/error-alert";
@@ -50,6 +50,7 @@ export function AuthForm({ application }: Props) {
   const state = searchParams.get("state") || "";
   const codeChallenge = searchParams.get("code_challenge") || "";
   const nonce = searchParams.get("nonce") || "";
+  const requestId = searchParams.get("request_id") || "";
 
   const form = useForm<z.infer<typeof formSchema>>({
     resolver: zodResolver(formSchema),
@@ -70,6 +71,7 @@ export function AuthForm({ application }: Props) {
     code_challenge: codeChallenge,
     state,
     ...(nonce ? { nonce } : {}),
+    ...(requestId ? { request_id: requestId } : {}),
   });
 
   async function onSubmit(values: z.infer<typeof formSchema>) {
@@ -164,6 +166,17 @@ export function AuthForm({ application }: Props) {
       return;
     }
 
+    // Started from GET /oauth2/authorize: let the server issue the code
+    // and redirect back to the client.
+    if (requestId) {
+      submitAuthorizeCallback({
+        requestId,
+        sessionCode: loginData.sessionCode,
+        email: values.email.trim(),
+      });
+      return;
+    }
+
     const [authorizeData, authorizeErr] = await authorizeApi({
       email: values.email.trim(),
       sessionCode: loginData.sessionCode,
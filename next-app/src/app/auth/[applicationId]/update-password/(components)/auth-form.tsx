@@ -24,7 +24,7 @@ import { zodResolver } from "@hookform/resolvers/zod";
 import { changePasswordApi } from "@/services/auth/change-password";
 
 import { ErrorAlert } from "@/components/error-alert";
-import { authorizeApi } from "@/services/auth/authorize";
+import { authorizeApi, submitAuthorizeCallback } from "@/services/auth/authorize";
 
 export function AuthForm() {
   const applicationId = useParams().applicationId as string;
@@ -38,6 +38,7 @@ export function AuthForm() {
   const email = searchParams.get("email") || "";
   const codeChallenge = searchParams.get("code_challenge") || "";
   const sessionCode = searchParams.get("session_code") || "";
+  const requestId = searchParams.get("request_id") || "";
 
   const changePasswordCode = searchParams.get("change_password_code") || "";
   const userId = searchParams.get("user_id") || "";
@@ -88,6 +89,17 @@ export function AuthForm() {
       return;
     }
 
+    // Started from GET /oauth2/authorize: let the server issue the code
+    // and redirect back to the client.
+    if (requestId) {
+      submitAuthorizeCallback({
+        requestId,
+        sessionCode: sessionCode,
+        email: email.trim(),
+      });
+      return;
+    }
+
     const [authorizeData, authorizeErr] = await authorizeApi({
       email: email.trim(),
       sessionCode: sessionCode,
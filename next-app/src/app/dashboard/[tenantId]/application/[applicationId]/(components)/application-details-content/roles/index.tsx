@@ -7,7 +7,10 @@ import { ErrorAlert } from "@/components/error-alert";
 import { SectionTitle } from "@/components/section-title";
 
 import { RolesTable, RoleTableItem } from "./roles-table";
-import { useApplicationRolesSWR } from "@/services/dashboard/use-application-roles-swr";
+import {
+  revalidateApplicationRoles,
+  useApplicationRolesSWR,
+} from "@/services/dashboard/use-application-roles-swr";
 import { useApplicationContext } from "../../../(contexts)/application-context-provider";
 import { useTenantsContext } from "@/app/dashboard/(contexts)/tenants-context-provider";
 
@@ -20,10 +23,13 @@ export function Roles() {
     pageSize: 10,
   });
 
+  const tenantId = selectedTenant?.id || "";
+  const applicationId = application?.id || "";
+
   const { data, error, isLoading, mutate } = useApplicationRolesSWR(
     {
-      tenantId: selectedTenant?.id || "",
-      applicationId: application?.id || "",
+      tenantId,
+      applicationId,
       page: pagination.pageIndex + 1,
       pageSize: pagination.pageSize,
     },
@@ -57,23 +63,36 @@ export function Roles() {
         totalCount={data?.totalCount || 0}
         pagination={pagination}
         onPaginationChange={setPagination}
-        setItems={(items: RoleTableItem[]) =>
-          mutate(data ? { ...data, data: items } : undefined, {
-            revalidate: false,
-          })
-        }
-        addRole={(role: RoleTableItem) =>
+        setItems={(items: RoleTableItem[]) => {
+          const removedCount = (data?.data.length ?? 0) - items.length;
+
           mutate(
             data
               ? {
                   ...data,
-                  data: [role, ...data.data],
+                  data: items,
+                  totalCount: data.totalCount - removedCount,
+                }
+              : undefined,
+            { revalidate: false },
+          );
+
+          revalidateApplicationRoles(tenantId, applicationId);
+        }}
+        addRole={(role: RoleTableItem) => {
+          mutate(
+            data
+              ? {
+                  ...data,
+                  data: [...data.data, role],
                   totalCount: data.totalCount + 1,
                 }
               : undefined,
             { revalidate: false },
-          )
-        }
+          );
+
+          revalidateApplicationRoles(tenantId, applicationId);
+        }}
         isLoading={isLoading}
       />
     </section>
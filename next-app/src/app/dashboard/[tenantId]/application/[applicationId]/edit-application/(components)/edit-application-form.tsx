@@ -51,6 +51,8 @@ export function EditApplicationForm({ application }: Props) {
       canSelfForgotPass: application?.canSelfForgotPass ?? false,
       canSelfSignUp: application?.canSelfSignUp ?? false,
       refreshTokenTtlDays: application?.refreshTokenTtlDays ?? 7,
+      redirectUris: application?.redirectUris ?? [],
+      postLogoutRedirectUris: application?.postLogoutRedirectUris ?? [],
     },
   });
 
@@ -401,6 +403,69 @@ export function EditApplicationForm({ application }: Props) {
             />
           </div>
 
+          <Separator className="my-2" />
+
+          <div className="flex flex-col gap-3">
+            <span className="text-sm font-medium">Redirect URIs</span>
+
+            <span className="text-muted-foreground text-sm">
+              Sign-in responses and logouts are only sent back to these URIs,
+              which must match exactly.
+            </span>
+
+            <FormField
+              control={form.control}
+              name="redirectUris"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>Sign-in redirect URIs</FormLabel>
+                  <FormControl>
+                    <MultiSelectInput
+                      items={field.value}
+                      placeholder="https://app.example.com/callback"
+                      onChange={(values: string[]) =>
+                        form.setValue("redirectUris", values, {
+                          shouldValidate: true,
+                        })
+                      }
+                    />
+                  </FormControl>
+
+                  <FormDescription>
+                    Absolute https URIs, loopback http URIs or private-use
+                    scheme URIs, without fragment. Sign-in fails for any other
+                    URI.
+                  </FormDescription>
+                  <FormMessage></FormMessage>
+                </FormItem>
+              )}
+            />
+
+            <FormField
+              control={form.control}
+              name="postLogoutRedirectUris"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>Post-logout redirect URIs</FormLabel>
+                  <FormControl>
+                    <MultiSelectInput
+                      items={field.value}
+                      placeholder="https://app.example.com/signed-out"
+                      onChange={(values: string[]) =>
+                        form.setValue("postLogoutRedirectUris", values, {
+                          shouldValidate: true,
+                        })
+                      }
+                    />
+                  </FormControl>
+
+                  <FormDescription></FormDescription>
+                  <FormMessage></FormMessage>
+                </FormItem>
+              )}
+            />
+          </div>
+
           <Button type="submit" disabled={isLoading} className="ml-auto w-fit">
             {isLoading ? "Saving changes..." : "Update Application"}
           </Button>
@@ -15,4 +15,11 @@ export const formSchema = z.object({
   canSelfSignUp: z.boolean(),
   canSelfForgotPass: z.boolean(),
   refreshTokenTtlDays: z.number().min(1).max(365),
+
+  redirectUris: z
+    .array(z.string().url("Redirect URIs must be absolute URIs."))
+    .max(20, "At most 20 redirect URIs can be registered."),
+  postLogoutRedirectUris: z
+    .array(z.string().url("Redirect URIs must be absolute URIs."))
+    .max(20, "At most 20 redirect URIs can be registered."),
 });
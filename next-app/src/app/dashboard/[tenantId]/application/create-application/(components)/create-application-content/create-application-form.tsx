@@ -53,6 +53,8 @@ export function CreateApplicationForm() {
       hasMfaEmail: false,
       canSelfForgotPass: false,
       canSelfSignUp: false,
+      redirectUris: [],
+      postLogoutRedirectUris: [],
     },
   });
 
@@ -359,6 +361,69 @@ export function CreateApplicationForm() {
             />
           </div>
 
+          <Separator className="my-2" />
+
+          <div className="flex flex-col gap-3">
+            <span className="text-sm font-medium">Redirect URIs</span>
+
+            <span className="text-muted-foreground text-sm">
+              Sign-in responses and logouts are only sent back to these URIs,
+              which must match exactly.
+            </span>
+
+            <FormField
+              control={form.control}
+              name="redirectUris"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>Sign-in redirect URIs</FormLabel>
+                  <FormControl>
+                    <MultiSelectInput
+                      items={field.value}
+                      placeholder="https://app.example.com/callback"
+                      onChange={(values: string[]) =>
+                        form.setValue("redirectUris", values, {
+                          shouldValidate: true,
+                        })
+                      }
+                    />
+                  </FormControl>
+
+                  <FormDescription>
+                    Absolute https URIs, loopback http URIs or private-use
+                    scheme URIs, without fragment. Sign-in fails for any other
+                    URI.
+                  </FormDescription>
+                  <FormMessage></FormMessage>
+                </FormItem>
+              )}
+            />
+
+            <FormField
+              control={form.control}
+              name="postLogoutRedirectUris"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>Post-logout redirect URIs</FormLabel>
+                  <FormControl>
+                    <MultiSelectInput
+                      items={field.value}
+                      placeholder="https://app.example.com/signed-out"
+                      onChange={(values: string[]) =>
+                        form.setValue("postLogoutRedirectUris", values, {
+                          shouldValidate: true,
+                        })
+                      }
+                    />
+                  </FormControl>
+
+                  <FormDescription></FormDescription>
+                  <FormMessage></FormMessage>
+                </FormItem>
+              )}
+            />
+          </div>
+
           <Button type="submit" disabled={isLoading} className="ml-auto w-fit">
             {isLoading ? "Creating Application..." : "Create Application"}
           </Button>
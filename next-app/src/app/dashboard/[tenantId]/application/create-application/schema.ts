@@ -19,4 +19,11 @@ export const formSchema = z.object({
   hasMfaWebauthn: z.boolean(),
   canSelfSignUp: z.boolean(),
   canSelfForgotPass: z.boolean(),
+
+  redirectUris: z
+    .array(z.string().url("Redirect URIs must be absolute URIs."))
+    .max(20, "At most 20 redirect URIs can be registered."),
+  postLogoutRedirectUris: z
+    .array(z.string().url("Redirect URIs must be absolute URIs."))
+    .max(20, "At most 20 redirect URIs can be registered."),
 });
@@ -56,3 +56,40 @@ export async function authorizeApi({
     return [null, error as APIError];
   }
 }
+
+type CallbackRequest = {
+  requestId: string;
+  sessionCode: string;
+  email: string;
+};
+
+// Finishes an authorization started with GET /oauth2/authorize. The session
+// code is posted rather than put in the URL, so it never lands in the browser
+// history or access logs. The server issues the code and redirects the
+// browser back to the client.
+export function submitAuthorizeCallback({
+  requestId,
+  sessionCode,
+  email,
+}: CallbackRequest): void {
+  const form = document.createElement("form");
+  form.method = "POST";
+  form.action = `${process.env.NEXT_PUBLIC_BASE_API_URL}/oauth2/authorize/callback`;
+
+  const fields = {
+    request_id: requestId,
+    session_code: sessionCode,
+    email,
+  };
+
+  for (const [name, value] of Object.entries(fields)) {
+    const input = document.createElement("input");
+    input.type = "hidden";
+    input.name = name;
+    input.value = value;
+    form.appendChild(input);
+  }
+
+  document.body.appendChild(form);
+  form.submit();
+}
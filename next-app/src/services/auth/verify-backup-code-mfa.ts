@@ -0,0 +1,37 @@
+import { api } from "../base/gatekeeper-api";
+import { APIError, Result } from "@/types/service-options";
+
+type Request = {
+  email: string;
+  code: string;
+  mfaId: string;
+  applicationId: string;
+};
+
+type Response = {
+  sessionCode: string;
+  remainingBackupCodes: number;
+  warning?: string;
+};
+
+export async function verifyBackupCodeMfaApi({
+  email,
+  code,
+  mfaId,
+  applicationId,
+}: Request): Promise<Result<Response, APIError>> {
+  try {
+    const { data } = await api.post<Response>(
+      `/v1/auth/verify-mfa/backup-code`,
+      {
+        email,
+        code,
+        mfaId,
+        applicationId,
+      },
+    );
+    return [data, null];
+  } catch (error: unknown) {
+    return [null, error as APIError];
+  }
+}
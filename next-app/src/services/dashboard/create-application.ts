@@ -12,6 +12,8 @@ type Request = {
   tenantId: string;
   canSelfSignUp: boolean;
   canSelfForgotPass: boolean;
+  redirectUris: string[];
+  postLogoutRedirectUris: string[];
 };
 
 type Response = {
@@ -39,6 +41,8 @@ export async function createApplicationApi(
     tenantId,
     canSelfForgotPass,
     canSelfSignUp,
+    redirectUris,
+    postLogoutRedirectUris,
   }: Request,
   { accessToken }: IServiceOptions,
 ): Promise<Result<Response, APIError>> {
@@ -56,6 +60,8 @@ export async function createApplicationApi(
         tenantId,
         canSelfForgotPass,
         canSelfSignUp,
+        redirectUris,
+        postLogoutRedirectUris,
       },
       {
         headers: {
@@ -13,6 +13,8 @@ type Request = {
   canSelfSignUp: boolean;
   canSelfForgotPass: boolean;
   refreshTokenTtlDays: number;
+  redirectUris: string[];
+  postLogoutRedirectUris: string[];
 };
 
 type Response = {
@@ -41,6 +43,8 @@ export async function editApplicationApi(
     canSelfForgotPass,
     canSelfSignUp,
     refreshTokenTtlDays,
+    redirectUris,
+    postLogoutRedirectUris,
   }: Request,
   { accessToken }: IServiceOptions,
 ): Promise<Result<Response, APIError>> {
@@ -59,6 +63,8 @@ export async function editApplicationApi(
         canSelfForgotPass,
         canSelfSignUp,
         refreshTokenTtlDays,
+        redirectUris,
+        postLogoutRedirectUris,
       },
       {
         headers: {
@@ -14,8 +14,9 @@ export interface IApplication {
   canSelfForgotPass: boolean;
   mfaAuthAppEnabled: boolean;
   mfaWebauthnEnabled: boolean;
-  passwordHashingSecret: string;
   refreshTokenTtlDays: number;
+  redirectUris: string[];
+  postLogoutRedirectUris: string[];
   secrets: {
     id: string;
     name: string;
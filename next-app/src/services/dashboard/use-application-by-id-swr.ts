@@ -16,7 +16,6 @@ export interface IApplication {
   canSelfForgotPass: boolean;
   mfaAuthAppEnabled: boolean;
   mfaWebauthnEnabled: boolean;
-  passwordHashingSecret: string;
   refreshTokenTtlDays: number;
   secrets: {
     id: string;
@@ -1,4 +1,4 @@
-import useSWR from "swr";
+import useSWR, { mutate } from "swr";
 
 import { api } from "../base/gatekeeper-api";
 
@@ -33,6 +33,25 @@ const fetcher = (url: string, options: IServiceOptions) =>
     })
     .then((res) => res.data);
 
+function rolesKeyPrefix(tenantId: string, applicationId: string) {
+  return `/v1/tenants/${tenantId}/applications/${applicationId}/roles`;
+}
+
+/**
+ * Refetches every cached page of an application's roles, including the
+ * role pickers of the user forms, after a role was added or deleted.
+ */
+export function revalidateApplicationRoles(
+  tenantId: string,
+  applicationId: string,
+) {
+  const prefix = rolesKeyPrefix(tenantId, applicationId);
+
+  return mutate(
+    (key) => typeof key === "string" && key.startsWith(`${prefix}?`),
+  );
+}
+
 export function useApplicationRolesSWR(
   request: Request,
   options: IServiceOptions,
@@ -42,7 +61,7 @@ export function useApplicationRolesSWR(
 
   return useSWR(
     request?.tenantId
-      ? `/v1/tenants/${request?.tenantId}/applications/${request?.applicationId}/roles?page=${page}&pageSize=${pageSize}`
+      ? `${rolesKeyPrefix(request.tenantId, request.applicationId)}?page=${page}&pageSize=${pageSize}`
       : null,
     (url) => fetcher(url, options),
     {
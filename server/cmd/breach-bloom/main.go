@@ -0,0 +1,73 @@
+// Command breach-bloom builds the bloom filter read through
+// BREACHED_PASSWORDS_BLOOM_FILE from a directory of HIBP range files.
+//
+//	go run ./cmd/breach-bloom -ranges ./pwnedpasswords -min-count 10 -out breached.bloom
+package main
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"flag"
+	"log/slog"
+	"os"
+
+	"github.com/gate-keeper/internal/infra/breach"
+)
+
+func main() {
+	rangeDir := flag.String("ranges", "", "directory of HIBP range files")
+	out := flag.String("out", "breached-passwords.bloom", "file to write the bloom filter to")
+	minCount := flag.Int("min-count", 1, "only include passwords seen in at least this many breaches")
+	falsePositiveRate := flag.Float64("fp-rate", 0.001, "share of unbreached passwords the filter may reject")
+	flag.Parse()
+
+	if *rangeDir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ranges, err := breach.OpenRangeDirectory(*rangeDir)
+	if err != nil {
+		panic(err)
+	}
+
+	// The filter is sized from the number of entries, so the dataset is read
+	// twice rather than held in memory.
+	var entries uint64
+	err = ranges.Walk(func(_ [sha1.Size]byte, occurrences int) error {
+		if occurrences >= *minCount {
+			entries++
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	filter := breach.NewBloomFilter(entries, *falsePositiveRate)
+	err = ranges.Walk(func(digest [sha1.Size]byte, occurrences int) error {
+		if occurrences >= *minCount {
+			filter.Add(digest)
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	file, err := os.Create(*out)
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	if err := breach.WriteBloomFilter(w, filter); err != nil {
+		panic(err)
+	}
+	if err := w.Flush(); err != nil {
+		panic(err)
+	}
+
+	slog.Info("Bloom filter written", "path", *out, "entries", entries)
+}
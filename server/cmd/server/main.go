@@ -1,11 +1,19 @@
 package main
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
+	"time"
 
 	_ "github.com/gate-keeper/cmd/server/docs"
+	"github.com/gate-keeper/internal/infra/auditlog"
+	"github.com/gate-keeper/internal/infra/breach"
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database"
+	"github.com/gate-keeper/internal/infra/ratelimit"
+	"github.com/gate-keeper/internal/infra/revocation"
+	"github.com/gate-keeper/internal/infra/signing"
 	"github.com/gate-keeper/internal/presentation/http/routing"
 	"github.com/joho/godotenv"
 )
@@ -26,6 +34,14 @@ func main() {
 		panic(err)
 	}
 
+	keySet, err := signing.LoadFromEnv()
+
+	if err != nil {
+		panic(err)
+	}
+
+	signing.SetProvider(keySet)
+
 	pool, err := database.NewConnectionPool()
 
 	if err != nil {
@@ -34,7 +50,68 @@ func main() {
 
 	defer pool.Close()
 
-	router := routing.SetHttpRoutes(pool)
+	if err := database.SeedPlatformAdmins(context.Background(), pool); err != nil {
+		panic(err)
+	}
+
+	if err := database.WarnApplicationsWithoutRedirectURIs(context.Background(), pool); err != nil {
+		panic(err)
+	}
+
+	if err := database.FailInterruptedUserImports(context.Background(), pool); err != nil {
+		panic(err)
+	}
+
+	if policy := signing.PolicyFromEnv(); policy.Enabled {
+		provider := signing.NewDatabaseKeyProvider(pool, keySet)
+		scheduler := signing.NewScheduler(pool, provider, policy, time.Minute)
+
+		if err := scheduler.Start(context.Background()); err != nil {
+			panic(err)
+		}
+
+		signing.SetProvider(provider)
+		slog.Info("🔑 Signing key rotation enabled", "interval", policy.Interval, "overlap", policy.Overlap)
+	}
+
+	if interval := auditlog.CheckpointIntervalFromEnv(); interval > 0 {
+		scheduler := auditlog.NewCheckpointScheduler(pool, interval)
+
+		if err := scheduler.Start(context.Background()); err != nil {
+			panic(err)
+		}
+
+		slog.Info("🔏 Audit log checkpoints enabled", "interval", interval)
+	}
+
+	revocation.SetDenylist(revocation.NewDatabaseDenylist(pool))
+
+	corpus, err := breach.LoadFromEnv()
+
+	if err != nil {
+		panic(err)
+	}
+
+	if corpus != nil {
+		breach.SetCorpus(corpus)
+		slog.Info("🛡️ Breached password dataset loaded")
+	}
+
+	trustedProxies, err := clientip.TrustedProxiesFromEnv()
+
+	if err != nil {
+		panic(err)
+	}
+
+	clientip.SetTrustedProxies(trustedProxies)
+
+	rateLimitStore, err := ratelimit.StoreFromEnv(context.Background())
+
+	if err != nil {
+		panic(err)
+	}
+
+	router := routing.SetHttpRoutes(pool, rateLimitStore)
 
 	slog.Info("✅ Server is running on port 8080")
 
@@ -0,0 +1,17 @@
+package constants
+
+import "time"
+
+// Defaults for the tenant account lockout policy.
+const (
+	DefaultLockoutThreshold   = 10 // failed logins before the account is locked, 0 disables lockout
+	DefaultLockoutDuration    = 5 * time.Minute
+	DefaultLockoutMaxDuration = 24 * time.Hour
+)
+
+// Failed logins below the lockout threshold slow down the next attempt by a
+// delay that doubles with every failure, starting after the second one.
+const (
+	FailedLoginBaseDelay = time.Second
+	FailedLoginMaxDelay  = 30 * time.Second
+)
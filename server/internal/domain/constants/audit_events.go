@@ -12,4 +12,37 @@ const (
 	AuditEventBackupCodesGenerated = "BACKUP_CODES_GENERATED"
 	AuditEventBackupCodeUsed       = "BACKUP_CODE_USED"
 	AuditEventReauthSuccess        = "REAUTH_SUCCESS"
+	AuditEventAccountLocked        = "ACCOUNT_LOCKED"
+	AuditEventAccountUnlocked      = "ACCOUNT_UNLOCKED"
+
+	// Authentication
+	AuditEventLoginSucceeded        = "LOGIN_SUCCEEDED"
+	AuditEventLoginFailed           = "LOGIN_FAILED"
+	AuditEventMfaChallengeIssued    = "MFA_CHALLENGE_ISSUED"
+	AuditEventMfaChallengeSucceeded = "MFA_CHALLENGE_SUCCEEDED"
+	AuditEventMfaChallengeFailed    = "MFA_CHALLENGE_FAILED"
+	AuditEventTokenIssued           = "TOKEN_ISSUED"
+	AuditEventRefreshTokenReused    = "REFRESH_TOKEN_REUSED"
+	AuditEventUserSignedUp          = "USER_SIGNED_UP"
+	AuditEventPasswordResetRequest  = "PASSWORD_RESET_REQUESTED"
+	AuditEventPasswordReset         = "PASSWORD_RESET"
+	AuditEventOAuthAccountLinked    = "OAUTH_ACCOUNT_LINKED"
+
+	// Tenant administration
+	AuditEventTenantCreated            = "TENANT_CREATED"
+	AuditEventTenantUpdated            = "TENANT_UPDATED"
+	AuditEventTenantDeleted            = "TENANT_DELETED"
+	AuditEventApplicationCreated       = "APPLICATION_CREATED"
+	AuditEventApplicationUpdated       = "APPLICATION_UPDATED"
+	AuditEventApplicationDeleted       = "APPLICATION_DELETED"
+	AuditEventApplicationSecretCreated = "APPLICATION_SECRET_CREATED"
+	AuditEventApplicationSecretDeleted = "APPLICATION_SECRET_DELETED"
+	AuditEventUserCreated              = "USER_CREATED"
+	AuditEventUserUpdated              = "USER_UPDATED"
+	AuditEventUserDeleted              = "USER_DELETED"
+)
+
+const (
+	AuditLogFormatCSV    = "csv"
+	AuditLogFormatNDJSON = "ndjson"
 )
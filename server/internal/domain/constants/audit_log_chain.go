@@ -0,0 +1,11 @@
+package constants
+
+// Reasons a tenant's audit log chain fails verification
+const (
+	AuditLogChainHashMismatch         = "hash_mismatch"          // the entry's content doesn't match its hash
+	AuditLogChainPreviousHashMismatch = "previous_hash_mismatch" // the entry doesn't link to the one before it
+	AuditLogChainSequenceGap          = "sequence_gap"           // entries before this one are missing
+	AuditLogChainCheckpointMismatch   = "checkpoint_mismatch"    // the entry differs from a signed checkpoint
+	AuditLogChainMissingEntries       = "missing_entries"        // the chain ends before its head or last checkpoint
+	AuditLogChainHeadMismatch         = "head_mismatch"          // the chain doesn't end at its head
+)
@@ -0,0 +1,12 @@
+package constants
+
+const (
+	ScopeOpenID        = "openid"
+	ScopeProfile       = "profile"
+	ScopeEmail         = "email"
+	ScopeOfflineAccess = "offline_access"
+)
+
+// SupportedScopes are the scopes clients may request on behalf of a user, as
+// published in the OpenID Connect discovery document.
+var SupportedScopes = []string{ScopeOpenID, ScopeProfile, ScopeEmail, ScopeOfflineAccess}
@@ -1,6 +1,9 @@
 package constants
 
 const (
-	UserCredentialsPasswordAlgorithmBcrypt  = "bcrypt"
-	UserCredentialsPasswordAlgorithmArgon2i = "argon2i"
+	UserCredentialsPasswordAlgorithmArgon2id       = "argon2id"
+	UserCredentialsPasswordAlgorithmBcrypt         = "bcrypt"
+	UserCredentialsPasswordAlgorithmScrypt         = "scrypt"
+	UserCredentialsPasswordAlgorithmPbkdf2Sha256   = "pbkdf2-sha256"
+	UserCredentialsPasswordAlgorithmFirebaseScrypt = "firebase-scrypt"
 )
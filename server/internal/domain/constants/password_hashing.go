@@ -0,0 +1,17 @@
+package constants
+
+// Argon2id cost parameters used for tenants that don't configure their own.
+const (
+	DefaultPasswordHashMemory      = 64 * 1024 // KiB
+	DefaultPasswordHashIterations  = 3
+	DefaultPasswordHashParallelism = 4
+)
+
+// Upper bounds on the Argon2id cost parameters of hashes that are verified,
+// matching what tenants can configure. Imported hashes above them are
+// rejected so a single sign-in can't exhaust the server.
+const (
+	MaxPasswordHashMemory      = 256 * 1024 // KiB
+	MaxPasswordHashIterations  = 10
+	MaxPasswordHashParallelism = 16
+)
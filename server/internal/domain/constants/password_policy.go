@@ -0,0 +1,24 @@
+package constants
+
+// Defaults and bounds for the tenant password policy.
+const (
+	DefaultPasswordMinLength = 8
+	MaxPasswordLength        = 128 // applies to every tenant
+	MaxPasswordHistoryCount  = 24
+
+	// DefaultBreachedPasswordThreshold rejects a password found in a single
+	// breach once the breached password check is enabled.
+	DefaultBreachedPasswordThreshold = 1
+)
+
+// Codes reported for each rule a password breaks.
+const (
+	PasswordViolationTooShort       = "too_short"
+	PasswordViolationTooLong        = "too_long"
+	PasswordViolationNoUppercase    = "missing_uppercase"
+	PasswordViolationNoLowercase    = "missing_lowercase"
+	PasswordViolationNoDigit        = "missing_digit"
+	PasswordViolationNoSymbol       = "missing_symbol"
+	PasswordViolationForbiddenWord  = "forbidden_word"
+	PasswordViolationRecentlyReused = "recently_used"
+)
@@ -0,0 +1,6 @@
+package constants
+
+const (
+	RedirectURIKindLogin      = "login"       // where authorization responses are sent
+	RedirectURIKindPostLogout = "post_logout" // where RP-initiated logout may return
+)
@@ -0,0 +1,8 @@
+package constants
+
+const (
+	SigningKeyStatusNext    = "next"    // published in JWKS, not yet used to sign
+	SigningKeyStatusActive  = "active"  // signs every new token of the tenant
+	SigningKeyStatusRetired = "retired" // still published until its tokens expire
+	SigningKeyStatusRevoked = "revoked" // withdrawn immediately (emergency rotation)
+)
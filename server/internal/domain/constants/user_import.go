@@ -0,0 +1,20 @@
+package constants
+
+import "time"
+
+const (
+	UserImportStatusPending   = "pending"   // accepted, waiting to be processed
+	UserImportStatusRunning   = "running"   // rows are being validated or imported
+	UserImportStatusCompleted = "completed" // every row was processed; see the per-row errors
+	UserImportStatusFailed    = "failed"    // processing stopped before the end of the file
+)
+
+const (
+	UserImportHeartbeatInterval = 30 * time.Second // how often a running import refreshes its heartbeat
+	UserImportHeartbeatTimeout  = 2 * time.Minute  // after which an import nobody refreshes is considered interrupted
+)
+
+const (
+	UserTransferFormatCSV   = "csv"
+	UserTransferFormatJSONL = "jsonl"
+)
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlatformAdmin is a user allowed to manage every tenant, including creating
+// and removing tenants.
+type PlatformAdmin struct {
+	UserID    uuid.UUID
+	CreatedAt time.Time
+}
+
+// TenantAdmin is a user allowed to manage a single tenant and everything it
+// owns: applications, users, roles, secrets and OAuth providers. The user may
+// belong to a different tenant than the one they administer.
+type TenantAdmin struct {
+	TenantID  uuid.UUID
+	UserID    uuid.UUID
+	CreatedAt time.Time
+}
+
+func NewPlatformAdmin(userID uuid.UUID) *PlatformAdmin {
+	return &PlatformAdmin{
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+func NewTenantAdmin(tenantID, userID uuid.UUID) *TenantAdmin {
+	return &TenantAdmin{
+		TenantID:  tenantID,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+	}
+}
@@ -17,6 +17,10 @@ type ApplicationAuthorizationCode struct {
 	CodeChallengeMethod string
 	Nonce               *string
 	Scope               *string
+	// IPAddress and UserAgent describe the browser the user signed in from,
+	// recorded on the session created when the code is redeemed.
+	IPAddress           *string
+	UserAgent           *string
 }
 
 func CreateApplicationAuthorizationCode(applicationID, tenantUserID uuid.UUID, redirectUri, codeChallenge, codeChallegeMethod string, nonce *string, scope *string) (*ApplicationAuthorizationCode, error) {
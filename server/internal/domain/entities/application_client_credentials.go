@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApplicationClientCredentials enables the client_credentials grant for an
+// application and lists what it may request when acting on its own behalf.
+type ApplicationClientCredentials struct {
+	ApplicationID uuid.UUID
+	Scopes        []string
+	Roles         []ApplicationRole
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+func NewApplicationClientCredentials(applicationID uuid.UUID, scopes []string) *ApplicationClientCredentials {
+	return &ApplicationClientCredentials{
+		ApplicationID: applicationID,
+		Scopes:        scopes,
+		CreatedAt:     time.Now().UTC(),
+	}
+}
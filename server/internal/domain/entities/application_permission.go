@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApplicationPermission is a permission string (e.g. "invoices:read") defined
+// by an application and granted to users through the roles it is attached to.
+type ApplicationPermission struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	Name          string
+	Description   *string
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+func NewApplicationPermission(applicationID uuid.UUID, name string, description *string) *ApplicationPermission {
+	newID, err := uuid.NewV7()
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &ApplicationPermission{
+		ID:            newID,
+		ApplicationID: applicationID,
+		Name:          name,
+		Description:   description,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     nil,
+	}
+}
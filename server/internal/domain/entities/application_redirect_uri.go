@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApplicationRedirectURI is a URI registered by an application. Authorization
+// responses (and logout redirects) are only ever sent to registered URIs.
+type ApplicationRedirectURI struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	URI           string
+	Kind          string
+	CreatedAt     time.Time
+}
+
+func NewApplicationRedirectURI(applicationID uuid.UUID, uri, kind string) (*ApplicationRedirectURI, error) {
+	id, err := uuid.NewV7()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationRedirectURI{
+		ID:            id,
+		ApplicationID: applicationID,
+		URI:           uri,
+		Kind:          kind,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
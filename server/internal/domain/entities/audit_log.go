@@ -1,25 +1,102 @@
 package entities
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-// AuditLog represents an immutable security audit log entry.
+// AuditLogGenesisHash is the previous hash of the first entry of a tenant's
+// chain.
+const AuditLogGenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// AuditLog represents an immutable security audit log entry. Entries of a
+// tenant form a hash chain: each one stores the hash of the previous entry
+// and a hash of its own content, so edits, removals and reordering can be
+// detected.
 type AuditLog struct {
 	ID            uuid.UUID
-	UserID        uuid.UUID
-	ApplicationID uuid.UUID
-	EventType     string // e.g., "PASSWORD_CHANGED", "MFA_ENABLED"
+	TenantID      uuid.UUID  // uuid.Nil until stored when the entry has an application
+	UserID        *uuid.UUID // nil when the user is unknown, e.g. a login with an unregistered e-mail
+	ApplicationID *uuid.UUID // nil for tenant-level events
+	EventType     string     // e.g., "PASSWORD_CHANGED", "MFA_ENABLED"
 	IPAddress     string
 	UserAgent     string
 	Result        string // "success" or "failure"
 	Details       *string
 	CreatedAt     time.Time
+	ChainSequence int64  // position in the tenant's chain, starting at 1; 0 for entries written before the chain
+	PreviousHash  string // hash of the entry at ChainSequence-1, or AuditLogGenesisHash
+	Hash          string
+}
+
+// auditLogCanonicalContent is the content covered by an entry's hash. Field
+// order is part of the format and must not change.
+type auditLogCanonicalContent struct {
+	TenantID      uuid.UUID  `json:"tenantId"`
+	ChainSequence int64      `json:"chainSequence"`
+	ID            uuid.UUID  `json:"id"`
+	UserID        *uuid.UUID `json:"userId"`
+	ApplicationID *uuid.UUID `json:"applicationId"`
+	EventType     string     `json:"eventType"`
+	IPAddress     string     `json:"ipAddress"`
+	UserAgent     string     `json:"userAgent"`
+	Result        string     `json:"result"`
+	Details       *string    `json:"details"`
+	CreatedAt     string     `json:"createdAt"`
+	PreviousHash  string     `json:"previousHash"`
+}
+
+// ComputeHash returns the hex encoded SHA-256 of the entry's canonical
+// content. CreatedAt is taken in UTC at microsecond precision, as stored.
+func (a *AuditLog) ComputeHash() string {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(auditLogCanonicalContent{
+		TenantID:      a.TenantID,
+		ChainSequence: a.ChainSequence,
+		ID:            a.ID,
+		UserID:        a.UserID,
+		ApplicationID: a.ApplicationID,
+		EventType:     a.EventType,
+		IPAddress:     a.IPAddress,
+		UserAgent:     a.UserAgent,
+		Result:        a.Result,
+		Details:       a.Details,
+		CreatedAt:     a.CreatedAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		PreviousHash:  a.PreviousHash,
+	}); err != nil {
+		panic("failed to encode AuditLog content")
+	}
+
+	sum := sha256.Sum256(bytes.TrimSuffix(buffer.Bytes(), []byte("\n")))
+	return hex.EncodeToString(sum[:])
 }
 
+// ChainTo places the entry after the head of its tenant's chain and seals it
+// with its hash.
+func (a *AuditLog) ChainTo(headSequence int64, headHash string) {
+	a.CreatedAt = a.CreatedAt.UTC().Truncate(time.Microsecond)
+	a.ChainSequence = headSequence + 1
+	a.PreviousHash = headHash
+	a.Hash = a.ComputeHash()
+}
+
+// NewAuditLog records an event of a user in an application. The entry
+// belongs to the application's tenant.
 func NewAuditLog(userID, applicationID uuid.UUID, eventType, ipAddress, userAgent, result string, details *string) *AuditLog {
+	return NewTenantAuditLog(uuid.Nil, &userID, &applicationID, eventType, ipAddress, userAgent, result, details)
+}
+
+// NewTenantAuditLog records an event whose user or application may be
+// unknown, such as a tenant admin operation. A uuid.Nil tenantID takes the
+// tenant of the application.
+func NewTenantAuditLog(tenantID uuid.UUID, userID, applicationID *uuid.UUID, eventType, ipAddress, userAgent, result string, details *string) *AuditLog {
 	id, err := uuid.NewV7()
 	if err != nil {
 		panic("failed to generate UUID for AuditLog")
@@ -27,6 +104,7 @@ func NewAuditLog(userID, applicationID uuid.UUID, eventType, ipAddress, userAgen
 
 	return &AuditLog{
 		ID:            id,
+		TenantID:      tenantID,
 		UserID:        userID,
 		ApplicationID: applicationID,
 		EventType:     eventType,
@@ -37,3 +115,52 @@ func NewAuditLog(userID, applicationID uuid.UUID, eventType, ipAddress, userAgen
 		CreatedAt:     time.Now().UTC(),
 	}
 }
+
+// AuditLogFilter narrows an audit log search. Nil fields match every entry.
+type AuditLogFilter struct {
+	UserID        *uuid.UUID
+	ApplicationID *uuid.UUID
+	EventType     *string
+	Result        *string
+	IPAddress     *string
+	From          *time.Time // inclusive
+	To            *time.Time // exclusive
+}
+
+// AuditLogCheckpoint is a tenant's chain head signed with the tenant's
+// signing key, so it can be archived outside the database and checked later.
+type AuditLogCheckpoint struct {
+	ID            uuid.UUID
+	TenantID      uuid.UUID
+	ChainSequence int64
+	AuditLogID    uuid.UUID
+	Hash          string
+	KeyID         string // "kid" of the signing key
+	Signature     string // compact JWS over the checkpoint claims
+	CreatedAt     time.Time
+}
+
+func NewAuditLogCheckpoint(tenantID uuid.UUID, chainSequence int64, auditLogID uuid.UUID, hash string) *AuditLogCheckpoint {
+	id, err := uuid.NewV7()
+	if err != nil {
+		panic("failed to generate UUID for AuditLogCheckpoint")
+	}
+
+	return &AuditLogCheckpoint{
+		ID:            id,
+		TenantID:      tenantID,
+		ChainSequence: chainSequence,
+		AuditLogID:    auditLogID,
+		Hash:          hash,
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+// AuditLogChainHead is the last link of a tenant's audit log chain.
+type AuditLogChainHead struct {
+	TenantID      uuid.UUID
+	ChainSequence int64      // 0 while the chain is empty
+	AuditLogID    *uuid.UUID // nil while the chain is empty
+	Hash          string
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuthorizationRequest is a validated GET /oauth2/authorize request kept
+// server-side while the user goes through the hosted login UI, so the
+// parameters the code is finally bound to cannot be altered in the browser.
+type AuthorizationRequest struct {
+	ID                  uuid.UUID
+	ApplicationID       uuid.UUID
+	RedirectUri         string
+	ResponseType        string
+	Scope               string
+	State               *string
+	Nonce               *string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	ExpiresAt           time.Time
+	CreatedAt           time.Time
+}
+
+func NewAuthorizationRequest(applicationID uuid.UUID, redirectUri, responseType, scope string, state, nonce *string, codeChallenge, codeChallengeMethod string) (*AuthorizationRequest, error) {
+	id, err := uuid.NewV7()
+
+	if err != nil {
+		return nil, err
+	}
+
+	currentTime := time.Now().UTC()
+
+	return &AuthorizationRequest{
+		ID:                  id,
+		ApplicationID:       applicationID,
+		RedirectUri:         redirectUri,
+		ResponseType:        responseType,
+		Scope:               scope,
+		State:               state,
+		Nonce:               nonce,
+		CodeChallenge:       codeChallenge,
+		CodeChallengeMethod: codeChallengeMethod,
+		ExpiresAt:           currentTime.Add(15 * time.Minute), // same lifetime as a session code
+		CreatedAt:           currentTime,
+	}, nil
+}
+
+func (r *AuthorizationRequest) IsExpired() bool {
+	return r.ExpiresAt.Before(time.Now().UTC())
+}
@@ -1,10 +1,11 @@
 package entities
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
-	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -54,12 +55,30 @@ func HashBackupCode(code string) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// NormalizeBackupCode formats a code typed by the user the way
+// generateBackupCode does, so lowercase letters, spaces and a missing dash
+// still match its hash.
+func NormalizeBackupCode(code string) string {
+	code = strings.ToUpper(strings.Join(strings.Fields(code), ""))
+	code = strings.ReplaceAll(code, "-", "")
+
+	if len(code) != 8 {
+		return code
+	}
+
+	return fmt.Sprintf("%s-%s", code[:4], code[4:])
+}
+
 // generateBackupCode generates a random 8-character alphanumeric code in format XXXX-XXXX.
 func generateBackupCode() string {
 	const charset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // excludes easily confused chars (0,O,1,I)
 	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		panic("failed to generate BackupCode")
+	}
+	// len(charset) divides 256, so the modulo doesn't bias the code
 	for i := range b {
-		b[i] = charset[rand.Intn(len(charset))]
+		b[i] = charset[int(b[i])%len(charset)]
 	}
 	return fmt.Sprintf("%s-%s", string(b[:4]), string(b[4:]))
 }
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordHistory keeps a hash of a password the user had before, so that
+// tenants with a history policy can refuse to let it be set again.
+type PasswordHistory struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID // references TenantUser.ID
+	PasswordHash string
+	CreatedAt    time.Time // when the password stopped being used
+}
+
+func NewPasswordHistory(userID uuid.UUID, passwordHash string) *PasswordHistory {
+	id, err := uuid.NewV7()
+
+	if err != nil {
+		panic("failed to generate UUID for PasswordHistory")
+	}
+
+	return &PasswordHistory{
+		ID:           id,
+		UserID:       userID,
+		PasswordHash: passwordHash,
+		CreatedAt:    time.Now().UTC(),
+	}
+}
@@ -6,13 +6,23 @@ import (
 	"github.com/google/uuid"
 )
 
+// RefreshToken is a single-use token. Every redemption rotates it into a new
+// token of the same family, so replaying an already used token reveals that
+// the family has leaked.
 type RefreshToken struct {
-	ID        uuid.UUID
-	UserID    uuid.UUID
-	ExpiresAt time.Time
-	CreatedAt time.Time
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	FamilyID      uuid.UUID
+	ApplicationID *uuid.UUID
+	SessionID     *uuid.UUID
+	Scope         *string
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+	UsedAt        *time.Time
+	RevokedAt     *time.Time
 }
 
+// CreateRefreshToken creates the first token of a new family.
 func CreateRefreshToken(userID uuid.UUID, expiresAt time.Time) (*RefreshToken, error) {
 	id, err := uuid.NewV7()
 
@@ -23,6 +33,7 @@ func CreateRefreshToken(userID uuid.UUID, expiresAt time.Time) (*RefreshToken, e
 	return &RefreshToken{
 		ID:        id,
 		UserID:    userID,
+		FamilyID:  id,
 		ExpiresAt: expiresAt,
 		CreatedAt: time.Now().UTC(),
 	}, nil
@@ -32,7 +43,43 @@ func NewRefreshToken(ID uuid.UUID, userID uuid.UUID, expiresAt time.Time) *Refre
 	return &RefreshToken{
 		ID:        ID,
 		UserID:    userID,
+		FamilyID:  ID,
 		ExpiresAt: expiresAt,
 		CreatedAt: time.Now().UTC(),
 	}
 }
+
+// Rotate marks the token as used and returns its successor in the same family.
+func (t *RefreshToken) Rotate(expiresAt time.Time) (*RefreshToken, error) {
+	id, err := uuid.NewV7()
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	t.UsedAt = &now
+
+	return &RefreshToken{
+		ID:            id,
+		UserID:        t.UserID,
+		FamilyID:      t.FamilyID,
+		ApplicationID: t.ApplicationID,
+		SessionID:     t.SessionID,
+		Scope:         t.Scope,
+		ExpiresAt:     expiresAt,
+		CreatedAt:     now,
+	}, nil
+}
+
+func (t *RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+func (t *RefreshToken) IsExpired() bool {
+	return !t.ExpiresAt.After(time.Now().UTC())
+}
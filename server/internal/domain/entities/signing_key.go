@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/google/uuid"
+)
+
+// SigningKey is a persisted token signing key owned by a tenant.
+// Lifecycle: next -> active -> retired -> (deleted once ExpiresAt passes).
+type SigningKey struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	KeyID       string // "kid" header value
+	Algorithm   string // RS256, ES256 or EdDSA
+	PrivateKey  string // PKCS#8 PEM, stored unencrypted: rely on database encryption at rest
+	Status      string
+	CreatedAt   time.Time
+	ActivatedAt *time.Time
+	RetiredAt   *time.Time
+	ExpiresAt   *time.Time // when the key stops being published in JWKS
+}
+
+func NewSigningKey(tenantID uuid.UUID, keyID, algorithm, privateKeyPEM string) *SigningKey {
+	id, err := uuid.NewV7()
+	if err != nil {
+		panic("failed to generate UUID for SigningKey")
+	}
+
+	return &SigningKey{
+		ID:         id,
+		TenantID:   tenantID,
+		KeyID:      keyID,
+		Algorithm:  algorithm,
+		PrivateKey: privateKeyPEM,
+		Status:     constants.SigningKeyStatusNext,
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
+// Activate promotes the key so it signs new tokens.
+func (k *SigningKey) Activate(now time.Time) {
+	k.Status = constants.SigningKeyStatusActive
+	k.ActivatedAt = &now
+}
+
+// Retire stops the key from signing while keeping it published for overlap,
+// so tokens it already signed keep validating until they expire.
+func (k *SigningKey) Retire(now time.Time, overlap time.Duration) {
+	expiresAt := now.Add(overlap)
+	k.Status = constants.SigningKeyStatusRetired
+	k.RetiredAt = &now
+	k.ExpiresAt = &expiresAt
+}
+
+// Revoke withdraws the key immediately; tokens it signed stop validating.
+func (k *SigningKey) Revoke(now time.Time) {
+	k.Status = constants.SigningKeyStatusRevoked
+	k.RetiredAt = &now
+	k.ExpiresAt = &now
+}
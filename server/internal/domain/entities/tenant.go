@@ -3,6 +3,7 @@ package entities
 import (
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/google/uuid"
 )
 
@@ -13,7 +14,33 @@ type Tenant struct {
 	CanSelfSignUp      bool
 	CanSelfForgotPass  bool
 	IsActive           bool
-	PasswordHashSecret string
+	PasswordHashSecret string // optional pepper mixed into every password hash
+	// PreviousPasswordHashSecret is the pepper PasswordHashSecret replaced,
+	// kept to verify passwords that weren't rehashed yet.
+	PreviousPasswordHashSecret string
+	// Argon2id cost parameters applied to new password hashes.
+	PasswordHashMemory      int // KiB
+	PasswordHashIterations  int
+	PasswordHashParallelism int
+	// Rules new passwords must follow, see services.ValidatePassword.
+	PasswordMinLength        int
+	PasswordRequireUppercase bool
+	PasswordRequireLowercase bool
+	PasswordRequireDigit     bool
+	PasswordRequireSymbol    bool
+	PasswordMaxAgeDays       int // 0 disables expiry
+	PasswordHistoryCount     int // number of previous passwords that can't be reused
+	PasswordForbiddenWords   []string
+	// Rejects new passwords found in the breach dataset loaded at startup at
+	// least BreachedPasswordThreshold times, see breach.IsBreached.
+	BreachedPasswordCheck     bool
+	BreachedPasswordThreshold int
+	// Locks accounts after LockoutThreshold consecutive failed logins, for a
+	// duration that doubles with every lockout up to LockoutMaxDuration.
+	LockoutThreshold   int // 0 disables lockout
+	LockoutDuration    time.Duration
+	LockoutMaxDuration time.Duration
+	LockoutNotifyUser  bool // e-mail the user when their account gets locked
 	CreatedAt          time.Time
 	UpdatedAt          *time.Time
 }
@@ -22,10 +49,19 @@ func NewTenant(name string, description *string, passwordHashSecret string) *Ten
 	newId := uuid.New()
 
 	return &Tenant{
-		ID:                 newId,
-		Name:               name,
-		Description:        description,
-		PasswordHashSecret: passwordHashSecret,
-		CreatedAt:          time.Now().UTC(),
+		ID:                        newId,
+		Name:                      name,
+		Description:               description,
+		PasswordHashSecret:        passwordHashSecret,
+		PasswordHashMemory:        constants.DefaultPasswordHashMemory,
+		PasswordHashIterations:    constants.DefaultPasswordHashIterations,
+		PasswordHashParallelism:   constants.DefaultPasswordHashParallelism,
+		PasswordMinLength:         constants.DefaultPasswordMinLength,
+		PasswordForbiddenWords:    []string{},
+		BreachedPasswordThreshold: constants.DefaultBreachedPasswordThreshold,
+		LockoutThreshold:          constants.DefaultLockoutThreshold,
+		LockoutDuration:           constants.DefaultLockoutDuration,
+		LockoutMaxDuration:        constants.DefaultLockoutMaxDuration,
+		CreatedAt:                 time.Now().UTC(),
 	}
 }
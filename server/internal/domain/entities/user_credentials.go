@@ -12,8 +12,9 @@ type UserCredentials struct {
 	ID                uuid.UUID
 	UserID            uuid.UUID // references TenantUser.ID
 	PasswordHash      string    // hashed password
-	PasswordAlgorithm string    // e.g., "bcrypt", "argon2"
+	PasswordAlgorithm string    // e.g., "argon2id", "bcrypt"
 	ShouldChangePass  bool      // indicates if the user should change their password on next login
+	PasswordChangedAt time.Time // last time the password itself was set, rehashing doesn't change it
 	CreatedAt         time.Time
 	UpdatedAt         *time.Time
 }
@@ -25,13 +26,28 @@ func NewUserCredentials(userID uuid.UUID, passwordHash string, shouldChangePass
 		panic("failed to generate UUID for UserCredentials")
 	}
 
+	now := time.Now()
+
 	return &UserCredentials{
 		ID:                id,
 		UserID:            userID,
 		PasswordHash:      passwordHash,
-		PasswordAlgorithm: constants.UserCredentialsPasswordAlgorithmArgon2i, // default algorithm
+		PasswordAlgorithm: constants.UserCredentialsPasswordAlgorithmArgon2id, // default algorithm
 		ShouldChangePass:  shouldChangePass,
-		CreatedAt:         time.Now(),
+		PasswordChangedAt: now,
+		CreatedAt:         now,
 		UpdatedAt:         nil,
 	}
 }
+
+// SetPassword replaces the password with a new Argon2id hash and clears the
+// change-on-next-login flag.
+func (c *UserCredentials) SetPassword(passwordHash string) {
+	now := time.Now().UTC()
+
+	c.PasswordHash = passwordHash
+	c.PasswordAlgorithm = constants.UserCredentialsPasswordAlgorithmArgon2id
+	c.ShouldChangePass = false
+	c.PasswordChangedAt = now
+	c.UpdatedAt = &now
+}
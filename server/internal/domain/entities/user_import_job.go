@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/google/uuid"
+)
+
+// UserImportJob tracks an asynchronous bulk import of users into a tenant.
+// Lifecycle: pending -> running -> completed | failed.
+type UserImportJob struct {
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	IdempotencyKey string // client supplied; the same key returns the same job
+	Format         string // csv or jsonl
+	DryRun         bool   // validate every row without creating users
+	Status         string
+	TotalRows      int
+	ProcessedRows  int
+	ImportedRows   int
+	SkippedRows    int // rows whose e-mail already belongs to a user of the tenant
+	FailedRows     int
+	Errors         []UserImportRowError
+	CreatedAt      time.Time
+	CompletedAt    *time.Time
+}
+
+// UserImportRowError reports why a row of the import file was not imported.
+// Row is 1-based and does not count the CSV header.
+type UserImportRowError struct {
+	Row   int
+	Email string
+	Error string
+}
+
+func NewUserImportJob(tenantID uuid.UUID, idempotencyKey, format string, dryRun bool, totalRows int) *UserImportJob {
+	id, err := uuid.NewV7()
+	if err != nil {
+		panic("failed to generate UUID for UserImportJob")
+	}
+
+	return &UserImportJob{
+		ID:             id,
+		TenantID:       tenantID,
+		IdempotencyKey: idempotencyKey,
+		Format:         format,
+		DryRun:         dryRun,
+		Status:         constants.UserImportStatusPending,
+		TotalRows:      totalRows,
+		Errors:         []UserImportRowError{},
+		CreatedAt:      time.Now().UTC(),
+	}
+}
+
+// AddRowError records a row that could not be imported.
+func (j *UserImportJob) AddRowError(row int, email, message string) {
+	j.FailedRows++
+	j.Errors = append(j.Errors, UserImportRowError{Row: row, Email: email, Error: message})
+}
+
+// Complete marks the job as finished with the given status.
+func (j *UserImportJob) Complete(status string, now time.Time) {
+	j.Status = status
+	j.CompletedAt = &now
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserLockout tracks the consecutive failed logins of a user and whether the
+// account is locked because of them. It is removed after a successful login
+// or when an administrator unlocks the account.
+type UserLockout struct {
+	UserID         uuid.UUID // references TenantUser.ID
+	ApplicationID  uuid.UUID // application of the latest failed login
+	FailedAttempts int       // failed logins since the last lockout
+	LockoutCount   int       // consecutive lockouts, each one lasts twice as long
+	LastFailedAt   *time.Time
+	LockedUntil    *time.Time
+	UpdatedAt      time.Time
+}
+
+func NewUserLockout(userID, applicationID uuid.UUID) *UserLockout {
+	return &UserLockout{
+		UserID:        userID,
+		ApplicationID: applicationID,
+		UpdatedAt:     time.Now().UTC(),
+	}
+}
+
+// IsLocked reports whether the account is locked at now.
+func (l *UserLockout) IsLocked(now time.Time) bool {
+	return l.LockedUntil != nil && now.Before(*l.LockedUntil)
+}
@@ -44,9 +44,34 @@ var (
 	ErrInvalidClientSecret      = CustomError{Name: "ErrInvalidClientSecret", Code: http.StatusBadRequest, Message: "Invalid client secret", Title: "Invalid client secret"}
 	ErrClientSecretExpired      = CustomError{Name: "ErrClientSecretExpired", Code: http.StatusBadRequest, Message: "Client secret expired", Title: "Client secret expired"}
 
-	ErrTenantNotFound = CustomError{Name: "ErrTenantNotFound", Code: http.StatusNotFound, Message: "Tenant not found", Title: "Tenant not found"}
+	ErrTenantNotFound    = CustomError{Name: "ErrTenantNotFound", Code: http.StatusNotFound, Message: "Tenant not found", Title: "Tenant not found"}
+	ErrRouteBodyMismatch = CustomError{Name: "ErrRouteBodyMismatch", Code: http.StatusBadRequest, Message: "The IDs in the request body don't match the ones in the URL", Title: "Request body doesn't match the URL"}
+
+	ErrAdminRequired          = CustomError{Name: "ErrAdminRequired", Code: http.StatusForbidden, Message: "Only administrators of this tenant can perform this action", Title: "Administrator required"}
+	ErrDashboardTokenRequired = CustomError{Name: "ErrDashboardTokenRequired", Code: http.StatusForbidden, Message: "The administration API only accepts user tokens issued to the dashboard", Title: "Dashboard token required"}
+	ErrPlatformAdminRequired  = CustomError{Name: "ErrPlatformAdminRequired", Code: http.StatusForbidden, Message: "Only platform administrators can perform this action", Title: "Platform administrator required"}
+	ErrTenantAdminNotFound    = CustomError{Name: "ErrTenantAdminNotFound", Code: http.StatusNotFound, Message: "The user is not an administrator of this tenant", Title: "Tenant administrator not found"}
+
+	ErrSigningKeyRotationDisabled = CustomError{Name: "ErrSigningKeyRotationDisabled", Code: http.StatusConflict, Message: "Signing key rotation is not enabled on this server (JWT_KEY_ROTATION_ENABLED)", Title: "Signing key rotation disabled"}
+
+	ErrUserImportNotFound      = CustomError{Name: "ErrUserImportNotFound", Code: http.StatusNotFound, Message: "User import not found", Title: "User import not found"}
+	ErrUserImportInvalidFormat = CustomError{Name: "ErrUserImportInvalidFormat", Code: http.StatusBadRequest, Message: "User imports and exports must be CSV (text/csv) or JSON Lines (application/x-ndjson)", Title: "Invalid user import format"}
+	ErrUserImportInvalidHeader = CustomError{Name: "ErrUserImportInvalidHeader", Code: http.StatusBadRequest, Message: "The CSV header must include an email column and only name supported columns", Title: "Invalid user import header"}
+	ErrUserImportEmpty         = CustomError{Name: "ErrUserImportEmpty", Code: http.StatusBadRequest, Message: "The import file does not contain any user", Title: "Empty user import"}
+	ErrUserImportTooLarge      = CustomError{Name: "ErrUserImportTooLarge", Code: http.StatusRequestEntityTooLarge, Message: "The import file exceeds the maximum allowed size", Title: "User import too large"}
 
 	ErrUserRoleNotFound = CustomError{Name: "ErrUserRoleNotFound", Code: http.StatusNotFound, Message: "User role not found", Title: "User role not found"}
+	ErrRoleNotFound     = CustomError{Name: "ErrRoleNotFound", Code: http.StatusBadRequest, Message: "Role not found in this application", Title: "Role not found"}
+
+	ErrPermissionNotFound      = CustomError{Name: "ErrPermissionNotFound", Code: http.StatusNotFound, Message: "Permission not found in this application", Title: "Permission not found"}
+	ErrPermissionAlreadyExists = CustomError{Name: "ErrPermissionAlreadyExists", Code: http.StatusConflict, Message: "A permission with this name already exists in this application", Title: "Permission already exists"}
+	ErrInvalidPermissionName   = CustomError{Name: "ErrInvalidPermissionName", Code: http.StatusBadRequest, Message: "Permission names may only contain letters, digits and the characters : . _ - *", Title: "Invalid permission name"}
+	ErrPermissionDenied        = CustomError{Name: "ErrPermissionDenied", Code: http.StatusForbidden, Message: "The access token does not grant the permission required for this action", Title: "Permission denied"}
+
+	ErrClientCredentialsNotEnabled = CustomError{Name: "ErrClientCredentialsNotEnabled", Code: http.StatusNotFound, Message: "The client_credentials grant is not enabled for this application", Title: "Client credentials not enabled"}
+
+	ErrInvalidRedirectURI       = CustomError{Name: "ErrInvalidRedirectURI", Code: http.StatusBadRequest, Message: "Redirect URIs must be absolute https URIs, loopback http URIs or private-use scheme URIs, without fragment", Title: "Invalid redirect URI"}
+	ErrRedirectURINotRegistered = CustomError{Name: "ErrRedirectURINotRegistered", Code: http.StatusBadRequest, Message: "The redirect URI is not registered for this application", Title: "Redirect URI not registered"}
 
 	ErrAuthorizationCodeNotFound           = CustomError{Name: "ErrAuthorizationCodeNotFound", Code: http.StatusNotFound, Message: "Authorization code not found", Title: "Authorization code not found"}
 	ErrAuthorizationCodeExpired            = CustomError{Name: "ErrAuthorizationCodeExpired", Code: http.StatusBadRequest, Message: "Authorization code expired", Title: "Authorization code expired"}
@@ -112,7 +137,6 @@ var (
 	ErrStepUpTokenAlreadyUsed   = CustomError{Name: "ErrStepUpTokenAlreadyUsed", Code: http.StatusBadRequest, Message: "Step-up token has already been used", Title: "Step-up token already used"}
 	ErrCurrentPasswordRequired  = CustomError{Name: "ErrCurrentPasswordRequired", Code: http.StatusBadRequest, Message: "Current password is required", Title: "Current password required"}
 	ErrCurrentPasswordIncorrect = CustomError{Name: "ErrCurrentPasswordIncorrect", Code: http.StatusBadRequest, Message: "Current password is incorrect", Title: "Incorrect current password"}
-	ErrPasswordTooWeak          = CustomError{Name: "ErrPasswordTooWeak", Code: http.StatusBadRequest, Message: "Password does not meet strength requirements: minimum 8 characters, at least one uppercase, one lowercase, one digit, and one special character", Title: "Password too weak"}
 	ErrPasswordSameAsCurrent    = CustomError{Name: "ErrPasswordSameAsCurrent", Code: http.StatusBadRequest, Message: "New password must be different from current password", Title: "Same password"}
 	ErrMfaAlreadyEnabled        = CustomError{Name: "ErrMfaAlreadyEnabled", Code: http.StatusBadRequest, Message: "MFA is already enabled for this user", Title: "MFA already enabled"}
 	ErrMfaNotEnabled            = CustomError{Name: "ErrMfaNotEnabled", Code: http.StatusBadRequest, Message: "MFA is not enabled for this user", Title: "MFA not enabled"}
@@ -122,8 +146,22 @@ var (
 	ErrEmailChangeExpired       = CustomError{Name: "ErrEmailChangeExpired", Code: http.StatusBadRequest, Message: "Email change request has expired", Title: "Email change expired"}
 	ErrEmailAlreadyInUse        = CustomError{Name: "ErrEmailAlreadyInUse", Code: http.StatusConflict, Message: "Email address is already in use", Title: "Email already in use"}
 	ErrSessionNotFound          = CustomError{Name: "ErrSessionNotFound", Code: http.StatusNotFound, Message: "Session not found", Title: "Session not found"}
+	ErrSessionRevoked           = CustomError{Name: "ErrSessionRevoked", Code: http.StatusUnauthorized, Message: "Session has been revoked or has expired", Title: "Session revoked"}
 	ErrCannotRevokeCurrentSess  = CustomError{Name: "ErrCannotRevokeCurrentSess", Code: http.StatusBadRequest, Message: "Cannot revoke the current session", Title: "Cannot revoke current session"}
+	ErrTokenWithoutUser         = CustomError{Name: "ErrTokenWithoutUser", Code: http.StatusUnauthorized, Message: "The token was not issued to a user", Title: "Invalid token"}
 	ErrReauthFailed             = CustomError{Name: "ErrReauthFailed", Code: http.StatusUnauthorized, Message: "Reauthentication failed", Title: "Reauthentication failed"}
+	ErrAuditLogInvalidFilter    = CustomError{Name: "ErrAuditLogInvalidFilter", Code: http.StatusBadRequest, Message: "Audit log filters take UUIDs for userId, applicationId and cursor, and RFC 3339 timestamps for from and to", Title: "Invalid audit log filter"}
+	ErrAuditLogInvalidFormat    = CustomError{Name: "ErrAuditLogInvalidFormat", Code: http.StatusBadRequest, Message: "Audit logs are exported as CSV (text/csv) or NDJSON (application/x-ndjson)", Title: "Invalid audit log export format"}
+
+	// Breached password screening errors
+	ErrPasswordBreached                = CustomError{Name: "ErrPasswordBreached", Code: http.StatusBadRequest, Message: "This password has appeared in a data breach, please choose a different password", Title: "Breached password"}
+	ErrBreachedPasswordCorpusNotLoaded = CustomError{Name: "ErrBreachedPasswordCorpusNotLoaded", Code: http.StatusBadRequest, Message: "The breached password check can't be enabled because no breached password dataset is loaded", Title: "Breached password dataset not loaded"}
+
+	// Account lockout errors
+	ErrAccountLocked        = CustomError{Name: "ErrAccountLocked", Code: http.StatusLocked, Message: "Too many failed login attempts, the account is temporarily locked. Try again later or contact support", Title: "Account locked"}
+	ErrLoginThrottled       = CustomError{Name: "ErrLoginThrottled", Code: http.StatusTooManyRequests, Message: "Too many failed login attempts, wait a few seconds and try again", Title: "Too many login attempts"}
+	ErrMfaChallengeNotFound = CustomError{Name: "ErrMfaChallengeNotFound", Code: http.StatusNotFound, Message: "No pending MFA challenge was found for this login, sign in again", Title: "MFA challenge not found"}
+	ErrRateLimitExceeded    = CustomError{Name: "ErrRateLimitExceeded", Code: http.StatusTooManyRequests, Message: "Too many requests, retry after the time given in the Retry-After header", Title: "Too many requests"}
 )
 
 var ErrorsList = map[string]CustomError{
@@ -147,9 +185,28 @@ var ErrorsList = map[string]CustomError{
 	"ErrIncompatibleVersion":                 ErrIncompatibleVersion,
 	"ErrUserSignUpWithSocial":                ErrUserSignUpWithSocial,
 	"ErrApplicationNotFound":                 ErrApplicationNotFound,
+	"ErrRouteBodyMismatch":                   ErrRouteBodyMismatch,
 	"ErrAplicationSecretNotFound":            ErrAplicationSecretNotFound,
+	"ErrAdminRequired":                       ErrAdminRequired,
+	"ErrDashboardTokenRequired":              ErrDashboardTokenRequired,
+	"ErrPlatformAdminRequired":               ErrPlatformAdminRequired,
+	"ErrTenantAdminNotFound":                 ErrTenantAdminNotFound,
 	"ErrTenantNotFound":                      ErrTenantNotFound,
+	"ErrSigningKeyRotationDisabled":          ErrSigningKeyRotationDisabled,
+	"ErrUserImportNotFound":                  ErrUserImportNotFound,
+	"ErrUserImportInvalidFormat":             ErrUserImportInvalidFormat,
+	"ErrUserImportInvalidHeader":             ErrUserImportInvalidHeader,
+	"ErrUserImportEmpty":                     ErrUserImportEmpty,
+	"ErrUserImportTooLarge":                  ErrUserImportTooLarge,
 	"ErrUserRoleNotFound":                    ErrUserRoleNotFound,
+	"ErrRoleNotFound":                        ErrRoleNotFound,
+	"ErrPermissionNotFound":                  ErrPermissionNotFound,
+	"ErrPermissionAlreadyExists":             ErrPermissionAlreadyExists,
+	"ErrInvalidPermissionName":               ErrInvalidPermissionName,
+	"ErrPermissionDenied":                    ErrPermissionDenied,
+	"ErrClientCredentialsNotEnabled":         ErrClientCredentialsNotEnabled,
+	"ErrInvalidRedirectURI":                  ErrInvalidRedirectURI,
+	"ErrRedirectURINotRegistered":            ErrRedirectURINotRegistered,
 	"ErrAuthorizationCodeNotFound":           ErrAuthorizationCodeNotFound,
 	"ErrAuthorizationCodeExpired":            ErrAuthorizationCodeExpired,
 	"ErrInvalidClientSecret":                 ErrInvalidClientSecret,
@@ -202,7 +259,6 @@ var ErrorsList = map[string]CustomError{
 	"ErrStepUpTokenAlreadyUsed":              ErrStepUpTokenAlreadyUsed,
 	"ErrCurrentPasswordRequired":             ErrCurrentPasswordRequired,
 	"ErrCurrentPasswordIncorrect":            ErrCurrentPasswordIncorrect,
-	"ErrPasswordTooWeak":                     ErrPasswordTooWeak,
 	"ErrPasswordSameAsCurrent":               ErrPasswordSameAsCurrent,
 	"ErrMfaAlreadyEnabled":                   ErrMfaAlreadyEnabled,
 	"ErrMfaNotEnabled":                       ErrMfaNotEnabled,
@@ -212,6 +268,16 @@ var ErrorsList = map[string]CustomError{
 	"ErrEmailChangeExpired":                  ErrEmailChangeExpired,
 	"ErrEmailAlreadyInUse":                   ErrEmailAlreadyInUse,
 	"ErrSessionNotFound":                     ErrSessionNotFound,
+	"ErrTokenWithoutUser":                    ErrTokenWithoutUser,
+	"ErrSessionRevoked":                      ErrSessionRevoked,
 	"ErrCannotRevokeCurrentSess":             ErrCannotRevokeCurrentSess,
 	"ErrReauthFailed":                        ErrReauthFailed,
+	"ErrAuditLogInvalidFilter":               ErrAuditLogInvalidFilter,
+	"ErrAuditLogInvalidFormat":               ErrAuditLogInvalidFormat,
+	"ErrPasswordBreached":                    ErrPasswordBreached,
+	"ErrBreachedPasswordCorpusNotLoaded":     ErrBreachedPasswordCorpusNotLoaded,
+	"ErrAccountLocked":                       ErrAccountLocked,
+	"ErrLoginThrottled":                      ErrLoginThrottled,
+	"ErrMfaChallengeNotFound":                ErrMfaChallengeNotFound,
+	"ErrRateLimitExceeded":                   ErrRateLimitExceeded,
 }
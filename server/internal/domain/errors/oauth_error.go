@@ -0,0 +1,46 @@
+package errors
+
+import "net/http"
+
+// OAuth 2.0 error codes (RFC 6749 §5.2, RFC 7009 §2.2.1).
+const (
+	OAuthInvalidRequest          = "invalid_request"
+	OAuthInvalidClient           = "invalid_client"
+	OAuthInvalidGrant            = "invalid_grant"
+	OAuthUnauthorizedClient      = "unauthorized_client"
+	OAuthUnsupportedGrantType    = "unsupported_grant_type"
+	OAuthInvalidScope            = "invalid_scope"
+	OAuthUnsupportedTokenType    = "unsupported_token_type"
+	OAuthServerError             = "server_error"
+	OAuthAccessDenied            = "access_denied"
+	OAuthUnsupportedResponseType = "unsupported_response_type"
+	OAuthLoginRequired           = "login_required"
+)
+
+// OAuthError is an error rendered in the RFC 6749 §5.2 format
+// ({"error": "...", "error_description": "..."}) instead of the
+// CustomError envelope used by the rest of the API.
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	Status      int    `json:"-"`
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code
+}
+
+// NewOAuthError builds an OAuthError with the status code mandated by the spec:
+// 401 for invalid_client, 500 for server_error and 400 for everything else.
+func NewOAuthError(code, description string) *OAuthError {
+	status := http.StatusBadRequest
+
+	switch code {
+	case OAuthInvalidClient:
+		status = http.StatusUnauthorized
+	case OAuthServerError:
+		status = http.StatusInternalServerError
+	}
+
+	return &OAuthError{Code: code, Description: description, Status: status}
+}
@@ -0,0 +1,34 @@
+package errors
+
+import "strings"
+
+// PasswordPolicyError is returned when a new password breaks the tenant's
+// password policy. Unlike CustomError it lists every broken rule so clients
+// can point the user at each of them.
+type PasswordPolicyError struct {
+	Title      string                    `json:"title"`
+	Message    string                    `json:"message"`
+	Violations []PasswordPolicyViolation `json:"violations"`
+}
+
+type PasswordPolicyViolation struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return e.Message
+}
+
+func NewPasswordPolicyError(violations []PasswordPolicyViolation) *PasswordPolicyError {
+	messages := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		messages = append(messages, violation.Message)
+	}
+
+	return &PasswordPolicyError{
+		Title:      "Password policy violation",
+		Message:    "Password does not meet the password policy: " + strings.Join(messages, "; "),
+		Violations: violations,
+	}
+}
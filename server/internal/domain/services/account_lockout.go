@@ -0,0 +1,92 @@
+package services
+
+import (
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+// LockoutPolicy is how a tenant reacts to consecutive failed logins.
+type LockoutPolicy struct {
+	// Threshold is the number of failed logins that locks the account. Zero
+	// disables both the lockout and the delays between failed logins.
+	Threshold int
+
+	// Duration is how long the first lockout lasts. Every further lockout
+	// before a successful login lasts twice as long, up to MaxDuration.
+	Duration    time.Duration
+	MaxDuration time.Duration
+}
+
+// LockoutDuration returns how long the lockoutCount-th consecutive lockout
+// lasts.
+func LockoutDuration(policy LockoutPolicy, lockoutCount int) time.Duration {
+	maxDuration := max(policy.MaxDuration, policy.Duration)
+	duration := policy.Duration
+
+	for i := 1; i < lockoutCount && duration < maxDuration; i++ {
+		duration *= 2
+	}
+
+	return min(duration, maxDuration)
+}
+
+// FailedLoginDelay returns how long to wait after the failedAttempts-th
+// failed login before trying again. The first failure, most likely a typo,
+// isn't delayed.
+func FailedLoginDelay(failedAttempts int) time.Duration {
+	if failedAttempts < 2 {
+		return 0
+	}
+
+	delay := constants.FailedLoginBaseDelay
+
+	for i := 2; i < failedAttempts && delay < constants.FailedLoginMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, constants.FailedLoginMaxDelay)
+}
+
+// NextLoginAttemptAt returns when the account accepts the next login attempt,
+// either because a lockout ends or because the delay after the last failed
+// login has passed.
+func NextLoginAttemptAt(lockout *entities.UserLockout) time.Time {
+	next := time.Time{}
+
+	if lockout.LockedUntil != nil {
+		next = *lockout.LockedUntil
+	}
+
+	if lockout.LastFailedAt != nil {
+		if delayed := lockout.LastFailedAt.Add(FailedLoginDelay(lockout.FailedAttempts)); delayed.After(next) {
+			next = delayed
+		}
+	}
+
+	return next
+}
+
+// RegisterFailedLogin counts a failed login made through applicationID and
+// locks the account once the policy's threshold is reached. It reports
+// whether this failure locked the account.
+func RegisterFailedLogin(policy LockoutPolicy, lockout *entities.UserLockout, applicationID uuid.UUID, now time.Time) bool {
+	lockout.ApplicationID = applicationID
+	lockout.FailedAttempts++
+	lockout.LastFailedAt = &now
+	lockout.UpdatedAt = now
+
+	if policy.Threshold <= 0 || lockout.FailedAttempts < policy.Threshold {
+		return false
+	}
+
+	lockout.LockoutCount++
+	lockout.FailedAttempts = 0
+
+	lockedUntil := now.Add(LockoutDuration(policy, lockout.LockoutCount))
+	lockout.LockedUntil = &lockedUntil
+
+	return true
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockoutDuration_DoublesUpToMax(t *testing.T) {
+	policy := LockoutPolicy{Threshold: 5, Duration: 5 * time.Minute, MaxDuration: time.Hour}
+
+	assert.Equal(t, 5*time.Minute, LockoutDuration(policy, 1))
+	assert.Equal(t, 10*time.Minute, LockoutDuration(policy, 2))
+	assert.Equal(t, 40*time.Minute, LockoutDuration(policy, 4))
+	assert.Equal(t, time.Hour, LockoutDuration(policy, 5))
+	assert.Equal(t, time.Hour, LockoutDuration(policy, 1000))
+}
+
+func TestFailedLoginDelay(t *testing.T) {
+	assert.Zero(t, FailedLoginDelay(0))
+	assert.Zero(t, FailedLoginDelay(1))
+	assert.Equal(t, time.Second, FailedLoginDelay(2))
+	assert.Equal(t, 2*time.Second, FailedLoginDelay(3))
+	assert.Equal(t, 16*time.Second, FailedLoginDelay(6))
+	assert.Equal(t, 30*time.Second, FailedLoginDelay(7))
+	assert.Equal(t, 30*time.Second, FailedLoginDelay(1000))
+}
+
+func TestRegisterFailedLogin_LocksAtThreshold(t *testing.T) {
+	policy := LockoutPolicy{Threshold: 3, Duration: time.Minute, MaxDuration: time.Hour}
+	lockout := entities.NewUserLockout(uuid.New(), uuid.New())
+	applicationID := uuid.New()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.False(t, RegisterFailedLogin(policy, lockout, applicationID, now))
+	assert.False(t, RegisterFailedLogin(policy, lockout, applicationID, now))
+	assert.Equal(t, now.Add(time.Second), NextLoginAttemptAt(lockout))
+
+	assert.True(t, RegisterFailedLogin(policy, lockout, applicationID, now))
+	require.NotNil(t, lockout.LockedUntil)
+	assert.Equal(t, now.Add(time.Minute), *lockout.LockedUntil)
+	assert.Equal(t, applicationID, lockout.ApplicationID)
+	assert.Equal(t, 0, lockout.FailedAttempts)
+	assert.True(t, lockout.IsLocked(now))
+	assert.False(t, lockout.IsLocked(now.Add(time.Minute)))
+
+	// The next lockout, before any successful login, lasts twice as long
+	later := now.Add(time.Hour)
+	RegisterFailedLogin(policy, lockout, applicationID, later)
+	RegisterFailedLogin(policy, lockout, applicationID, later)
+	assert.True(t, RegisterFailedLogin(policy, lockout, applicationID, later))
+	assert.Equal(t, later.Add(2*time.Minute), *lockout.LockedUntil)
+	assert.Equal(t, 2, lockout.LockoutCount)
+}
+
+func TestRegisterFailedLogin_DisabledPolicyNeverLocks(t *testing.T) {
+	lockout := entities.NewUserLockout(uuid.New(), uuid.New())
+	now := time.Now().UTC()
+
+	for range 100 {
+		assert.False(t, RegisterFailedLogin(LockoutPolicy{}, lockout, lockout.ApplicationID, now))
+	}
+	assert.Nil(t, lockout.LockedUntil)
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+// AuditLogChainBreak is the first link of a tenant's audit log chain that
+// fails verification.
+type AuditLogChainBreak struct {
+	AuditLogID    *uuid.UUID // nil when the entry is missing
+	ChainSequence int64
+	Reason        string
+}
+
+// AuditLogChainVerifier walks a tenant's audit log chain in chain order and
+// stops at the first broken link. Signed checkpoints pin the hash of the
+// entries they cover, so a chain rewritten from some entry on is detected
+// too.
+type AuditLogChainVerifier struct {
+	checkpoints     map[int64]string
+	lastCheckpoint  int64
+	checkedEntries  int64
+	lastSequence    int64
+	lastHash        string
+	lastAuditLogID  *uuid.UUID
+	firstBrokenLink *AuditLogChainBreak
+}
+
+func NewAuditLogChainVerifier(checkpoints []*entities.AuditLogCheckpoint) *AuditLogChainVerifier {
+	verifier := &AuditLogChainVerifier{
+		checkpoints: make(map[int64]string, len(checkpoints)),
+		lastHash:    entities.AuditLogGenesisHash,
+	}
+
+	for _, checkpoint := range checkpoints {
+		verifier.checkpoints[checkpoint.ChainSequence] = checkpoint.Hash
+		verifier.lastCheckpoint = max(verifier.lastCheckpoint, checkpoint.ChainSequence)
+	}
+
+	return verifier
+}
+
+// Add checks the next entry of the chain. It returns false once the chain is
+// broken, and later entries are ignored.
+func (v *AuditLogChainVerifier) Add(entry *entities.AuditLog) bool {
+	if v.firstBrokenLink != nil {
+		return false
+	}
+
+	if reason := v.check(entry); reason != "" {
+		v.firstBrokenLink = &AuditLogChainBreak{
+			AuditLogID:    &entry.ID,
+			ChainSequence: entry.ChainSequence,
+			Reason:        reason,
+		}
+
+		return false
+	}
+
+	v.checkedEntries++
+	v.lastSequence = entry.ChainSequence
+	v.lastHash = entry.Hash
+	v.lastAuditLogID = &entry.ID
+
+	return true
+}
+
+func (v *AuditLogChainVerifier) check(entry *entities.AuditLog) string {
+	if entry.ChainSequence != v.lastSequence+1 {
+		return constants.AuditLogChainSequenceGap
+	}
+
+	if entry.ComputeHash() != entry.Hash {
+		return constants.AuditLogChainHashMismatch
+	}
+
+	if entry.PreviousHash != v.lastHash {
+		return constants.AuditLogChainPreviousHashMismatch
+	}
+
+	if hash, ok := v.checkpoints[entry.ChainSequence]; ok && hash != entry.Hash {
+		return constants.AuditLogChainCheckpointMismatch
+	}
+
+	return ""
+}
+
+// Finish checks that the walked chain reaches the last checkpoint and ends at
+// the head, and returns the first broken link, or nil when the chain is
+// intact. A nil head means the tenant has no chain yet.
+func (v *AuditLogChainVerifier) Finish(head *entities.AuditLogChainHead) *AuditLogChainBreak {
+	if v.firstBrokenLink != nil {
+		return v.firstBrokenLink
+	}
+
+	headSequence, headHash := int64(0), entities.AuditLogGenesisHash
+	if head != nil {
+		headSequence, headHash = head.ChainSequence, head.Hash
+	}
+
+	if v.lastSequence < max(headSequence, v.lastCheckpoint) {
+		v.firstBrokenLink = &AuditLogChainBreak{
+			ChainSequence: v.lastSequence + 1,
+			Reason:        constants.AuditLogChainMissingEntries,
+		}
+	} else if v.lastSequence != headSequence || v.lastHash != headHash {
+		v.firstBrokenLink = &AuditLogChainBreak{
+			AuditLogID:    v.lastAuditLogID,
+			ChainSequence: v.lastSequence,
+			Reason:        constants.AuditLogChainHeadMismatch,
+		}
+	}
+
+	return v.firstBrokenLink
+}
+
+// Until returns the sequence the chain must be walked to: its head, or the
+// last checkpoint when the head is behind it.
+func (v *AuditLogChainVerifier) Until(head *entities.AuditLogChainHead) int64 {
+	if head == nil {
+		return v.lastCheckpoint
+	}
+
+	return max(head.ChainSequence, v.lastCheckpoint)
+}
+
+// CheckedEntries returns how many entries passed verification.
+func (v *AuditLogChainVerifier) CheckedEntries() int64 {
+	return v.checkedEntries
+}
+
+// LastSequence returns the sequence of the last verified entry, the next one
+// to ask for.
+func (v *AuditLogChainVerifier) LastSequence() int64 {
+	return v.lastSequence
+}
+
+// LastHash returns the hash of the last verified entry.
+func (v *AuditLogChainVerifier) LastHash() string {
+	return v.lastHash
+}
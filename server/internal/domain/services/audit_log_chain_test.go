@@ -0,0 +1,178 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuditLogChain(t *testing.T, length int) ([]*entities.AuditLog, *entities.AuditLogChainHead) {
+	t.Helper()
+
+	tenantID := uuid.New()
+	head := &entities.AuditLogChainHead{TenantID: tenantID, Hash: entities.AuditLogGenesisHash}
+	chain := make([]*entities.AuditLog, 0, length)
+
+	for range length {
+		userID := uuid.New()
+		entry := entities.NewTenantAuditLog(tenantID, &userID, nil, constants.AuditEventLoginSucceeded, "203.0.113.7:4242", "curl/8.0", "success", nil)
+		entry.ChainTo(head.ChainSequence, head.Hash)
+
+		chain = append(chain, entry)
+		head.ChainSequence = entry.ChainSequence
+		head.AuditLogID = &entry.ID
+		head.Hash = entry.Hash
+	}
+
+	return chain, head
+}
+
+func verifyAuditLogChain(chain []*entities.AuditLog, head *entities.AuditLogChainHead, checkpoints []*entities.AuditLogCheckpoint) (*AuditLogChainVerifier, *AuditLogChainBreak) {
+	verifier := NewAuditLogChainVerifier(checkpoints)
+	for _, entry := range chain {
+		if !verifier.Add(entry) {
+			break
+		}
+	}
+
+	return verifier, verifier.Finish(head)
+}
+
+func TestAuditLogChainVerifier_IntactChain(t *testing.T) {
+	chain, head := newAuditLogChain(t, 5)
+
+	verifier, broken := verifyAuditLogChain(chain, head, nil)
+
+	assert.Nil(t, broken)
+	assert.Equal(t, int64(5), verifier.CheckedEntries())
+	assert.Equal(t, int64(5), verifier.LastSequence())
+	assert.Equal(t, head.Hash, verifier.LastHash())
+}
+
+func TestAuditLogChainVerifier_EmptyChain(t *testing.T) {
+	_, broken := verifyAuditLogChain(nil, nil, nil)
+
+	assert.Nil(t, broken)
+}
+
+func TestAuditLogChainVerifier_EditedEntry(t *testing.T) {
+	chain, head := newAuditLogChain(t, 5)
+	chain[2].Result = "failure"
+
+	verifier, broken := verifyAuditLogChain(chain, head, nil)
+
+	require.NotNil(t, broken)
+	assert.Equal(t, constants.AuditLogChainHashMismatch, broken.Reason)
+	assert.Equal(t, int64(3), broken.ChainSequence)
+	assert.Equal(t, chain[2].ID, *broken.AuditLogID)
+	assert.Equal(t, int64(2), verifier.CheckedEntries())
+}
+
+func TestAuditLogChainVerifier_EditedAndRehashedEntry(t *testing.T) {
+	chain, head := newAuditLogChain(t, 5)
+	chain[2].Result = "failure"
+	chain[2].Hash = chain[2].ComputeHash()
+
+	_, broken := verifyAuditLogChain(chain, head, nil)
+
+	require.NotNil(t, broken)
+	assert.Equal(t, constants.AuditLogChainPreviousHashMismatch, broken.Reason)
+	assert.Equal(t, int64(4), broken.ChainSequence)
+}
+
+func TestAuditLogChainVerifier_RemovedEntry(t *testing.T) {
+	chain, head := newAuditLogChain(t, 5)
+	chain = append(chain[:1], chain[2:]...)
+
+	_, broken := verifyAuditLogChain(chain, head, nil)
+
+	require.NotNil(t, broken)
+	assert.Equal(t, constants.AuditLogChainSequenceGap, broken.Reason)
+	assert.Equal(t, int64(3), broken.ChainSequence)
+}
+
+func TestAuditLogChainVerifier_TruncatedChain(t *testing.T) {
+	chain, head := newAuditLogChain(t, 5)
+
+	_, broken := verifyAuditLogChain(chain[:3], head, nil)
+
+	require.NotNil(t, broken)
+	assert.Equal(t, constants.AuditLogChainMissingEntries, broken.Reason)
+	assert.Equal(t, int64(4), broken.ChainSequence)
+	assert.Nil(t, broken.AuditLogID)
+}
+
+func TestAuditLogChainVerifier_TruncatedChainAndHeadBeforeCheckpoint(t *testing.T) {
+	chain, _ := newAuditLogChain(t, 5)
+	checkpoint := entities.NewAuditLogCheckpoint(chain[4].TenantID, 5, chain[4].ID, chain[4].Hash)
+	head := &entities.AuditLogChainHead{TenantID: chain[2].TenantID, ChainSequence: 3, AuditLogID: &chain[2].ID, Hash: chain[2].Hash}
+
+	_, broken := verifyAuditLogChain(chain[:3], head, []*entities.AuditLogCheckpoint{checkpoint})
+
+	require.NotNil(t, broken)
+	assert.Equal(t, constants.AuditLogChainMissingEntries, broken.Reason)
+	assert.Equal(t, int64(4), broken.ChainSequence)
+}
+
+func TestAuditLogChainVerifier_RewrittenChainAfterCheckpoint(t *testing.T) {
+	chain, _ := newAuditLogChain(t, 5)
+	checkpoint := entities.NewAuditLogCheckpoint(chain[3].TenantID, 4, chain[3].ID, chain[3].Hash)
+
+	// Rewrite every entry from the third one on, so the chain links again
+	chain[2].Result = "failure"
+	head := &entities.AuditLogChainHead{TenantID: chain[1].TenantID, ChainSequence: 2, Hash: chain[1].Hash}
+	for _, entry := range chain[2:] {
+		entry.ChainTo(head.ChainSequence, head.Hash)
+		head.ChainSequence = entry.ChainSequence
+		head.AuditLogID = &entry.ID
+		head.Hash = entry.Hash
+	}
+
+	_, broken := verifyAuditLogChain(chain, head, []*entities.AuditLogCheckpoint{checkpoint})
+
+	require.NotNil(t, broken)
+	assert.Equal(t, constants.AuditLogChainCheckpointMismatch, broken.Reason)
+	assert.Equal(t, int64(4), broken.ChainSequence)
+}
+
+func TestAuditLogChainVerifier_HeadMismatch(t *testing.T) {
+	chain, head := newAuditLogChain(t, 3)
+	head.Hash = entities.AuditLogGenesisHash
+
+	_, broken := verifyAuditLogChain(chain, head, nil)
+
+	require.NotNil(t, broken)
+	assert.Equal(t, constants.AuditLogChainHeadMismatch, broken.Reason)
+	assert.Equal(t, int64(3), broken.ChainSequence)
+}
+
+func TestAuditLogComputeHash_CoversContentAndLink(t *testing.T) {
+	chain, _ := newAuditLogChain(t, 1)
+	entry := chain[0]
+	hash := entry.ComputeHash()
+
+	assert.Equal(t, entry.Hash, hash)
+	assert.Len(t, hash, 64)
+
+	entry.PreviousHash = "ff" + entry.PreviousHash[2:]
+	assert.NotEqual(t, hash, entry.ComputeHash())
+}
+
+func TestAuditLogChainVerifier_HeadBehindCheckpoint(t *testing.T) {
+	chain, _ := newAuditLogChain(t, 5)
+	checkpoint := entities.NewAuditLogCheckpoint(chain[3].TenantID, 4, chain[3].ID, chain[3].Hash)
+	head := &entities.AuditLogChainHead{TenantID: chain[2].TenantID, ChainSequence: 3, AuditLogID: &chain[2].ID, Hash: chain[2].Hash}
+	verifier := NewAuditLogChainVerifier([]*entities.AuditLogCheckpoint{checkpoint})
+
+	assert.Equal(t, int64(4), verifier.Until(head))
+
+	_, broken := verifyAuditLogChain(chain[:4], head, []*entities.AuditLogCheckpoint{checkpoint})
+
+	require.NotNil(t, broken)
+	assert.Equal(t, constants.AuditLogChainHeadMismatch, broken.Reason)
+	assert.Equal(t, int64(4), broken.ChainSequence)
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/errors"
+)
+
+// minNameWordLength keeps short words of tenant and application names, such
+// as "app" or "inc", from being forbidden in passwords.
+const minNameWordLength = 4
+
+// PasswordPolicy is the set of rules a tenant applies to new passwords.
+type PasswordPolicy struct {
+	MinLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+
+	// MaxAgeDays is how long a password may be used before the user has to
+	// change it. Zero disables expiry.
+	MaxAgeDays int
+
+	// HistoryCount is how many of the user's latest passwords, including the
+	// current one, may not be set again. Zero allows any reuse.
+	HistoryCount int
+
+	// ForbiddenWords may not appear anywhere in the password, ignoring case.
+	ForbiddenWords []string
+
+	// BreachedPasswordCheck rejects passwords seen in at least
+	// BreachedPasswordThreshold breaches of the dataset loaded at startup.
+	BreachedPasswordCheck     bool
+	BreachedPasswordThreshold int
+}
+
+// ValidatePassword checks the password against every rule of the policy that
+// can be evaluated without the user's previous hashes and returns the rules
+// it breaks. An empty result means the password is acceptable.
+func ValidatePassword(policy PasswordPolicy, password string) []errors.PasswordPolicyViolation {
+	violations := []errors.PasswordPolicyViolation{}
+
+	length := utf8.RuneCountInString(password)
+	minLength := max(policy.MinLength, 1)
+
+	if length < minLength {
+		violations = append(violations, errors.PasswordPolicyViolation{
+			Code:    constants.PasswordViolationTooShort,
+			Message: fmt.Sprintf("must be at least %d characters long", minLength),
+		})
+	}
+
+	if length > constants.MaxPasswordLength {
+		violations = append(violations, errors.PasswordPolicyViolation{
+			Code:    constants.PasswordViolationTooLong,
+			Message: fmt.Sprintf("must be at most %d characters long", constants.MaxPasswordLength),
+		})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			hasSymbol = true
+		}
+	}
+
+	if policy.RequireUppercase && !hasUpper {
+		violations = append(violations, errors.PasswordPolicyViolation{
+			Code:    constants.PasswordViolationNoUppercase,
+			Message: "must contain an uppercase letter",
+		})
+	}
+
+	if policy.RequireLowercase && !hasLower {
+		violations = append(violations, errors.PasswordPolicyViolation{
+			Code:    constants.PasswordViolationNoLowercase,
+			Message: "must contain a lowercase letter",
+		})
+	}
+
+	if policy.RequireDigit && !hasDigit {
+		violations = append(violations, errors.PasswordPolicyViolation{
+			Code:    constants.PasswordViolationNoDigit,
+			Message: "must contain a digit",
+		})
+	}
+
+	if policy.RequireSymbol && !hasSymbol {
+		violations = append(violations, errors.PasswordPolicyViolation{
+			Code:    constants.PasswordViolationNoSymbol,
+			Message: "must contain a symbol",
+		})
+	}
+
+	lowerPassword := strings.ToLower(password)
+	seen := map[string]bool{}
+
+	for _, word := range policy.ForbiddenWords {
+		word = strings.ToLower(strings.TrimSpace(word))
+
+		if word == "" || seen[word] || !strings.Contains(lowerPassword, word) {
+			continue
+		}
+
+		seen[word] = true
+		violations = append(violations, errors.PasswordPolicyViolation{
+			Code:    constants.PasswordViolationForbiddenWord,
+			Message: fmt.Sprintf("must not contain %q", word),
+		})
+	}
+
+	return violations
+}
+
+// NameForbiddenWords splits tenant and application names into the words that
+// passwords may not contain, e.g. "Acme Billing" gives "acme" and "billing".
+func NameForbiddenWords(names ...string) []string {
+	words := []string{}
+
+	for _, name := range names {
+		fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+
+		for _, field := range fields {
+			if utf8.RuneCountInString(field) >= minNameWordLength {
+				words = append(words, field)
+			}
+		}
+	}
+
+	return words
+}
+
+// IsPasswordExpired reports whether a password set at changedAt has outlived
+// the policy's maximum age.
+func IsPasswordExpired(policy PasswordPolicy, changedAt, now time.Time) bool {
+	if policy.MaxAgeDays <= 0 {
+		return false
+	}
+
+	return now.After(changedAt.AddDate(0, 0, policy.MaxAgeDays))
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func violationCodes(violations []errors.PasswordPolicyViolation) []string {
+	codes := []string{}
+	for _, violation := range violations {
+		codes = append(codes, violation.Code)
+	}
+	return codes
+}
+
+func TestValidatePassword_CharacterClasses(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:        8,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		expected []string
+	}{
+		{"valid password", "MyP@ssw0rd", []string{}},
+		{"another valid", "Str0ng!Pass", []string{}},
+		{"too short", "Ab1!", []string{constants.PasswordViolationTooShort}},
+		{"no uppercase", "myp@ssw0rd", []string{constants.PasswordViolationNoUppercase}},
+		{"no lowercase", "MYP@SSW0RD", []string{constants.PasswordViolationNoLowercase}},
+		{"no digit", "MyP@ssword", []string{constants.PasswordViolationNoDigit}},
+		{"no symbol", "MyPassw0rd", []string{constants.PasswordViolationNoSymbol}},
+		{"unicode letters", "Ñandú-2024", []string{}},
+		{"empty string", "", []string{
+			constants.PasswordViolationTooShort,
+			constants.PasswordViolationNoUppercase,
+			constants.PasswordViolationNoLowercase,
+			constants.PasswordViolationNoDigit,
+			constants.PasswordViolationNoSymbol,
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, violationCodes(ValidatePassword(policy, tc.password)))
+		})
+	}
+}
+
+func TestValidatePassword_Length(t *testing.T) {
+	policy := PasswordPolicy{MinLength: 12}
+
+	assert.Equal(t, []string{constants.PasswordViolationTooShort}, violationCodes(ValidatePassword(policy, "elevenchars")))
+	assert.Empty(t, ValidatePassword(policy, "twelve chars"))
+	// Length is counted in characters, not bytes.
+	assert.Equal(t, []string{constants.PasswordViolationTooShort}, violationCodes(ValidatePassword(policy, "ñññññññññññ")))
+	assert.Equal(t, []string{constants.PasswordViolationTooLong}, violationCodes(ValidatePassword(policy, strings.Repeat("a", constants.MaxPasswordLength+1))))
+	// An unset minimum still rejects empty passwords.
+	assert.Equal(t, []string{constants.PasswordViolationTooShort}, violationCodes(ValidatePassword(PasswordPolicy{}, "")))
+}
+
+func TestValidatePassword_ForbiddenWords(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:      8,
+		ForbiddenWords: []string{"Password", " acme ", "", "password"},
+	}
+
+	violations := ValidatePassword(policy, "MyPASSWORD@Acme")
+
+	assert.Equal(t, []string{constants.PasswordViolationForbiddenWord, constants.PasswordViolationForbiddenWord}, violationCodes(violations))
+	assert.Equal(t, `must not contain "password"`, violations[0].Message)
+	assert.Equal(t, `must not contain "acme"`, violations[1].Message)
+	assert.Empty(t, ValidatePassword(policy, "correct horse battery"))
+}
+
+func TestNameForbiddenWords(t *testing.T) {
+	assert.Equal(t, []string{"acme", "billing", "portal"}, NameForbiddenWords("Acme Inc.", "billing-portal"))
+	assert.Empty(t, NameForbiddenWords("", "App"))
+}
+
+func TestIsPasswordExpired(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.False(t, IsPasswordExpired(PasswordPolicy{}, now.AddDate(-5, 0, 0), now))
+	assert.False(t, IsPasswordExpired(PasswordPolicy{MaxAgeDays: 90}, now.AddDate(0, 0, -89), now))
+	assert.True(t, IsPasswordExpired(PasswordPolicy{MaxAgeDays: 90}, now.AddDate(0, 0, -91), now))
+}
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"regexp"
+	"slices"
+)
+
+// permissionNamePattern accepts colon-separated segments such as
+// "invoices:read" or "reports.monthly:export".
+var permissionNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+(:[A-Za-z0-9_.-]+)*$`)
+
+// ValidatePermissionName reports whether a permission name may be defined.
+func ValidatePermissionName(name string) bool {
+	return len(name) <= 255 && permissionNamePattern.MatchString(name)
+}
+
+// HasPermission reports whether the required permission is among the granted
+// ones. Permissions are compared exactly; there is no implied hierarchy
+// between "invoices" and "invoices:read".
+func HasPermission(granted []string, required string) bool {
+	return required != "" && slices.Contains(granted, required)
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePermissionName(t *testing.T) {
+	valid := []string{"invoices:read", "invoices", "reports.monthly:export", "admin_panel:access-all"}
+	for _, name := range valid {
+		assert.True(t, ValidatePermissionName(name), name)
+	}
+
+	invalid := []string{"", "invoices:", ":read", "invoices read", "invoices::read", "invoices:*", strings.Repeat("a", 256)}
+	for _, name := range invalid {
+		assert.False(t, ValidatePermissionName(name), name)
+	}
+}
+
+func TestHasPermission(t *testing.T) {
+	granted := []string{"invoices:read", "invoices:write"}
+
+	assert.True(t, HasPermission(granted, "invoices:read"))
+	assert.False(t, HasPermission(granted, "invoices"))
+	assert.False(t, HasPermission(granted, "invoices:delete"))
+	assert.False(t, HasPermission(nil, "invoices:read"))
+	assert.False(t, HasPermission(granted, ""))
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/gate-keeper/internal/domain/entities"
+)
+
+// PortWildcard may replace the port of a loopback redirect URI
+// (e.g. "http://127.0.0.1:*/callback") so native apps can listen on whatever
+// ephemeral port the OS hands them (RFC 8252 §7.3).
+const PortWildcard = ":*"
+
+// ValidateRedirectURI reports whether a URI may be registered:
+//
+//  1. It must be absolute, without fragment (RFC 6749 §3.1.2) nor userinfo.
+//  2. https URIs are accepted for any host.
+//  3. http URIs are only accepted for loopback hosts (RFC 8252 §7.3).
+//  4. Private-use schemes must be reverse domain names such as
+//     "com.example.app" (RFC 8252 §7.1).
+//  5. The port wildcard is only accepted on loopback http URIs.
+func ValidateRedirectURI(uri string) bool {
+	parsed, wildcard, err := parseRedirectURI(uri)
+
+	if err != nil || !parsed.IsAbs() || parsed.Fragment != "" || parsed.User != nil {
+		return false
+	}
+
+	switch parsed.Scheme {
+	case "https":
+		return parsed.Host != "" && !wildcard
+	case "http":
+		return isLoopback(parsed.Hostname())
+	default:
+		return strings.Contains(parsed.Scheme, ".") && !wildcard
+	}
+}
+
+// MatchRedirectURI reports whether requested is one of the registered URIs of
+// the given kind. Matching is an exact string comparison; the only exception
+// is a loopback URI registered with the port wildcard, which matches the same
+// URI on any port.
+func MatchRedirectURI(registered []entities.ApplicationRedirectURI, kind, requested string) bool {
+	if requested == "" {
+		return false
+	}
+
+	for _, redirectURI := range registered {
+		if redirectURI.Kind != kind {
+			continue
+		}
+
+		if redirectURI.URI == requested {
+			return true
+		}
+
+		if matchesPortWildcard(redirectURI.URI, requested) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesPortWildcard(registered, requested string) bool {
+	pattern, wildcard, err := parseRedirectURI(registered)
+
+	if err != nil || !wildcard {
+		return false
+	}
+
+	candidate, err := url.Parse(requested)
+
+	if err != nil || candidate.Fragment != "" || candidate.User != nil {
+		return false
+	}
+
+	return candidate.Scheme == pattern.Scheme &&
+		candidate.Hostname() == pattern.Hostname() &&
+		candidate.Path == pattern.Path &&
+		candidate.RawQuery == pattern.RawQuery
+}
+
+// parseRedirectURI parses a redirect URI, stripping the port wildcard that
+// net/url would otherwise reject.
+func parseRedirectURI(uri string) (*url.URL, bool, error) {
+	wildcard := false
+
+	if i := strings.Index(uri, "://"); i >= 0 {
+		rest := uri[i+3:]
+		authorityEnd := strings.IndexAny(rest, "/?#")
+
+		if authorityEnd == -1 {
+			authorityEnd = len(rest)
+		}
+
+		if authority := rest[:authorityEnd]; strings.HasSuffix(authority, PortWildcard) {
+			wildcard = true
+			uri = uri[:i+3] + strings.TrimSuffix(authority, PortWildcard) + rest[authorityEnd:]
+		}
+	}
+
+	parsed, err := url.Parse(uri)
+
+	return parsed, wildcard, err
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
@@ -3,6 +3,7 @@ package accountchangepassword
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -21,7 +22,7 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 
 	// Inject server-side values (never trust frontend)
 	command.UserID = http_router.GetUserIDFromContext(request.Context())
-	command.IPAddress = request.RemoteAddr
+	command.IPAddress = clientip.RealIP(request)
 	command.UserAgent = request.UserAgent()
 
 	params := repositories.ParamsRs[Command, *Response, Handler]{
@@ -2,7 +2,6 @@ package accountchangepassword
 
 import (
 	"context"
-	"regexp"
 	"time"
 
 	"github.com/gate-keeper/internal/domain/constants"
@@ -11,36 +10,21 @@ import (
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	mailservice "github.com/gate-keeper/internal/infra/mail-service"
 )
 
 type Handler struct {
-	repository IRepository
+	repository  IRepository
+	mailService mailservice.IMailService
 }
 
 func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
 	return &Handler{
-		repository: NewRepository(q),
+		repository:  NewRepository(q),
+		mailService: &mailservice.MailService{},
 	}
 }
 
-// passwordStrengthRegex enforces: >=8 chars, at least 1 uppercase, 1 lowercase, 1 digit, 1 special char
-var (
-	hasUpperCase   = regexp.MustCompile(`[A-Z]`)
-	hasLowerCase   = regexp.MustCompile(`[a-z]`)
-	hasDigit       = regexp.MustCompile(`[0-9]`)
-	hasSpecialChar = regexp.MustCompile(`[^a-zA-Z0-9]`)
-)
-
-func validatePasswordStrength(password string) bool {
-	if len(password) < 8 {
-		return false
-	}
-	return hasUpperCase.MatchString(password) &&
-		hasLowerCase.MatchString(password) &&
-		hasDigit.MatchString(password) &&
-		hasSpecialChar.MatchString(password)
-}
-
 func (h *Handler) Handler(ctx context.Context, command Command) (*Response, error) {
 	// 1. Fetch user
 	user, err := h.repository.GetUserByID(ctx, command.UserID)
@@ -60,8 +44,38 @@ func (h *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, &errors.ErrUserCredentialsNotFound
 	}
 
-	// 3. Verify current password
-	isPasswordCorrect, err := application_utils.ComparePassword(userCredentials.PasswordHash, command.CurrentPassword)
+	// 3. Fetch application and tenant for the password hashing policy
+	application, err := h.repository.GetApplicationByID(ctx, command.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application == nil {
+		return nil, &errors.ErrApplicationNotFound
+	}
+
+	tenant, err := h.repository.GetTenantByID(ctx, application.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	hashPolicy := application_utils.TenantPasswordHashPolicy(tenant)
+	passwordPolicy := application_utils.TenantPasswordPolicy(tenant, application)
+
+	// 4. Refuse while the account is locked, since wrong current passwords
+	// count towards the same lockout as failed logins
+	now := time.Now().UTC()
+
+	lockout, err := h.repository.GetUserLockoutByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := application_utils.PasswordAttemptBlocked(application_utils.TenantLockoutPolicy(tenant), lockout, now); err != nil {
+		return nil, err
+	}
+
+	// 5. Verify current password
+	isPasswordCorrect, err := application_utils.ComparePassword(userCredentials.PasswordHash, command.CurrentPassword, hashPolicy.Peppers()...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,59 +83,61 @@ func (h *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		// Log failed attempt
 		auditLog := entities.NewAuditLog(user.ID, command.ApplicationID,
 			constants.AuditEventFailedReauth, command.IPAddress, command.UserAgent, "failure", nil)
-		_ = h.repository.AddAuditLog(ctx, auditLog)
-		return nil, &errors.ErrCurrentPasswordIncorrect
+		if err := h.repository.AddAuditLog(ctx, auditLog); err != nil {
+			return nil, err
+		}
+
+		attempt := application_utils.PasswordAttempt{
+			User:          user,
+			Tenant:        tenant,
+			ApplicationID: command.ApplicationID,
+			IPAddress:     command.IPAddress,
+			UserAgent:     command.UserAgent,
+		}
+		return nil, application_utils.RegisterFailedPassword(ctx, h.repository, h.mailService, attempt, lockout, now, &errors.ErrCurrentPasswordIncorrect)
 	}
 
-	// 4. Validate password strength
-	if !validatePasswordStrength(command.NewPassword) {
-		return nil, &errors.ErrPasswordTooWeak
+	if lockout != nil {
+		if err := h.repository.RemoveUserLockout(ctx, user.ID); err != nil {
+			return nil, err
+		}
 	}
 
-	// 5. Ensure new password is different from current
-	isSamePassword, _ := application_utils.ComparePassword(userCredentials.PasswordHash, command.NewPassword)
+	// 6. Ensure new password is different from current
+	isSamePassword, _ := application_utils.ComparePassword(userCredentials.PasswordHash, command.NewPassword, hashPolicy.Peppers()...)
 	if isSamePassword {
 		return nil, &errors.ErrPasswordSameAsCurrent
 	}
 
-	// 6. Fetch application for tenant lookup
-	application, err := h.repository.GetApplicationByID(ctx, command.ApplicationID)
-	if err != nil {
+	// 7. Validate the new password against the tenant's password policy
+	if err := application_utils.ValidatePasswordChange(ctx, h.repository, passwordPolicy, hashPolicy.Peppers(), userCredentials, command.NewPassword); err != nil {
 		return nil, err
 	}
-	if application == nil {
-		return nil, &errors.ErrApplicationNotFound
-	}
 
-	// 7. Fetch tenant for hashing secret
-	tenant, err := h.repository.GetTenantByID(ctx, application.TenantID)
+	// 8. Hash new password using the tenant's Argon2 policy
+	newHashedPassword, err := application_utils.HashPassword(command.NewPassword, hashPolicy)
 	if err != nil {
 		return nil, err
 	}
 
-	// 8. Hash new password using Argon2
-	newHashedPassword, err := application_utils.HashPassword(command.NewPassword, tenant.PasswordHashSecret)
-	if err != nil {
+	// 9. Keep the replaced password in the history and update credentials
+	if err := application_utils.RecordPasswordHistory(ctx, h.repository, passwordPolicy, userCredentials); err != nil {
 		return nil, err
 	}
 
-	// 8. Update credentials
-	now := time.Now().UTC()
-	userCredentials.PasswordHash = newHashedPassword
-	userCredentials.ShouldChangePass = false
-	userCredentials.UpdatedAt = &now
+	userCredentials.SetPassword(newHashedPassword)
 
 	if err := h.repository.UpdateUserCredentials(ctx, userCredentials); err != nil {
 		return nil, err
 	}
 
-	// 9. Invalidate all existing sessions (security requirement)
+	// 10. Invalidate all existing sessions (security requirement)
 	_ = h.repository.RevokeAllUserSessions(ctx, user.ID)
 
-	// 10. Invalidate all refresh tokens
+	// 11. Invalidate all refresh tokens
 	_ = h.repository.RevokeRefreshTokenFromUser(ctx, user.ID)
 
-	// 11. Log security event
+	// 12. Log security event
 	auditLog := entities.NewAuditLog(user.ID, command.ApplicationID,
 		constants.AuditEventPasswordChanged, command.IPAddress, command.UserAgent, "success", nil)
 	_ = h.repository.AddAuditLog(ctx, auditLog)
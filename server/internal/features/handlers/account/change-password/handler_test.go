@@ -9,6 +9,7 @@ import (
 	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -69,6 +70,46 @@ func (m *mockChangePassRepo) GetTenantByID(ctx context.Context, id uuid.UUID) (*
 	return args.Get(0).(*entities.Tenant), args.Error(1)
 }
 
+func (m *mockChangePassRepo) AddPasswordHistory(ctx context.Context, passwordHistory *entities.PasswordHistory) error {
+	return m.Called(ctx, passwordHistory).Error(0)
+}
+
+func (m *mockChangePassRepo) ListPasswordHistoryByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]entities.PasswordHistory, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.PasswordHistory), args.Error(1)
+}
+
+func (m *mockChangePassRepo) RemoveOldPasswordHistory(ctx context.Context, userID uuid.UUID, keep int) error {
+	return m.Called(ctx, userID, keep).Error(0)
+}
+
+func (m *mockChangePassRepo) GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserProfile), args.Error(1)
+}
+
+func (m *mockChangePassRepo) GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserLockout), args.Error(1)
+}
+
+func (m *mockChangePassRepo) UpsertUserLockout(ctx context.Context, lockout *entities.UserLockout) error {
+	return m.Called(ctx, lockout).Error(0)
+}
+
+func (m *mockChangePassRepo) RemoveUserLockout(ctx context.Context, userID uuid.UUID) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
 var _ IRepository = (*mockChangePassRepo)(nil)
 
 // ---------------------------------------------------------------------------
@@ -136,12 +177,17 @@ func TestHandler_ChangePassword_IncorrectCurrentPassword(t *testing.T) {
 		ID:                uuid.New(),
 		UserID:            userID,
 		PasswordHash:      "invalid-hash-that-wont-match",
-		PasswordAlgorithm: constants.UserCredentialsPasswordAlgorithmArgon2i,
+		PasswordAlgorithm: constants.UserCredentialsPasswordAlgorithmArgon2id,
 		CreatedAt:         time.Now().UTC(),
 	}
 
+	tenantID := uuid.New()
+
 	repo.On("GetUserByID", mock.Anything, userID).Return(user, nil)
 	repo.On("GetUserCredentialsByUserID", mock.Anything, userID).Return(creds, nil)
+	repo.On("GetApplicationByID", mock.Anything, appID).Return(&entities.Application{ID: appID, TenantID: tenantID}, nil)
+	repo.On("GetTenantByID", mock.Anything, tenantID).Return(&entities.Tenant{ID: tenantID}, nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, userID).Return(nil, nil)
 	// ComparePassword will error because hash is not a valid argon2 hash,
 	// so the handler returns the error before reaching AddAuditLog.
 
@@ -207,27 +253,123 @@ func TestHandler_ChangePassword_GetCredentialsDBError(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
-func TestValidatePasswordStrength(t *testing.T) {
-	tests := []struct {
-		name     string
-		password string
-		expected bool
-	}{
-		{"valid password", "MyP@ssw0rd", true},
-		{"another valid", "Str0ng!Pass", true},
-		{"too short", "Ab1!", false},
-		{"no uppercase", "myp@ssw0rd", false},
-		{"no lowercase", "MYP@SSW0RD", false},
-		{"no digit", "MyP@ssword", false},
-		{"no special char", "MyPassw0rd", false},
-		{"empty string", "", false},
-		{"just spaces", "        ", false},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			result := validatePasswordStrength(tc.password)
-			assert.Equal(t, tc.expected, result)
-		})
+func TestHandler_ChangePassword_PasswordPolicyViolation(t *testing.T) {
+	repo := new(mockChangePassRepo)
+	userID := uuid.New()
+	appID := uuid.New()
+	tenant := &entities.Tenant{
+		ID:                       uuid.New(),
+		Name:                     "Acme",
+		PasswordMinLength:        10,
+		PasswordRequireUppercase: true,
+		PasswordRequireLowercase: true,
+		PasswordRequireDigit:     true,
+		PasswordRequireSymbol:    true,
+	}
+	currentHash, err := application_utils.HashPassword("OldPass1!", application_utils.TenantPasswordHashPolicy(tenant))
+	require.NoError(t, err)
+
+	user := &entities.TenantUser{
+		ID:        userID,
+		Email:     "user@test.com",
+		IsActive:  true,
+		CreatedAt: time.Now().UTC(),
+	}
+	creds := &entities.UserCredentials{
+		ID:                uuid.New(),
+		UserID:            userID,
+		PasswordHash:      currentHash,
+		PasswordAlgorithm: constants.UserCredentialsPasswordAlgorithmArgon2id,
+		CreatedAt:         time.Now().UTC(),
+	}
+
+	repo.On("GetUserByID", mock.Anything, userID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, userID).Return(creds, nil)
+	repo.On("GetApplicationByID", mock.Anything, appID).Return(&entities.Application{ID: appID, TenantID: tenant.ID, Name: "Portal"}, nil)
+	repo.On("GetTenantByID", mock.Anything, tenant.ID).Return(tenant, nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, userID).Return(nil, nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{
+		UserID:          userID,
+		ApplicationID:   appID,
+		CurrentPassword: "OldPass1!",
+		NewPassword:     "acmeportal",
+	})
+
+	var policyErr *errors.PasswordPolicyError
+	require.ErrorAs(t, err, &policyErr)
+	assert.Nil(t, resp)
+
+	codes := []string{}
+	for _, violation := range policyErr.Violations {
+		codes = append(codes, violation.Code)
+	}
+	assert.Equal(t, []string{
+		constants.PasswordViolationNoUppercase,
+		constants.PasswordViolationNoDigit,
+		constants.PasswordViolationNoSymbol,
+		constants.PasswordViolationForbiddenWord,
+		constants.PasswordViolationForbiddenWord,
+	}, codes)
+	repo.AssertNotCalled(t, "UpdateUserCredentials", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_ChangePassword_LocksAccountAtThreshold(t *testing.T) {
+	repo := new(mockChangePassRepo)
+	userID := uuid.New()
+	appID := uuid.New()
+	tenant := &entities.Tenant{
+		ID:                 uuid.New(),
+		LockoutThreshold:   3,
+		LockoutDuration:    5 * time.Minute,
+		LockoutMaxDuration: time.Hour,
+	}
+	currentHash, err := application_utils.HashPassword("OldPass1!", application_utils.TenantPasswordHashPolicy(tenant))
+	require.NoError(t, err)
+
+	user := &entities.TenantUser{
+		ID:        userID,
+		Email:     "user@test.com",
+		IsActive:  true,
+		CreatedAt: time.Now().UTC(),
 	}
+	creds := &entities.UserCredentials{
+		ID:                uuid.New(),
+		UserID:            userID,
+		PasswordHash:      currentHash,
+		PasswordAlgorithm: constants.UserCredentialsPasswordAlgorithmArgon2id,
+		CreatedAt:         time.Now().UTC(),
+	}
+	lastFailedAt := time.Now().UTC().Add(-time.Hour)
+	lockout := &entities.UserLockout{UserID: userID, ApplicationID: appID, FailedAttempts: 2, LastFailedAt: &lastFailedAt}
+
+	repo.On("GetUserByID", mock.Anything, userID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, userID).Return(creds, nil)
+	repo.On("GetApplicationByID", mock.Anything, appID).Return(&entities.Application{ID: appID, TenantID: tenant.ID}, nil)
+	repo.On("GetTenantByID", mock.Anything, tenant.ID).Return(tenant, nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, userID).Return(lockout, nil)
+	repo.On("UpsertUserLockout", mock.Anything, lockout).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventFailedReauth
+	})).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventAccountLocked
+	})).Return(nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{
+		UserID:          userID,
+		ApplicationID:   appID,
+		CurrentPassword: "WrongPass1!",
+		NewPassword:     "NewPass1!",
+	})
+
+	assert.ErrorIs(t, err, &errors.ErrAccountLocked)
+	assert.Nil(t, resp)
+	require.NotNil(t, lockout.LockedUntil)
+	assert.Equal(t, 1, lockout.LockoutCount)
+	repo.AssertNotCalled(t, "UpdateUserCredentials", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
 }
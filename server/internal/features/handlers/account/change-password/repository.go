@@ -18,6 +18,13 @@ type IRepository interface {
 	RevokeRefreshTokenFromUser(ctx context.Context, userID uuid.UUID) error
 	RevokeAllUserSessions(ctx context.Context, userID uuid.UUID) error
 	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
+	AddPasswordHistory(ctx context.Context, passwordHistory *entities.PasswordHistory) error
+	ListPasswordHistoryByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]entities.PasswordHistory, error)
+	RemoveOldPasswordHistory(ctx context.Context, userID uuid.UUID, keep int) error
+	GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error)
+	GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error)
+	UpsertUserLockout(ctx context.Context, lockout *entities.UserLockout) error
+	RemoveUserLockout(ctx context.Context, userID uuid.UUID) error
 }
 
 type Repository struct {
@@ -28,6 +35,9 @@ type Repository struct {
 	repositories.RefreshTokenRepository
 	repositories.UserSessionRepository
 	repositories.AuditLogRepository
+	repositories.PasswordHistoryRepository
+	repositories.UserProfileRepository
+	repositories.UserLockoutRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -39,5 +49,8 @@ func NewRepository(q *pgstore.Queries) Repository {
 		RefreshTokenRepository:    repositories.RefreshTokenRepository{Store: q},
 		UserSessionRepository:     repositories.UserSessionRepository{Store: q},
 		AuditLogRepository:        repositories.AuditLogRepository{Store: q},
+		PasswordHistoryRepository: repositories.PasswordHistoryRepository{Store: q},
+		UserProfileRepository:     repositories.UserProfileRepository{Store: q},
+		UserLockoutRepository:     repositories.UserLockoutRepository{Store: q},
 	}
 }
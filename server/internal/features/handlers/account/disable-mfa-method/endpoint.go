@@ -3,6 +3,7 @@ package accountdisablemfamethod
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/go-chi/chi"
@@ -19,7 +20,7 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	command := Command{
 		UserID:    http_router.GetUserIDFromContext(request.Context()),
 		Method:    method,
-		IPAddress: request.RemoteAddr,
+		IPAddress: clientip.RealIP(request),
 		UserAgent: request.UserAgent(),
 	}
 
@@ -87,8 +87,8 @@ func (h *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		_ = h.repository.DeleteBackupCodesByUserID(ctx, user.ID)
 	}
 
-	// Log audit event
-	auditLog := entities.NewAuditLog(user.ID, user.TenantID,
+	// Log audit event, the request carries no application
+	auditLog := entities.NewTenantAuditLog(user.TenantID, &user.ID, nil,
 		constants.AuditEventMfaDisabled, command.IPAddress, command.UserAgent, "success", nil)
 	_ = h.repository.AddAuditLog(ctx, auditLog)
 
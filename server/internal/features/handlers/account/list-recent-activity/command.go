@@ -0,0 +1,9 @@
+package accountlistrecentactivity
+
+import "github.com/google/uuid"
+
+type Command struct {
+	UserID uuid.UUID  `json:"-"`
+	Cursor *uuid.UUID `json:"-"` // from ?cursor=
+	Limit  int        `json:"-"` // from ?limit=
+}
@@ -0,0 +1,40 @@
+package accountlistrecentactivity
+
+import (
+	"net/http"
+
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	cursor, limit, err := application_utils.ParseAuditLogPage(request.URL.Query())
+	if err != nil {
+		panic(err)
+	}
+
+	command := Command{
+		UserID: http_router.GetUserIDFromContext(request.Context()),
+		Cursor: cursor,
+		Limit:  limit,
+	}
+
+	params := repositories.ParamsRs[Command, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: command,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+	if err != nil {
+		panic(err)
+	}
+
+	http_router.SendJson(writter, response, http.StatusOK)
+}
@@ -0,0 +1,58 @@
+package accountlistrecentactivity
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (h *Handler) Handler(ctx context.Context, command Command) (*Response, error) {
+	user, err := h.repository.GetUserByID(ctx, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, &errors.ErrUserNotFound
+	}
+
+	// One more entry than requested tells whether another page follows.
+	filter := entities.AuditLogFilter{UserID: &user.ID}
+	logs, err := h.repository.ListTenantAuditLogs(ctx, user.TenantID, filter, command.Cursor, command.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{Activity: make([]ActivityItem, 0, len(logs))}
+
+	if len(logs) > command.Limit {
+		logs = logs[:command.Limit]
+		response.NextCursor = &logs[len(logs)-1].ID
+	}
+
+	for _, log := range logs {
+		response.Activity = append(response.Activity, ActivityItem{
+			ID:            log.ID,
+			EventType:     log.EventType,
+			Result:        log.Result,
+			ApplicationID: log.ApplicationID,
+			IPAddress:     log.IPAddress,
+			UserAgent:     log.UserAgent,
+			CreatedAt:     log.CreatedAt,
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,27 @@
+package accountlistrecentactivity
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error)
+	ListTenantAuditLogs(ctx context.Context, tenantID uuid.UUID, filter entities.AuditLogFilter, beforeID *uuid.UUID, limit int) ([]*entities.AuditLog, error)
+}
+
+type Repository struct {
+	repositories.UserRepository
+	repositories.AuditLogRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		UserRepository:     repositories.UserRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
+	}
+}
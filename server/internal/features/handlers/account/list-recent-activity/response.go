@@ -0,0 +1,23 @@
+package accountlistrecentactivity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivityItem is a security event of the user's account.
+type ActivityItem struct {
+	ID            uuid.UUID  `json:"id"`
+	EventType     string     `json:"eventType"`
+	Result        string     `json:"result"`
+	ApplicationID *uuid.UUID `json:"applicationId"`
+	IPAddress     string     `json:"ipAddress"`
+	UserAgent     string     `json:"userAgent"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+type Response struct {
+	Activity   []ActivityItem `json:"activity"`
+	NextCursor *uuid.UUID     `json:"nextCursor"`
+}
@@ -3,5 +3,6 @@ package accountlistsessions
 import "github.com/google/uuid"
 
 type Command struct {
-	UserID uuid.UUID `json:"-"`
+	UserID           uuid.UUID `json:"-"`
+	CurrentSessionID uuid.UUID `json:"-"` // sid claim of the caller's token
 }
@@ -14,7 +14,8 @@ type Endpoint struct {
 
 func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	command := Command{
-		UserID: http_router.GetUserIDFromContext(request.Context()),
+		UserID:           http_router.GetUserIDFromContext(request.Context()),
+		CurrentSessionID: http_router.GetSessionIDFromContext(request.Context()),
 	}
 
 	params := repositories.ParamsRs[Command, *Response, Handler]{
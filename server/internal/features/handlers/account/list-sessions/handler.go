@@ -33,6 +33,7 @@ func (h *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 			CreatedAt:    s.CreatedAt,
 			LastActiveAt: s.LastActiveAt,
 			ExpiresAt:    s.ExpiresAt,
+			IsCurrent:    s.ID == command.CurrentSessionID,
 		})
 	}
 
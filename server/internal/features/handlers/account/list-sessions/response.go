@@ -16,6 +16,7 @@ type SessionItem struct {
 	CreatedAt    time.Time `json:"createdAt"`
 	LastActiveAt time.Time `json:"lastActiveAt"`
 	ExpiresAt    time.Time `json:"expiresAt"`
+	IsCurrent    bool      `json:"isCurrent"`
 }
 
 type Response struct {
@@ -2,6 +2,7 @@ package reauthenticate
 
 import (
 	"context"
+	"time"
 
 	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
@@ -9,16 +10,19 @@ import (
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	mailservice "github.com/gate-keeper/internal/infra/mail-service"
 	"github.com/pquerna/otp/totp"
 )
 
 type Handler struct {
-	repository IRepository
+	repository  IRepository
+	mailService mailservice.IMailService
 }
 
 func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
 	return &Handler{
-		repository: NewRepository(q),
+		repository:  NewRepository(q),
+		mailService: &mailservice.MailService{},
 	}
 }
 
@@ -39,8 +43,28 @@ func (h *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, &errors.ErrUserCredentialsNotFound
 	}
 
+	tenant, err := h.repository.GetTenantByID(ctx, user.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrong passwords count towards the same lockout as failed logins, so
+	// reauthentication can't be used to guess the password instead
+	now := time.Now().UTC()
+
+	lockout, err := h.repository.GetUserLockoutByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := application_utils.PasswordAttemptBlocked(application_utils.TenantLockoutPolicy(tenant), lockout, now); err != nil {
+		return nil, err
+	}
+
+	policy := application_utils.TenantPasswordHashPolicy(tenant)
+
 	// Verify current password
-	isPasswordCorrect, err := application_utils.ComparePassword(userCredentials.PasswordHash, command.Password)
+	isPasswordCorrect, err := application_utils.ComparePassword(userCredentials.PasswordHash, command.Password, policy.Peppers()...)
 	if err != nil {
 		return nil, err
 	}
@@ -49,8 +73,22 @@ func (h *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		// Log failed reauthentication
 		failureDetails := "incorrect password"
 		auditLog := entities.NewAuditLog(user.ID, command.ApplicationID, constants.AuditEventFailedReauth, "", "", "failure", &failureDetails)
-		_ = h.repository.AddAuditLog(ctx, auditLog)
-		return nil, &errors.ErrCurrentPasswordIncorrect
+		if err := h.repository.AddAuditLog(ctx, auditLog); err != nil {
+			return nil, err
+		}
+
+		attempt := application_utils.PasswordAttempt{User: user, Tenant: tenant, ApplicationID: command.ApplicationID}
+		return nil, application_utils.RegisterFailedPassword(ctx, h.repository, h.mailService, attempt, lockout, now, &errors.ErrCurrentPasswordIncorrect)
+	}
+
+	if lockout != nil {
+		if err := h.repository.RemoveUserLockout(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := application_utils.RehashPasswordIfNeeded(ctx, h.repository, userCredentials, command.Password, policy); err != nil {
+		return nil, err
 	}
 
 	// If user has TOTP MFA enabled, verify the TOTP code
@@ -9,6 +9,8 @@ import (
 	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -37,6 +39,18 @@ func (m *mockReauthRepo) GetUserCredentialsByUserID(ctx context.Context, userID
 	return args.Get(0).(*entities.UserCredentials), args.Error(1)
 }
 
+func (m *mockReauthRepo) UpdateUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error {
+	return m.Called(ctx, userCredentials).Error(0)
+}
+
+func (m *mockReauthRepo) GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Tenant), args.Error(1)
+}
+
 func (m *mockReauthRepo) GetMfaMethodByUserID(ctx context.Context, userID uuid.UUID, method string) (*entities.MfaMethod, error) {
 	args := m.Called(ctx, userID, method)
 	if args.Get(0) == nil {
@@ -65,6 +79,30 @@ func (m *mockReauthRepo) AddAuditLog(ctx context.Context, auditLog *entities.Aud
 	return m.Called(ctx, auditLog).Error(0)
 }
 
+func (m *mockReauthRepo) GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserProfile), args.Error(1)
+}
+
+func (m *mockReauthRepo) GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserLockout), args.Error(1)
+}
+
+func (m *mockReauthRepo) UpsertUserLockout(ctx context.Context, lockout *entities.UserLockout) error {
+	return m.Called(ctx, lockout).Error(0)
+}
+
+func (m *mockReauthRepo) RemoveUserLockout(ctx context.Context, userID uuid.UUID) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
 var _ IRepository = (*mockReauthRepo)(nil)
 
 // ---------------------------------------------------------------------------
@@ -86,6 +124,30 @@ func makeUser(id uuid.UUID) *entities.TenantUser {
 // a password that was hashed with the same util. Instead, we test the
 // "incorrect password" path which doesn't depend on the hash algorithm.
 
+// newLockoutTenant returns a tenant that locks accounts after three failed
+// password attempts.
+func newLockoutTenant(tenantID uuid.UUID) *entities.Tenant {
+	return &entities.Tenant{
+		ID:                 tenantID,
+		LockoutThreshold:   3,
+		LockoutDuration:    5 * time.Minute,
+		LockoutMaxDuration: time.Hour,
+	}
+}
+
+func makeCredentials(t *testing.T, userID uuid.UUID, tenant *entities.Tenant, password string) *entities.UserCredentials {
+	hash, err := application_utils.HashPassword(password, application_utils.TenantPasswordHashPolicy(tenant))
+	require.NoError(t, err)
+
+	return &entities.UserCredentials{
+		ID:                uuid.New(),
+		UserID:            userID,
+		PasswordHash:      hash,
+		PasswordAlgorithm: constants.UserCredentialsPasswordAlgorithmArgon2id,
+		CreatedAt:         time.Now().UTC(),
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Tests
 // ---------------------------------------------------------------------------
@@ -160,13 +222,15 @@ func TestHandler_Reauthenticate_IncorrectPassword(t *testing.T) {
 		ID:                uuid.New(),
 		UserID:            userID,
 		PasswordHash:      "not-a-valid-hash",
-		PasswordAlgorithm: constants.UserCredentialsPasswordAlgorithmArgon2i,
+		PasswordAlgorithm: constants.UserCredentialsPasswordAlgorithmArgon2id,
 		ShouldChangePass:  false,
 		CreatedAt:         time.Now().UTC(),
 	}
 
 	repo.On("GetUserByID", mock.Anything, userID).Return(user, nil)
 	repo.On("GetUserCredentialsByUserID", mock.Anything, userID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(&entities.Tenant{ID: user.TenantID}, nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, userID).Return(nil, nil)
 	// ComparePassword will error because hash is not a valid argon2 hash,
 	// so the handler returns the error before reaching AddAuditLog.
 
@@ -210,3 +274,61 @@ func TestHandler_Reauthenticate_AddStepUpTokenDBError(t *testing.T) {
 	assert.Equal(t, "insert failed", err.Error())
 	repo.AssertExpectations(t)
 }
+
+func TestHandler_Reauthenticate_WrongPasswordCountsTowardsLockout(t *testing.T) {
+	repo := new(mockReauthRepo)
+	userID := uuid.New()
+	appID := uuid.New()
+	user := makeUser(userID)
+	tenant := newLockoutTenant(user.TenantID)
+
+	repo.On("GetUserByID", mock.Anything, userID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, userID).Return(makeCredentials(t, userID, tenant, "s3cret!"), nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(tenant, nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, userID).Return(nil, nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventFailedReauth
+	})).Return(nil)
+	repo.On("UpsertUserLockout", mock.Anything, mock.MatchedBy(func(lockout *entities.UserLockout) bool {
+		return lockout.UserID == userID && lockout.FailedAttempts == 1 && lockout.LockedUntil == nil
+	})).Return(nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{
+		UserID:        userID,
+		ApplicationID: appID,
+		Password:      "wrong-password",
+	})
+
+	var commitErr *repositories.CommitError
+	require.ErrorAs(t, err, &commitErr, "the failed attempt must be committed")
+	assert.ErrorIs(t, err, &errors.ErrCurrentPasswordIncorrect)
+	assert.Nil(t, resp)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_Reauthenticate_LockedAccountSkipsPasswordCheck(t *testing.T) {
+	repo := new(mockReauthRepo)
+	userID := uuid.New()
+	user := makeUser(userID)
+	tenant := newLockoutTenant(user.TenantID)
+	lockedUntil := time.Now().UTC().Add(time.Minute)
+	lockout := &entities.UserLockout{UserID: userID, LockoutCount: 1, LockedUntil: &lockedUntil}
+
+	repo.On("GetUserByID", mock.Anything, userID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, userID).Return(makeCredentials(t, userID, tenant, "s3cret!"), nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(tenant, nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, userID).Return(lockout, nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{
+		UserID:        userID,
+		ApplicationID: uuid.New(),
+		Password:      "s3cret!",
+	})
+
+	assert.ErrorIs(t, err, &errors.ErrAccountLocked)
+	assert.Nil(t, resp)
+	repo.AssertNotCalled(t, "AddStepUpToken", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
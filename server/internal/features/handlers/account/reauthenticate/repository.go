@@ -12,27 +12,39 @@ import (
 type IRepository interface {
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error)
 	GetUserCredentialsByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserCredentials, error)
+	UpdateUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
+	GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error)
 	GetMfaMethodByUserID(ctx context.Context, userID uuid.UUID, method string) (*entities.MfaMethod, error)
 	GetMfaTotpSecretValidationByUserID(ctx context.Context, userID uuid.UUID) (*entities.MfaUserSecret, error)
 	AddStepUpToken(ctx context.Context, token *entities.StepUpToken) error
 	RevokeStepUpTokensByUserID(ctx context.Context, userID uuid.UUID) error
 	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
+	GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error)
+	GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error)
+	UpsertUserLockout(ctx context.Context, lockout *entities.UserLockout) error
+	RemoveUserLockout(ctx context.Context, userID uuid.UUID) error
 }
 
 type Repository struct {
 	repositories.UserRepository
 	repositories.UserCredentialsRepository
+	repositories.TenantRepository
 	repositories.MfaRepository
 	repositories.StepUpTokenRepository
 	repositories.AuditLogRepository
+	repositories.UserProfileRepository
+	repositories.UserLockoutRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		UserRepository:            repositories.UserRepository{Store: q},
 		UserCredentialsRepository: repositories.UserCredentialsRepository{Store: q},
+		TenantRepository:          repositories.TenantRepository{Store: q},
 		MfaRepository:             repositories.MfaRepository{Store: q},
 		StepUpTokenRepository:     repositories.StepUpTokenRepository{Store: q},
 		AuditLogRepository:        repositories.AuditLogRepository{Store: q},
+		UserProfileRepository:     repositories.UserProfileRepository{Store: q},
+		UserLockoutRepository:     repositories.UserLockoutRepository{Store: q},
 	}
 }
@@ -3,5 +3,7 @@ package accountrefreshtoken
 import "github.com/google/uuid"
 
 type Command struct {
-	UserID uuid.UUID `json:"-"`
+	UserID    uuid.UUID `json:"-"`
+	SessionID uuid.UUID `json:"-"` // sid claim of the presented token
+	ClientID  uuid.UUID `json:"-"` // client_id claim of the presented token
 }
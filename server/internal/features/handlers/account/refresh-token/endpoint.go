@@ -14,7 +14,9 @@ type Endpoint struct {
 
 func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	command := Command{
-		UserID: http_router.GetUserIDFromContext(request.Context()),
+		UserID:    http_router.GetUserIDFromContext(request.Context()),
+		SessionID: http_router.GetSessionIDFromContext(request.Context()),
+		ClientID:  http_router.GetClientIDFromContext(request.Context()),
 	}
 
 	params := repositories.ParamsRs[Command, *Response, Handler]{
@@ -7,6 +7,7 @@ import (
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
 )
 
 type Handler struct {
@@ -29,7 +30,22 @@ func (h *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, &errors.ErrUserNotFound
 	}
 
-	// 2. Fetch user profile for name claims
+	// 2. Keep the token bound to its session, which must still be active
+	if command.SessionID != uuid.Nil {
+		session, err := h.repository.GetUserSessionByID(ctx, command.SessionID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil || !session.IsActive() {
+			return nil, &errors.ErrSessionRevoked
+		}
+
+		if err := h.repository.UpdateUserSessionLastActive(ctx, session.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Fetch user profile for name claims
 	profile, err := h.repository.GetUserProfileByID(ctx, user.ID)
 	if err != nil {
 		return nil, err
@@ -42,7 +58,21 @@ func (h *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		displayName = profile.DisplayName
 	}
 
-	// 3. Issue a fresh access token
+	// 4. Keep the roles and permissions of the application the token was issued to
+	var roles, permissions []string
+	if command.ClientID != uuid.Nil {
+		roles, err = application_utils.UserRoleNames(ctx, h.repository, user.ID, command.ClientID)
+		if err != nil {
+			return nil, err
+		}
+
+		permissions, err = application_utils.UserPermissionNames(ctx, h.repository, user.ID, command.ClientID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. Issue a fresh access token
 	claims := application_utils.JWTClaims{
 		UserID:      user.ID,
 		FirstName:   firstName,
@@ -50,6 +80,11 @@ func (h *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		DisplayName: displayName,
 		Email:       user.Email,
 		TenantID:    user.TenantID,
+		SessionID:   command.SessionID,
+		// Keep the audience of the refreshed token, e.g. the dashboard
+		ClientID:    command.ClientID,
+		Roles:       roles,
+		Permissions: permissions,
 	}
 
 	accessToken, err := application_utils.CreateToken(claims)
@@ -0,0 +1,125 @@
+package accountrefreshtoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Repository mock
+// ---------------------------------------------------------------------------
+
+type mockRefreshRepo struct{ mock.Mock }
+
+func (m *mockRefreshRepo) GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.TenantUser), args.Error(1)
+}
+
+func (m *mockRefreshRepo) GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserProfile), args.Error(1)
+}
+
+func (m *mockRefreshRepo) GetUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) (*entities.UserSession, error) {
+	args := m.Called(ctx, sessionID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserSession), args.Error(1)
+}
+
+func (m *mockRefreshRepo) UpdateUserSessionLastActive(ctx context.Context, sessionID uuid.UUID) error {
+	return m.Called(ctx, sessionID).Error(0)
+}
+
+func (m *mockRefreshRepo) GetUserRolesFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]entities.ApplicationRole, error) {
+	args := m.Called(ctx, userID, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.ApplicationRole), args.Error(1)
+}
+
+func (m *mockRefreshRepo) GetUserPermissionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, userID, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+var _ IRepository = (*mockRefreshRepo)(nil)
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+func setup(t *testing.T) (*mockRefreshRepo, *entities.TenantUser) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	repo := new(mockRefreshRepo)
+	user := &entities.TenantUser{
+		ID:        uuid.New(),
+		TenantID:  uuid.New(),
+		Email:     "user@example.com",
+		IsActive:  true,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	repo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+	repo.On("GetUserProfileByID", mock.Anything, user.ID).Return(nil, nil)
+
+	return repo, user
+}
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestHandler_RefreshKeepsRolesAndPermissions(t *testing.T) {
+	repo, user := setup(t)
+	clientID := uuid.New()
+
+	repo.On("GetUserRolesFromApplication", mock.Anything, user.ID, clientID).
+		Return([]entities.ApplicationRole{{Name: "admin"}}, nil)
+	repo.On("GetUserPermissionsFromApplication", mock.Anything, user.ID, clientID).
+		Return([]string{"invoices:read"}, nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{UserID: user.ID, ClientID: clientID})
+	require.NoError(t, err)
+
+	claims, err := application_utils.ParseTokenClaims(resp.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, clientID.String(), claims["client_id"])
+	assert.Equal(t, []any{"admin"}, claims[application_utils.RolesClaim()])
+	assert.Equal(t, []any{"invoices:read"}, claims["permissions"])
+}
+
+func TestHandler_RefreshWithoutClientHasNoRoles(t *testing.T) {
+	repo, user := setup(t)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{UserID: user.ID})
+	require.NoError(t, err)
+
+	claims, err := application_utils.ParseTokenClaims(resp.AccessToken)
+	require.NoError(t, err)
+	assert.Empty(t, claims[application_utils.RolesClaim()])
+	repo.AssertNotCalled(t, "GetUserRolesFromApplication", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -12,16 +12,26 @@ import (
 type IRepository interface {
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error)
 	GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error)
+	GetUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) (*entities.UserSession, error)
+	UpdateUserSessionLastActive(ctx context.Context, sessionID uuid.UUID) error
+	GetUserRolesFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]entities.ApplicationRole, error)
+	GetUserPermissionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]string, error)
 }
 
 type Repository struct {
 	repositories.UserRepository
 	repositories.UserProfileRepository
+	repositories.UserSessionRepository
+	repositories.RoleRepository
+	repositories.PermissionRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		UserRepository:        repositories.UserRepository{Store: q},
 		UserProfileRepository: repositories.UserProfileRepository{Store: q},
+		UserSessionRepository: repositories.UserSessionRepository{Store: q},
+		RoleRepository:        repositories.RoleRepository{Store: q},
+		PermissionRepository:  repositories.PermissionRepository{Store: q},
 	}
 }
@@ -3,6 +3,7 @@ package accountrevokeallsessions
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -20,7 +21,7 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	}
 
 	command.UserID = http_router.GetUserIDFromContext(request.Context())
-	command.IPAddress = request.RemoteAddr
+	command.IPAddress = clientip.RealIP(request)
 	command.UserAgent = request.UserAgent()
 
 	params := repositories.ParamsRs[Command, *Response, Handler]{
@@ -3,6 +3,7 @@ package accountrevokesession
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/go-chi/chi/v5"
@@ -24,7 +25,7 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	command := Command{
 		UserID:    http_router.GetUserIDFromContext(request.Context()),
 		SessionID: sessionID,
-		IPAddress: request.RemoteAddr,
+		IPAddress: clientip.RealIP(request),
 		UserAgent: request.UserAgent(),
 	}
 
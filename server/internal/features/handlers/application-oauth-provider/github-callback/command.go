@@ -1,8 +1,10 @@
 package githubcallback
 
 type Command struct {
-	Code  string
-	State string
+	Code      string
+	State     string
+	IPAddress string
+	UserAgent string
 	// StoredState      string
 	// StoredProviderID uuid.UUID
 }
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"os"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -40,8 +41,10 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	state := parsedUrl.Query().Get("state")
 
 	command := Command{
-		Code:  code,
-		State: state,
+		Code:      code,
+		State:     state,
+		IPAddress: clientip.RealIP(request),
+		UserAgent: request.UserAgent(),
 	}
 
 	params := repositories.ParamsRs[Command, *ServiceResponse, Handler]{
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
 	mfa_policy "github.com/gate-keeper/internal/domain/services"
@@ -194,6 +195,14 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 			return nil, err
 		}
 
+		linkedDetails := "provider: github, email: " + externalIdentity.Email
+		linkedLog := entities.NewAuditLog(newUser.ID, oauthProvider.ApplicationID,
+			constants.AuditEventOAuthAccountLinked, request.IPAddress, request.UserAgent, "success", &linkedDetails)
+
+		if err := s.repository.AddAuditLog(ctx, linkedLog); err != nil {
+			return nil, err
+		}
+
 		currentUser = newUser
 	}
 
@@ -233,6 +242,14 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 			return nil, err
 		}
 		if mfaChallenge != nil {
+			challengeDetails := "method: " + mfaChallenge.MfaType + ", provider: github"
+			challengeLog := entities.NewAuditLog(currentUser.ID, oauthProvider.ApplicationID,
+				constants.AuditEventMfaChallengeIssued, request.IPAddress, request.UserAgent, "success", &challengeDetails)
+
+			if err := s.repository.AddAuditLog(ctx, challengeLog); err != nil {
+				return nil, err
+			}
+
 			return &ServiceResponse{
 				RedirectURL:               os.Getenv("CLIENT_APPLICATION_URL") + "/api/callback/github",
 				UserData:                  &gitHubUserData,
@@ -265,6 +282,9 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 		return nil, err
 	}
 
+	authorizationCode.IPAddress = &request.IPAddress
+	authorizationCode.UserAgent = &request.UserAgent
+
 	if err := s.repository.RemoveAuthorizationCode(ctx, currentUser.ID, oauthProvider.ApplicationID); err != nil {
 		return nil, err
 	}
@@ -273,6 +293,14 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 		return nil, err
 	}
 
+	loginDetails := "provider: github"
+	loginLog := entities.NewAuditLog(currentUser.ID, oauthProvider.ApplicationID,
+		constants.AuditEventLoginSucceeded, request.IPAddress, request.UserAgent, "success", &loginDetails)
+
+	if err := s.repository.AddAuditLog(ctx, loginLog); err != nil {
+		return nil, err
+	}
+
 	return &ServiceResponse{
 		RedirectURL:               os.Getenv("CLIENT_APPLICATION_URL") + "/api/callback/github",
 		UserData:                  &gitHubUserData,
@@ -28,6 +28,7 @@ type IRepository interface {
 	GetWebAuthnCredentialsByMfaMethodID(ctx context.Context, mfaMethodID uuid.UUID) ([]entities.MfaPasskeyCredentials, error)
 	AddMfaPasskeySession(ctx context.Context, session *entities.MfaPasskeySession) error
 	GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error)
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
@@ -38,6 +39,7 @@ type Repository struct {
 	repositories.AuthorizationCodeRepository
 	repositories.ApplicationRepository
 	repositories.MfaRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -49,5 +51,6 @@ func NewRepository(q *pgstore.Queries) Repository {
 		AuthorizationCodeRepository: repositories.AuthorizationCodeRepository{Store: q},
 		ApplicationRepository:       repositories.ApplicationRepository{Store: q},
 		MfaRepository:               repositories.MfaRepository{Store: q},
+		AuditLogRepository:          repositories.AuditLogRepository{Store: q},
 	}
 }
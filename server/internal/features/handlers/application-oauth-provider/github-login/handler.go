@@ -3,8 +3,10 @@ package githublogin
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -33,6 +35,18 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 		return nil, &errors.ErrOAuthProviderNotFound
 	}
 
+	// The authorization code issued after the provider callback is sent to
+	// ClientRedirectUri, so it must be registered by the application.
+	registeredRedirectURIs, err := s.repository.ListRedirectURIs(ctx, oauthProvider.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !services.MatchRedirectURI(registeredRedirectURIs, constants.RedirectURIKindLogin, request.ClientRedirectUri) {
+		return nil, &errors.ErrRedirectURINotRegistered
+	}
+
 	externalOauthState := entities.AddExternalOAuthState(
 		state,
 		oauthProvider.ID,
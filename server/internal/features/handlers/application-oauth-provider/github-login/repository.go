@@ -11,14 +11,17 @@ import (
 type IRepository interface {
 	GetApplicationOAuthProviderByID(ctx context.Context, applicationOauthProviderID uuid.UUID) (*entities.ApplicationOAuthProvider, error)
 	AddExternalOAuthState(ctx context.Context, state *entities.ExternalOAuthState) error
+	ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error)
 }
 
 type Repository struct {
 	repositories.OAuthProviderRepository
+	repositories.RedirectURIRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		OAuthProviderRepository: repositories.OAuthProviderRepository{Store: q},
+		RedirectURIRepository:   repositories.RedirectURIRepository{Store: q},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
 	mfa_policy "github.com/gate-keeper/internal/domain/services"
@@ -155,6 +156,14 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 			return nil, err
 		}
 
+		linkedDetails := "provider: google, email: " + externalIdentity.Email
+		linkedLog := entities.NewAuditLog(newUser.ID, oauthProvider.ApplicationID,
+			constants.AuditEventOAuthAccountLinked, request.IPAddress, request.UserAgent, "success", &linkedDetails)
+
+		if err := s.repository.AddAuditLog(ctx, linkedLog); err != nil {
+			return nil, err
+		}
+
 		currentUser = newUser
 	}
 
@@ -196,6 +205,14 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 			return nil, err
 		}
 		if mfaChallenge != nil {
+			challengeDetails := "method: " + mfaChallenge.MfaType + ", provider: google"
+			challengeLog := entities.NewAuditLog(currentUser.ID, oauthProvider.ApplicationID,
+				constants.AuditEventMfaChallengeIssued, request.IPAddress, request.UserAgent, "success", &challengeDetails)
+
+			if err := s.repository.AddAuditLog(ctx, challengeLog); err != nil {
+				return nil, err
+			}
+
 			return &ServiceResponse{
 				RedirectURL:               os.Getenv("CLIENT_APPLICATION_URL") + "/api/callback/google",
 				UserData:                  &googleUserData,
@@ -228,6 +245,9 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 		return nil, err
 	}
 
+	authorizationCode.IPAddress = &request.IPAddress
+	authorizationCode.UserAgent = &request.UserAgent
+
 	if err := s.repository.RemoveAuthorizationCode(ctx, currentUser.ID, oauthProvider.ApplicationID); err != nil {
 		return nil, err
 	}
@@ -236,6 +256,14 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 		return nil, err
 	}
 
+	loginDetails := "provider: google"
+	loginLog := entities.NewAuditLog(currentUser.ID, oauthProvider.ApplicationID,
+		constants.AuditEventLoginSucceeded, request.IPAddress, request.UserAgent, "success", &loginDetails)
+
+	if err := s.repository.AddAuditLog(ctx, loginLog); err != nil {
+		return nil, err
+	}
+
 	return &ServiceResponse{
 		RedirectURL:               os.Getenv("CLIENT_APPLICATION_URL") + "/api/callback/google",
 		UserData:                  &googleUserData,
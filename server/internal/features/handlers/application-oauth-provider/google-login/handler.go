@@ -3,8 +3,10 @@ package googlelogin
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
@@ -34,6 +36,18 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*ServiceRespons
 		return nil, &errors.ErrOAuthProviderNotFound
 	}
 
+	// The authorization code issued after the provider callback is sent to
+	// ClientRedirectUri, so it must be registered by the application.
+	registeredRedirectURIs, err := s.repository.ListRedirectURIs(ctx, oauthProvider.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !services.MatchRedirectURI(registeredRedirectURIs, constants.RedirectURIKindLogin, request.ClientRedirectUri) {
+		return nil, &errors.ErrRedirectURINotRegistered
+	}
+
 	codeVerifier, err := application_utils.GenerateCodeVerifier()
 
 	if err != nil {
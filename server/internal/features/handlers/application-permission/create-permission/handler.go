@@ -0,0 +1,62 @@
+package createpermission
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, request Command) (*Response, error) {
+	if !services.ValidatePermissionName(request.Name) {
+		return nil, &errors.ErrInvalidPermissionName
+	}
+
+	isApplicationExists, err := s.repository.CheckIfApplicationExists(ctx, request.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !isApplicationExists {
+		return nil, &errors.ErrApplicationNotFound
+	}
+
+	existingPermission, err := s.repository.GetPermissionByName(ctx, request.ApplicationID, request.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if existingPermission != nil {
+		return nil, &errors.ErrPermissionAlreadyExists
+	}
+
+	newPermission := entities.NewApplicationPermission(request.ApplicationID, request.Name, request.Description)
+
+	if err := s.repository.AddPermission(ctx, newPermission); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		ID:            newPermission.ID,
+		Name:          newPermission.Name,
+		Description:   newPermission.Description,
+		ApplicationID: newPermission.ApplicationID,
+		CreatedAt:     newPermission.CreatedAt,
+		UpdatedAt:     newPermission.UpdatedAt,
+	}, nil
+}
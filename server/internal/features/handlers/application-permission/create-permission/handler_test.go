@@ -0,0 +1,115 @@
+package createpermission
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Repository mock
+// ---------------------------------------------------------------------------
+
+type mockCreatePermissionRepo struct{ mock.Mock }
+
+func (m *mockCreatePermissionRepo) AddPermission(ctx context.Context, permission *entities.ApplicationPermission) error {
+	return m.Called(ctx, permission).Error(0)
+}
+
+func (m *mockCreatePermissionRepo) GetPermissionByName(ctx context.Context, applicationID uuid.UUID, name string) (*entities.ApplicationPermission, error) {
+	args := m.Called(ctx, applicationID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ApplicationPermission), args.Error(1)
+}
+
+func (m *mockCreatePermissionRepo) CheckIfApplicationExists(ctx context.Context, applicationID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, applicationID)
+	return args.Bool(0), args.Error(1)
+}
+
+// Compile-time check
+var _ IRepository = (*mockCreatePermissionRepo)(nil)
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestHandler_CreatePermission_Success(t *testing.T) {
+	repo := new(mockCreatePermissionRepo)
+	appID, _ := uuid.NewV7()
+
+	repo.On("CheckIfApplicationExists", mock.Anything, appID).Return(true, nil)
+	repo.On("GetPermissionByName", mock.Anything, appID, "orders:write").Return(nil, nil)
+	repo.On("AddPermission", mock.Anything, mock.AnythingOfType("*entities.ApplicationPermission")).Return(nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{
+		ApplicationID: appID,
+		Name:          "orders:write",
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.NotEqual(t, uuid.Nil, resp.ID)
+	assert.Equal(t, "orders:write", resp.Name)
+	assert.Equal(t, appID, resp.ApplicationID)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_CreatePermission_InvalidName(t *testing.T) {
+	repo := new(mockCreatePermissionRepo)
+	appID, _ := uuid.NewV7()
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{
+		ApplicationID: appID,
+		Name:          "orders write",
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "ErrInvalidPermissionName", err.Error())
+	repo.AssertNotCalled(t, "AddPermission", mock.Anything, mock.Anything)
+}
+
+func TestHandler_CreatePermission_ApplicationNotFound(t *testing.T) {
+	repo := new(mockCreatePermissionRepo)
+	appID, _ := uuid.NewV7()
+
+	repo.On("CheckIfApplicationExists", mock.Anything, appID).Return(false, nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{
+		ApplicationID: appID,
+		Name:          "orders:write",
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "ErrApplicationNotFound", err.Error())
+	repo.AssertNotCalled(t, "AddPermission", mock.Anything, mock.Anything)
+}
+
+func TestHandler_CreatePermission_AlreadyExists(t *testing.T) {
+	repo := new(mockCreatePermissionRepo)
+	appID, _ := uuid.NewV7()
+
+	repo.On("CheckIfApplicationExists", mock.Anything, appID).Return(true, nil)
+	repo.On("GetPermissionByName", mock.Anything, appID, "orders:write").
+		Return(entities.NewApplicationPermission(appID, "orders:write", nil), nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{
+		ApplicationID: appID,
+		Name:          "orders:write",
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "ErrPermissionAlreadyExists", err.Error())
+	repo.AssertNotCalled(t, "AddPermission", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,28 @@
+package createpermission
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	AddPermission(ctx context.Context, permission *entities.ApplicationPermission) error
+	GetPermissionByName(ctx context.Context, applicationID uuid.UUID, name string) (*entities.ApplicationPermission, error)
+	CheckIfApplicationExists(ctx context.Context, applicationID uuid.UUID) (bool, error)
+}
+
+type Repository struct {
+	repositories.PermissionRepository
+	repositories.ApplicationRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		PermissionRepository:  repositories.PermissionRepository{Store: q},
+		ApplicationRepository: repositories.ApplicationRepository{Store: q},
+	}
+}
@@ -0,0 +1,8 @@
+package deletepermission
+
+import "github.com/google/uuid"
+
+type Command struct {
+	PermissionID  uuid.UUID `json:"permissionId" validate:"required,uuid"`
+	ApplicationID uuid.UUID `json:"applicationId" validate:"required,uuid"`
+}
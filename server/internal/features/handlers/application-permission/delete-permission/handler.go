@@ -0,0 +1,34 @@
+package deletepermission
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, request Command) error {
+	permission, err := s.repository.GetPermissionByID(ctx, request.PermissionID, request.ApplicationID)
+
+	if err != nil {
+		return err
+	}
+
+	if permission == nil {
+		return &errors.ErrPermissionNotFound
+	}
+
+	// role_permission rows are removed by the ON DELETE CASCADE constraint.
+	return s.repository.RemovePermission(ctx, permission.ID, permission.ApplicationID)
+}
@@ -0,0 +1,25 @@
+package deletepermission
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetPermissionByID(ctx context.Context, permissionID, applicationID uuid.UUID) (*entities.ApplicationPermission, error)
+	RemovePermission(ctx context.Context, permissionID, applicationID uuid.UUID) error
+}
+
+type Repository struct {
+	repositories.PermissionRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		PermissionRepository: repositories.PermissionRepository{Store: q},
+	}
+}
@@ -0,0 +1,56 @@
+package listpermissions
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	ListPermissionsFromApplicationPaged(ctx context.Context, applicationID uuid.UUID, limit, offset int) (*Response, error)
+	CheckIfApplicationExists(ctx context.Context, applicationID uuid.UUID) (bool, error)
+}
+
+type Repository struct {
+	repositories.ApplicationRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		ApplicationRepository: repositories.ApplicationRepository{Store: q},
+	}
+}
+
+func (r Repository) ListPermissionsFromApplicationPaged(ctx context.Context, applicationID uuid.UUID, limit, offset int) (*Response, error) {
+	permissions, err := r.ApplicationRepository.Store.ListPermissionsFromApplicationPaged(ctx, pgstore.ListPermissionsFromApplicationPagedParams{
+		ApplicationID: applicationID,
+		Limit:         int32(limit),
+		Offset:        int32(offset),
+	})
+
+	if err != nil && err != repositories.ErrNoRows {
+		return nil, err
+	}
+
+	totalCount := 0
+	if len(permissions) > 0 {
+		totalCount = int(permissions[0].TotalCount)
+	}
+
+	result := Response{
+		TotalCount: totalCount,
+		Data:       []PermissionResponse{},
+	}
+
+	for _, permission := range permissions {
+		result.Data = append(result.Data, PermissionResponse{
+			ID:          permission.ID,
+			Name:        permission.Name,
+			Description: permission.Description,
+		})
+	}
+
+	return &result, nil
+}
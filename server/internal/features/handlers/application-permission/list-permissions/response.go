@@ -0,0 +1,16 @@
+package listpermissions
+
+import "github.com/google/uuid"
+
+type PermissionResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+}
+
+type Response struct {
+	TotalCount int                  `json:"totalCount"`
+	Page       int                  `json:"page"`
+	PageSize   int                  `json:"pageSize"`
+	Data       []PermissionResponse `json:"data"`
+}
@@ -0,0 +1,15 @@
+package updatepermission
+
+import "github.com/google/uuid"
+
+type Command struct {
+	ApplicationID uuid.UUID
+	PermissionID  uuid.UUID
+	Name          string
+	Description   *string
+}
+
+type RequestBody struct {
+	Name        string  `json:"name" validate:"required"`
+	Description *string `json:"description"`
+}
@@ -0,0 +1,58 @@
+package updatepermission
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	var requestBody RequestBody
+
+	if err := http_router.ParseBodyToSchema(&requestBody, request); err != nil {
+		panic(err)
+	}
+
+	applicationIDString := chi.URLParam(request, "applicationID")
+	applicationIdUUID, err := uuid.Parse(applicationIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	permissionIDString := chi.URLParam(request, "permissionID")
+	permissionIdUUID, err := uuid.Parse(permissionIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	command := Command{
+		ApplicationID: applicationIdUUID,
+		PermissionID:  permissionIdUUID,
+		Name:          requestBody.Name,
+		Description:   requestBody.Description,
+	}
+
+	params := repositories.ParamsRs[Command, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: command,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	http_router.SendJson(writter, response, http.StatusOK)
+}
@@ -0,0 +1,68 @@
+package updatepermission
+
+import (
+	"context"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, request Command) (*Response, error) {
+	if !services.ValidatePermissionName(request.Name) {
+		return nil, &errors.ErrInvalidPermissionName
+	}
+
+	permission, err := s.repository.GetPermissionByID(ctx, request.PermissionID, request.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if permission == nil {
+		return nil, &errors.ErrPermissionNotFound
+	}
+
+	if permission.Name != request.Name {
+		existingPermission, err := s.repository.GetPermissionByName(ctx, request.ApplicationID, request.Name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if existingPermission != nil {
+			return nil, &errors.ErrPermissionAlreadyExists
+		}
+	}
+
+	now := time.Now().UTC()
+
+	permission.Name = request.Name
+	permission.Description = request.Description
+	permission.UpdatedAt = &now
+
+	if err := s.repository.UpdatePermission(ctx, permission); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		ID:            permission.ID,
+		Name:          permission.Name,
+		Description:   permission.Description,
+		ApplicationID: permission.ApplicationID,
+		CreatedAt:     permission.CreatedAt,
+		UpdatedAt:     permission.UpdatedAt,
+	}, nil
+}
@@ -29,7 +29,17 @@ func (s *Handler) Handler(ctx context.Context, request Command) error {
 		return &errors.ErrApplicationNotFound
 	}
 
-	if err := s.repository.RemoveRole(ctx, request.RoleID); err != nil {
+	role, err := s.repository.GetRoleByID(ctx, request.RoleID, request.ApplicationID)
+
+	if err != nil {
+		return err
+	}
+
+	if role == nil {
+		return &errors.ErrRoleNotFound
+	}
+
+	if err := s.repository.RemoveRole(ctx, role.ID); err != nil {
 		return err
 	}
 
@@ -2,6 +2,7 @@ package deleterole
 
 import (
 	"context"
+	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 	"github.com/google/uuid"
@@ -9,6 +10,7 @@ import (
 
 type IRepository interface {
 	CheckIfApplicationExists(ctx context.Context, applicationID uuid.UUID) (bool, error)
+	GetRoleByID(ctx context.Context, roleID, applicationID uuid.UUID) (*entities.ApplicationRole, error)
 	RemoveRole(ctx context.Context, roleID uuid.UUID) error
 }
 
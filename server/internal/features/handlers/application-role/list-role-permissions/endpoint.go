@@ -0,0 +1,50 @@
+package listrolepermissions
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	applicationIDString := chi.URLParam(request, "applicationID")
+	applicationIdUUID, err := uuid.Parse(applicationIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	roleIDString := chi.URLParam(request, "roleID")
+	roleIdUUID, err := uuid.Parse(roleIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	query := Query{
+		ApplicationID: applicationIdUUID,
+		RoleID:        roleIdUUID,
+	}
+
+	params := repositories.ParamsRs[Query, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: query,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	http_router.SendJson(writter, response, http.StatusOK)
+}
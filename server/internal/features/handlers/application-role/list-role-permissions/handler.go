@@ -0,0 +1,52 @@
+package listrolepermissions
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, request Query) (*Response, error) {
+	role, err := s.repository.GetRoleByID(ctx, request.RoleID, request.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if role == nil {
+		return nil, &errors.ErrRoleNotFound
+	}
+
+	permissions, err := s.repository.ListRolePermissions(ctx, role.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{
+		RoleID:      role.ID,
+		Permissions: []PermissionResponse{},
+	}
+
+	for _, permission := range permissions {
+		response.Permissions = append(response.Permissions, PermissionResponse{
+			ID:          permission.ID,
+			Name:        permission.Name,
+			Description: permission.Description,
+		})
+	}
+
+	return &response, nil
+}
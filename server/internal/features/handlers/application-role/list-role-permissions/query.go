@@ -0,0 +1,8 @@
+package listrolepermissions
+
+import "github.com/google/uuid"
+
+type Query struct {
+	ApplicationID uuid.UUID `json:"applicationId" validate:"required,uuid"`
+	RoleID        uuid.UUID `json:"roleId" validate:"required,uuid"`
+}
@@ -0,0 +1,14 @@
+package listrolepermissions
+
+import "github.com/google/uuid"
+
+type PermissionResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+}
+
+type Response struct {
+	RoleID      uuid.UUID            `json:"roleId"`
+	Permissions []PermissionResponse `json:"permissions"`
+}
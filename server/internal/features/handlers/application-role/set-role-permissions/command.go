@@ -0,0 +1,13 @@
+package setrolepermissions
+
+import "github.com/google/uuid"
+
+type Command struct {
+	ApplicationID uuid.UUID
+	RoleID        uuid.UUID
+	PermissionIDs []uuid.UUID
+}
+
+type RequestBody struct {
+	PermissionIDs []uuid.UUID `json:"permissionIds" validate:"required"`
+}
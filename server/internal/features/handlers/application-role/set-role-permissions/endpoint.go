@@ -0,0 +1,57 @@
+package setrolepermissions
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	var requestBody RequestBody
+
+	if err := http_router.ParseBodyToSchema(&requestBody, request); err != nil {
+		panic(err)
+	}
+
+	applicationIDString := chi.URLParam(request, "applicationID")
+	applicationIdUUID, err := uuid.Parse(applicationIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	roleIDString := chi.URLParam(request, "roleID")
+	roleIdUUID, err := uuid.Parse(roleIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	command := Command{
+		ApplicationID: applicationIdUUID,
+		RoleID:        roleIdUUID,
+		PermissionIDs: requestBody.PermissionIDs,
+	}
+
+	params := repositories.ParamsRs[Command, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: command,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	http_router.SendJson(writter, response, http.StatusOK)
+}
@@ -0,0 +1,72 @@
+package setrolepermissions
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler replaces the permissions attached to the role. Every permission must
+// belong to the role's application.
+func (s *Handler) Handler(ctx context.Context, request Command) (*Response, error) {
+	role, err := s.repository.GetRoleByID(ctx, request.RoleID, request.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if role == nil {
+		return nil, &errors.ErrRoleNotFound
+	}
+
+	response := Response{
+		RoleID:      role.ID,
+		Permissions: []PermissionResponse{},
+	}
+
+	seen := make(map[uuid.UUID]bool, len(request.PermissionIDs))
+	permissionIDs := make([]uuid.UUID, 0, len(request.PermissionIDs))
+
+	for _, permissionID := range request.PermissionIDs {
+		if seen[permissionID] {
+			continue
+		}
+
+		seen[permissionID] = true
+
+		permission, err := s.repository.GetPermissionByID(ctx, permissionID, request.ApplicationID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if permission == nil {
+			return nil, &errors.ErrPermissionNotFound
+		}
+
+		permissionIDs = append(permissionIDs, permission.ID)
+		response.Permissions = append(response.Permissions, PermissionResponse{
+			ID:   permission.ID,
+			Name: permission.Name,
+		})
+	}
+
+	if err := s.repository.ReplaceRolePermissions(ctx, role.ID, permissionIDs); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,130 @@
+package setrolepermissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Repository mock
+// ---------------------------------------------------------------------------
+
+type mockSetRolePermissionsRepo struct{ mock.Mock }
+
+func (m *mockSetRolePermissionsRepo) GetRoleByID(ctx context.Context, roleID, applicationID uuid.UUID) (*entities.ApplicationRole, error) {
+	args := m.Called(ctx, roleID, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ApplicationRole), args.Error(1)
+}
+
+func (m *mockSetRolePermissionsRepo) GetPermissionByID(ctx context.Context, permissionID, applicationID uuid.UUID) (*entities.ApplicationPermission, error) {
+	args := m.Called(ctx, permissionID, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ApplicationPermission), args.Error(1)
+}
+
+func (m *mockSetRolePermissionsRepo) ReplaceRolePermissions(ctx context.Context, roleID uuid.UUID, permissionIDs []uuid.UUID) error {
+	return m.Called(ctx, roleID, permissionIDs).Error(0)
+}
+
+// Compile-time check
+var _ IRepository = (*mockSetRolePermissionsRepo)(nil)
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestHandler_SetRolePermissions_Success(t *testing.T) {
+	repo := new(mockSetRolePermissionsRepo)
+	appID, _ := uuid.NewV7()
+	role := entities.NewApplicationRole(appID, "Editor", nil)
+	read := entities.NewApplicationPermission(appID, "posts:read", nil)
+	write := entities.NewApplicationPermission(appID, "posts:write", nil)
+
+	repo.On("GetRoleByID", mock.Anything, role.ID, appID).Return(role, nil)
+	repo.On("GetPermissionByID", mock.Anything, read.ID, appID).Return(read, nil)
+	repo.On("GetPermissionByID", mock.Anything, write.ID, appID).Return(write, nil)
+	repo.On("ReplaceRolePermissions", mock.Anything, role.ID, []uuid.UUID{read.ID, write.ID}).Return(nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{
+		ApplicationID: appID,
+		RoleID:        role.ID,
+		PermissionIDs: []uuid.UUID{read.ID, write.ID, read.ID},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, role.ID, resp.RoleID)
+	assert.Len(t, resp.Permissions, 2)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_SetRolePermissions_EmptyListClearsPermissions(t *testing.T) {
+	repo := new(mockSetRolePermissionsRepo)
+	appID, _ := uuid.NewV7()
+	role := entities.NewApplicationRole(appID, "Editor", nil)
+
+	repo.On("GetRoleByID", mock.Anything, role.ID, appID).Return(role, nil)
+	repo.On("ReplaceRolePermissions", mock.Anything, role.ID, []uuid.UUID{}).Return(nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{
+		ApplicationID: appID,
+		RoleID:        role.ID,
+		PermissionIDs: []uuid.UUID{},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Permissions)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_SetRolePermissions_RoleNotFound(t *testing.T) {
+	repo := new(mockSetRolePermissionsRepo)
+	appID, _ := uuid.NewV7()
+	roleID, _ := uuid.NewV7()
+
+	repo.On("GetRoleByID", mock.Anything, roleID, appID).Return(nil, nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{
+		ApplicationID: appID,
+		RoleID:        roleID,
+		PermissionIDs: []uuid.UUID{uuid.New()},
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "ErrRoleNotFound", err.Error())
+	repo.AssertNotCalled(t, "ReplaceRolePermissions", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_SetRolePermissions_PermissionFromAnotherApplication(t *testing.T) {
+	repo := new(mockSetRolePermissionsRepo)
+	appID, _ := uuid.NewV7()
+	role := entities.NewApplicationRole(appID, "Editor", nil)
+	foreignPermissionID, _ := uuid.NewV7()
+
+	repo.On("GetRoleByID", mock.Anything, role.ID, appID).Return(role, nil)
+	repo.On("GetPermissionByID", mock.Anything, foreignPermissionID, appID).Return(nil, nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{
+		ApplicationID: appID,
+		RoleID:        role.ID,
+		PermissionIDs: []uuid.UUID{foreignPermissionID},
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "ErrPermissionNotFound", err.Error())
+	repo.AssertNotCalled(t, "ReplaceRolePermissions", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,28 @@
+package setrolepermissions
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetRoleByID(ctx context.Context, roleID, applicationID uuid.UUID) (*entities.ApplicationRole, error)
+	GetPermissionByID(ctx context.Context, permissionID, applicationID uuid.UUID) (*entities.ApplicationPermission, error)
+	ReplaceRolePermissions(ctx context.Context, roleID uuid.UUID, permissionIDs []uuid.UUID) error
+}
+
+type Repository struct {
+	repositories.RoleRepository
+	repositories.PermissionRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		RoleRepository:       repositories.RoleRepository{Store: q},
+		PermissionRepository: repositories.PermissionRepository{Store: q},
+	}
+}
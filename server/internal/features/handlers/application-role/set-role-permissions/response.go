@@ -0,0 +1,13 @@
+package setrolepermissions
+
+import "github.com/google/uuid"
+
+type PermissionResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type Response struct {
+	RoleID      uuid.UUID            `json:"roleId"`
+	Permissions []PermissionResponse `json:"permissions"`
+}
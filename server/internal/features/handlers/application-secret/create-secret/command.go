@@ -10,6 +10,9 @@ type Command struct {
 	ApplicationID uuid.UUID  `json:"applicationId" validate:"required,uuid"`
 	Name          string     `json:"name" validate:"required"`
 	ExpiresAt     *time.Time `json:"expiresAt"`
+	AdminID       uuid.UUID  `json:"-"` // injected server-side
+	IPAddress     string     `json:"-"` // injected server-side
+	UserAgent     string     `json:"-"` // injected server-side
 }
 
 type RequestBody struct {
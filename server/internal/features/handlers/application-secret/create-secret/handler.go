@@ -3,8 +3,10 @@ package createsecret
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -36,6 +38,19 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 		return nil, err
 	}
 
+	details, err := application_utils.AuditLogChanges(newSecret.ID, nil, application_utils.ApplicationSecretAuditLogFields(newSecret))
+
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog := entities.NewAuditLog(request.AdminID, request.ApplicationID,
+		constants.AuditEventApplicationSecretCreated, request.IPAddress, request.UserAgent, "success", details)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
 	return &Response{
 		ID:            newSecret.ID,
 		ApplicationID: newSecret.ApplicationID,
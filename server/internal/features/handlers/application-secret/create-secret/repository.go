@@ -11,16 +11,19 @@ import (
 type IRepository interface {
 	CheckIfApplicationExists(ctx context.Context, applicationID uuid.UUID) (bool, error)
 	AddSecret(ctx context.Context, secret *entities.ApplicationSecret) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.ApplicationRepository
 	repositories.SecretRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		ApplicationRepository: repositories.ApplicationRepository{Store: q},
 		SecretRepository: repositories.SecretRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
 	}
 }
@@ -7,4 +7,7 @@ import (
 type Command struct {
 	SecretID      uuid.UUID `json:"secretId" validate:"required,uuid"`
 	ApplicationID uuid.UUID `json:"applicationId" validate:"required,uuid"`
+	AdminID       uuid.UUID `json:"-"` // injected server-side
+	IPAddress     string    `json:"-"` // injected server-side
+	UserAgent     string    `json:"-"` // injected server-side
 }
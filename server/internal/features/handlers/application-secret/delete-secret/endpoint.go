@@ -3,6 +3,7 @@ package deletesecret
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/go-chi/chi"
@@ -32,6 +33,9 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	command := Command{
 		SecretID:      secretIdUUID,
 		ApplicationID: applicationIdUUID,
+		AdminID:       http_router.GetUserIDFromContext(request.Context()),
+		IPAddress:     clientip.RealIP(request),
+		UserAgent:     request.UserAgent(),
 	}
 
 	params := repositories.Params[Command, Handler]{
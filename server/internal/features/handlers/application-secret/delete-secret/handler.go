@@ -2,8 +2,13 @@ package deletesecret
 
 import (
 	"context"
+	"slices"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
 
 	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -29,9 +34,30 @@ func (s *Handler) Handler(ctx context.Context, request Command) error {
 		return &errors.ErrApplicationNotFound
 	}
 
+	secrets, err := s.repository.ListSecretsFromApplication(ctx, request.ApplicationID)
+
+	if err != nil {
+		return err
+	}
+
+	secretIndex := slices.IndexFunc(*secrets, func(secret entities.ApplicationSecret) bool { return secret.ID == request.SecretID })
+
+	if secretIndex == -1 {
+		return &errors.ErrAplicationSecretNotFound
+	}
+
 	if err := s.repository.RemoveSecret(ctx, request.SecretID); err != nil {
 		return err
 	}
 
-	return nil
+	details, err := application_utils.AuditLogChanges(request.SecretID, application_utils.ApplicationSecretAuditLogFields(&(*secrets)[secretIndex]), nil)
+
+	if err != nil {
+		return err
+	}
+
+	auditLog := entities.NewAuditLog(request.AdminID, request.ApplicationID,
+		constants.AuditEventApplicationSecretDeleted, request.IPAddress, request.UserAgent, "success", details)
+
+	return s.repository.AddAuditLog(ctx, auditLog)
 }
@@ -2,6 +2,7 @@ package deletesecret
 
 import (
 	"context"
+	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 	"github.com/google/uuid"
@@ -9,17 +10,21 @@ import (
 
 type IRepository interface {
 	CheckIfApplicationExists(ctx context.Context, applicationID uuid.UUID) (bool, error)
+	ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error)
 	RemoveSecret(ctx context.Context, secretID uuid.UUID) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.ApplicationRepository
 	repositories.SecretRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		ApplicationRepository: repositories.ApplicationRepository{Store: q},
 		SecretRepository: repositories.SecretRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
 	}
 }
@@ -0,0 +1,15 @@
+package configureclientcredentials
+
+import "github.com/google/uuid"
+
+type Command struct {
+	TenantID      uuid.UUID
+	ApplicationID uuid.UUID
+	Scopes        []string
+	RoleIDs       []uuid.UUID
+}
+
+type RequestBody struct {
+	Scopes  []string    `json:"scopes"`
+	RoleIDs []uuid.UUID `json:"roleIds"`
+}
@@ -0,0 +1,99 @@
+package configureclientcredentials
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, command Command) (*Response, error) {
+	application, err := s.repository.GetApplicationByID(ctx, command.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if application == nil || application.TenantID != command.TenantID {
+		return nil, &errors.ErrApplicationNotFound
+	}
+
+	applicationRoles, err := s.repository.ListRolesFromApplication(ctx, application.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Only roles defined by the application itself can be granted to it.
+	roles := make([]entities.ApplicationRole, 0, len(command.RoleIDs))
+	for _, roleID := range command.RoleIDs {
+		index := slices.IndexFunc(*applicationRoles, func(role entities.ApplicationRole) bool {
+			return role.ID == roleID
+		})
+
+		if index == -1 {
+			return nil, &errors.ErrRoleNotFound
+		}
+
+		if !slices.ContainsFunc(roles, func(role entities.ApplicationRole) bool { return role.ID == roleID }) {
+			roles = append(roles, (*applicationRoles)[index])
+		}
+	}
+
+	scopes := make([]string, 0, len(command.Scopes))
+	for _, scope := range command.Scopes {
+		scope = strings.TrimSpace(scope)
+		if scope != "" && !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	credentials, err := s.repository.GetApplicationClientCredentials(ctx, application.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials == nil {
+		credentials = entities.NewApplicationClientCredentials(application.ID, scopes)
+	} else {
+		now := time.Now().UTC()
+		credentials.Scopes = scopes
+		credentials.UpdatedAt = &now
+	}
+
+	credentials.Roles = roles
+
+	if err := s.repository.SaveApplicationClientCredentials(ctx, credentials); err != nil {
+		return nil, err
+	}
+
+	response := &Response{
+		ApplicationID: credentials.ApplicationID,
+		Scopes:        credentials.Scopes,
+		Roles:         make([]RoleResponse, 0, len(roles)),
+		CreatedAt:     credentials.CreatedAt,
+		UpdatedAt:     credentials.UpdatedAt,
+	}
+
+	for _, role := range roles {
+		response.Roles = append(response.Roles, RoleResponse{ID: role.ID, Name: role.Name})
+	}
+
+	return response, nil
+}
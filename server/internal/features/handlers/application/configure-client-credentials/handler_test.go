@@ -0,0 +1,110 @@
+package configureclientcredentials
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Repository mock
+// ---------------------------------------------------------------------------
+
+type mockClientCredentialsRepo struct{ mock.Mock }
+
+func (m *mockClientCredentialsRepo) GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Application), args.Error(1)
+}
+
+func (m *mockClientCredentialsRepo) ListRolesFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationRole, error) {
+	args := m.Called(ctx, applicationID)
+	return args.Get(0).(*[]entities.ApplicationRole), args.Error(1)
+}
+
+func (m *mockClientCredentialsRepo) GetApplicationClientCredentials(ctx context.Context, applicationID uuid.UUID) (*entities.ApplicationClientCredentials, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ApplicationClientCredentials), args.Error(1)
+}
+
+func (m *mockClientCredentialsRepo) SaveApplicationClientCredentials(ctx context.Context, credentials *entities.ApplicationClientCredentials) error {
+	return m.Called(ctx, credentials).Error(0)
+}
+
+// Compile-time check
+var _ IRepository = (*mockClientCredentialsRepo)(nil)
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestHandler_EnablesClientCredentials(t *testing.T) {
+	repo := new(mockClientCredentialsRepo)
+	application := &entities.Application{ID: uuid.New(), TenantID: uuid.New()}
+	role := entities.ApplicationRole{ID: uuid.New(), ApplicationID: application.ID, Name: "billing"}
+
+	repo.On("GetApplicationByID", mock.Anything, application.ID).Return(application, nil)
+	repo.On("ListRolesFromApplication", mock.Anything, application.ID).Return(&[]entities.ApplicationRole{role}, nil)
+	repo.On("GetApplicationClientCredentials", mock.Anything, application.ID).Return(nil, nil)
+	repo.On("SaveApplicationClientCredentials", mock.Anything, mock.MatchedBy(func(c *entities.ApplicationClientCredentials) bool {
+		return c.ApplicationID == application.ID && len(c.Roles) == 1 && c.Roles[0].ID == role.ID
+	})).Return(nil)
+
+	handler := &Handler{repository: repo}
+
+	response, err := handler.Handler(context.Background(), Command{
+		TenantID:      application.TenantID,
+		ApplicationID: application.ID,
+		Scopes:        []string{"orders:read", " orders:read ", ""},
+		RoleIDs:       []uuid.UUID{role.ID},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orders:read"}, response.Scopes)
+	assert.Equal(t, []RoleResponse{{ID: role.ID, Name: "billing"}}, response.Roles)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_RejectsRoleFromAnotherApplication(t *testing.T) {
+	repo := new(mockClientCredentialsRepo)
+	application := &entities.Application{ID: uuid.New(), TenantID: uuid.New()}
+
+	repo.On("GetApplicationByID", mock.Anything, application.ID).Return(application, nil)
+	repo.On("ListRolesFromApplication", mock.Anything, application.ID).Return(&[]entities.ApplicationRole{}, nil)
+
+	handler := &Handler{repository: repo}
+
+	_, err := handler.Handler(context.Background(), Command{
+		TenantID:      application.TenantID,
+		ApplicationID: application.ID,
+		RoleIDs:       []uuid.UUID{uuid.New()},
+	})
+
+	assert.Equal(t, &errors.ErrRoleNotFound, err)
+	repo.AssertNotCalled(t, "SaveApplicationClientCredentials", mock.Anything, mock.Anything)
+}
+
+func TestHandler_RejectsApplicationFromAnotherTenant(t *testing.T) {
+	repo := new(mockClientCredentialsRepo)
+	application := &entities.Application{ID: uuid.New(), TenantID: uuid.New()}
+
+	repo.On("GetApplicationByID", mock.Anything, application.ID).Return(application, nil)
+
+	handler := &Handler{repository: repo}
+
+	_, err := handler.Handler(context.Background(), Command{TenantID: uuid.New(), ApplicationID: application.ID})
+
+	assert.Equal(t, &errors.ErrApplicationNotFound, err)
+}
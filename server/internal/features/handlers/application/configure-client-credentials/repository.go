@@ -0,0 +1,31 @@
+package configureclientcredentials
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	ListRolesFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationRole, error)
+	GetApplicationClientCredentials(ctx context.Context, applicationID uuid.UUID) (*entities.ApplicationClientCredentials, error)
+	SaveApplicationClientCredentials(ctx context.Context, credentials *entities.ApplicationClientCredentials) error
+}
+
+type Repository struct {
+	repositories.ApplicationRepository
+	repositories.RoleRepository
+	repositories.ClientCredentialsRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		ApplicationRepository:       repositories.ApplicationRepository{Store: q},
+		RoleRepository:              repositories.RoleRepository{Store: q},
+		ClientCredentialsRepository: repositories.ClientCredentialsRepository{Store: q},
+	}
+}
@@ -9,8 +9,14 @@ type Command struct {
 	HasMfaEmail          bool      `json:"hasMfaEmail" validate:"boolean"`
 	HasMfaAuthApp        bool      `json:"hasMfaAuthApp" validate:"boolean"`
 	HasMfaWebauthn       bool      `json:"hasMfaWebauthn" validate:"boolean"`
-	TenantID             uuid.UUID `json:"tenantId" validate:"required"`
+	TenantID             uuid.UUID `json:"tenantId"` // taken from the URL; must match it when sent
 	CanSelfSignUp        bool      `json:"canSelfSignUp" validate:"boolean"`
 	CanSelfForgotPass    bool      `json:"canSelfForgotPass" validate:"boolean"`
 	RequiresHighSecurity bool      `json:"requiresHighSecurity" validate:"boolean"`
+	// Authorization responses are only sent to these URIs (exact match).
+	RedirectURIs           []string  `json:"redirectUris" validate:"omitempty,max=20,dive,required,max=512"`
+	PostLogoutRedirectURIs []string  `json:"postLogoutRedirectUris" validate:"omitempty,max=20,dive,required,max=512"`
+	AdminID                uuid.UUID `json:"-"` // injected server-side
+	IPAddress              string    `json:"-"` // injected server-side
+	UserAgent              string    `json:"-"` // injected server-side
 }
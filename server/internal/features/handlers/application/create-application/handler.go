@@ -3,7 +3,9 @@ package createapplication
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -33,12 +35,49 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		command.CanSelfForgotPass,
 	)
 
-	err := s.repository.AddApplication(ctx, newApplication)
+	redirectURIs, err := application_utils.NewRedirectURIs(newApplication.ID, constants.RedirectURIKindLogin, command.RedirectURIs)
 
 	if err != nil {
 		return nil, err
 	}
 
+	postLogoutRedirectURIs, err := application_utils.NewRedirectURIs(newApplication.ID, constants.RedirectURIKindPostLogout, command.PostLogoutRedirectURIs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.AddApplication(ctx, newApplication)
+
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := application_utils.AuditLogChanges(newApplication.ID, nil, application_utils.ApplicationAuditLogFields(newApplication))
+
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog := entities.NewAuditLog(command.AdminID, newApplication.ID,
+		constants.AuditEventApplicationCreated, command.IPAddress, command.UserAgent, "success", details)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
+	if len(redirectURIs) > 0 {
+		if err := s.repository.ReplaceRedirectURIs(ctx, newApplication.ID, constants.RedirectURIKindLogin, redirectURIs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(postLogoutRedirectURIs) > 0 {
+		if err := s.repository.ReplaceRedirectURIs(ctx, newApplication.ID, constants.RedirectURIKindPostLogout, postLogoutRedirectURIs); err != nil {
+			return nil, err
+		}
+	}
+
 	userRoleDescription := "Default user role"
 	adminRoleDescription := "Default admin role"
 
@@ -6,7 +6,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -27,6 +29,14 @@ func (m *mockCreateAppRepo) AddRole(ctx context.Context, role *entities.Applicat
 	return m.Called(ctx, role).Error(0)
 }
 
+func (m *mockCreateAppRepo) ReplaceRedirectURIs(ctx context.Context, applicationID uuid.UUID, kind string, redirectURIs []entities.ApplicationRedirectURI) error {
+	return m.Called(ctx, applicationID, kind, redirectURIs).Error(0)
+}
+
+func (m *mockCreateAppRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
 var _ IRepository = (*mockCreateAppRepo)(nil)
 
 // ---------------------------------------------------------------------------
@@ -36,10 +46,14 @@ var _ IRepository = (*mockCreateAppRepo)(nil)
 func TestHandler_CreateApplication_Success(t *testing.T) {
 	repo := new(mockCreateAppRepo)
 	orgID, _ := uuid.NewV7()
+	adminID, _ := uuid.NewV7()
 	desc := "Test description"
 
 	repo.On("AddApplication", mock.Anything, mock.AnythingOfType("*entities.Application")).Return(nil)
 	repo.On("AddRole", mock.Anything, mock.AnythingOfType("*entities.ApplicationRole")).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventApplicationCreated && *auditLog.UserID == adminID
+	})).Return(nil)
 
 	h := &Handler{repository: repo}
 	resp, err := h.Handler(context.Background(), Command{
@@ -52,6 +66,7 @@ func TestHandler_CreateApplication_Success(t *testing.T) {
 		TenantID:          orgID,
 		CanSelfSignUp:     true,
 		CanSelfForgotPass: true,
+		AdminID:           adminID,
 	})
 
 	require.NoError(t, err)
@@ -85,6 +100,7 @@ func TestHandler_CreateApplication_CreatesDefaultRoles(t *testing.T) {
 	orgID, _ := uuid.NewV7()
 
 	repo.On("AddApplication", mock.Anything, mock.AnythingOfType("*entities.Application")).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.AnythingOfType("*entities.AuditLog")).Return(nil)
 	repo.On("AddRole", mock.Anything, mock.MatchedBy(func(role *entities.ApplicationRole) bool {
 		return role.Name == "User" || role.Name == "Admin"
 	})).Return(nil).Times(2)
@@ -104,3 +120,46 @@ func TestHandler_CreateApplication_CreatesDefaultRoles(t *testing.T) {
 
 	_ = time.Now() // prevent unused import
 }
+
+func TestHandler_CreateApplication_RegistersRedirectURIs(t *testing.T) {
+	repo := new(mockCreateAppRepo)
+	orgID, _ := uuid.NewV7()
+
+	repo.On("AddApplication", mock.Anything, mock.AnythingOfType("*entities.Application")).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.AnythingOfType("*entities.AuditLog")).Return(nil)
+	repo.On("AddRole", mock.Anything, mock.AnythingOfType("*entities.ApplicationRole")).Return(nil)
+	repo.On("ReplaceRedirectURIs", mock.Anything, mock.Anything, constants.RedirectURIKindLogin, mock.MatchedBy(func(uris []entities.ApplicationRedirectURI) bool {
+		return len(uris) == 2 && uris[0].URI == "https://app.example.com/callback" && uris[1].URI == "http://127.0.0.1:*/callback"
+	})).Return(nil)
+	repo.On("ReplaceRedirectURIs", mock.Anything, mock.Anything, constants.RedirectURIKindPostLogout, mock.MatchedBy(func(uris []entities.ApplicationRedirectURI) bool {
+		return len(uris) == 1 && uris[0].URI == "https://app.example.com/signed-out"
+	})).Return(nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{
+		Name:                   "My App",
+		Badges:                 []string{},
+		TenantID:               orgID,
+		RedirectURIs:           []string{"https://app.example.com/callback", "http://127.0.0.1:*/callback", "https://app.example.com/callback"},
+		PostLogoutRedirectURIs: []string{"https://app.example.com/signed-out"},
+	})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_CreateApplication_InvalidRedirectURI(t *testing.T) {
+	repo := new(mockCreateAppRepo)
+	orgID, _ := uuid.NewV7()
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{
+		Name:         "My App",
+		Badges:       []string{},
+		TenantID:     orgID,
+		RedirectURIs: []string{"http://app.example.com/callback"},
+	})
+
+	assert.ErrorIs(t, err, &errors.ErrInvalidRedirectURI)
+	repo.AssertNotCalled(t, "AddApplication", mock.Anything, mock.Anything)
+}
@@ -6,21 +6,28 @@ import (
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
 )
 
 type IRepository interface {
 	AddApplication(ctx context.Context, application *entities.Application) error
 	AddRole(ctx context.Context, role *entities.ApplicationRole) error
+	ReplaceRedirectURIs(ctx context.Context, applicationID uuid.UUID, kind string, redirectURIs []entities.ApplicationRedirectURI) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.ApplicationRepository
 	repositories.RoleRepository
+	repositories.RedirectURIRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		ApplicationRepository: repositories.ApplicationRepository{Store: q},
 		RoleRepository:        repositories.RoleRepository{Store: q},
+		RedirectURIRepository: repositories.RedirectURIRepository{Store: q},
+		AuditLogRepository:    repositories.AuditLogRepository{Store: q},
 	}
 }
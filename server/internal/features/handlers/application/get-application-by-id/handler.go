@@ -3,6 +3,7 @@ package getapplicationbyid
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/errors"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
@@ -29,11 +30,6 @@ func (s *Handler) Handler(ctx context.Context, request Query) (*Response, error)
 		return nil, &errors.ErrApplicationNotFound
 	}
 
-	tenant, err := s.repository.GetTenantByID(ctx, application.TenantID)
-	if err != nil {
-		return nil, err
-	}
-
 	secrets := make([]ApplicationSecrets, 0)
 
 	applicationSecretsDb, err := s.repository.ListSecretsFromApplication(ctx, application.ID)
@@ -53,26 +49,45 @@ func (s *Handler) Handler(ctx context.Context, request Query) (*Response, error)
 		}
 	}
 
+	registeredRedirectURIs, err := s.repository.ListRedirectURIs(ctx, application.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURIs := make([]string, 0)
+	postLogoutRedirectURIs := make([]string, 0)
+
+	for _, redirectURI := range registeredRedirectURIs {
+		switch redirectURI.Kind {
+		case constants.RedirectURIKindLogin:
+			redirectURIs = append(redirectURIs, redirectURI.URI)
+		case constants.RedirectURIKindPostLogout:
+			postLogoutRedirectURIs = append(postLogoutRedirectURIs, redirectURI.URI)
+		}
+	}
+
 	if len(application.Badges) == 1 && application.Badges[0] == "" {
 		application.Badges = make([]string, 0)
 	}
 
 	return &Response{
-		ID:                    application.ID,
-		Name:                  application.Name,
-		Description:           application.Description,
-		Badges:                application.Badges,
-		CreatedAt:             application.CreatedAt,
-		UpdatedAt:             application.UpdatedAt,
-		CanSelfSignUp:         application.CanSelfSignUp,
-		CanSelfForgotPass:     application.CanSelfForgotPass,
-		IsActive:              application.IsActive,
-		MfaAuthAppEnabled:     application.HasMfaAuthApp,
-		MfaEmailEnabled:       application.HasMfaEmail,
-		MfaWebauthnEnabled:    application.HasMfaWebauthn,
-		PasswordHashingSecret: tenant.PasswordHashSecret,
-		RefreshTokenTtlDays:   application.RefreshTokenTTLDays,
-		RequiresHighSecurity:  application.RequiresHighSecurity,
-		Secrets:               secrets,
+		ID:                     application.ID,
+		Name:                   application.Name,
+		Description:            application.Description,
+		Badges:                 application.Badges,
+		CreatedAt:              application.CreatedAt,
+		UpdatedAt:              application.UpdatedAt,
+		CanSelfSignUp:          application.CanSelfSignUp,
+		CanSelfForgotPass:      application.CanSelfForgotPass,
+		IsActive:               application.IsActive,
+		MfaAuthAppEnabled:      application.HasMfaAuthApp,
+		MfaEmailEnabled:        application.HasMfaEmail,
+		MfaWebauthnEnabled:     application.HasMfaPasskey,
+		RefreshTokenTtlDays:    application.RefreshTokenTTLDays,
+		RequiresHighSecurity:   application.RequiresHighSecurity,
+		Secrets:                secrets,
+		RedirectURIs:           redirectURIs,
+		PostLogoutRedirectURIs: postLogoutRedirectURIs,
 	}, nil
 }
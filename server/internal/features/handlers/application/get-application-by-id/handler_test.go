@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -34,12 +35,12 @@ func (m *mockGetAppRepo) ListSecretsFromApplication(ctx context.Context, applica
 	return args.Get(0).(*[]entities.ApplicationSecret), args.Error(1)
 }
 
-func (m *mockGetAppRepo) GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error) {
-	args := m.Called(ctx, id)
+func (m *mockGetAppRepo) ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error) {
+	args := m.Called(ctx, applicationID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*entities.Tenant), args.Error(1)
+	return args.Get(0).([]entities.ApplicationRedirectURI), args.Error(1)
 }
 
 var _ IRepository = (*mockGetAppRepo)(nil)
@@ -61,14 +62,6 @@ func newTestApp(appID uuid.UUID) *entities.Application {
 	}
 }
 
-func newTestTenant(tenantID uuid.UUID) *entities.Tenant {
-	return &entities.Tenant{
-		ID:                 tenantID,
-		Name:               "Test Tenant",
-		PasswordHashSecret: "secret-key-for-hashing",
-	}
-}
-
 // ---------------------------------------------------------------------------
 // Tests
 // ---------------------------------------------------------------------------
@@ -103,8 +96,11 @@ func TestHandler_GetApplicationByID_Success(t *testing.T) {
 	}
 
 	repo.On("GetApplicationByID", mock.Anything, appID).Return(app, nil)
-	repo.On("GetTenantByID", mock.Anything, app.TenantID).Return(newTestTenant(app.TenantID), nil)
 	repo.On("ListSecretsFromApplication", mock.Anything, appID).Return(&secrets, nil)
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return([]entities.ApplicationRedirectURI{
+		{URI: "https://app.example.com/callback", Kind: constants.RedirectURIKindLogin},
+		{URI: "https://app.example.com/signed-out", Kind: constants.RedirectURIKindPostLogout},
+	}, nil)
 
 	h := &Handler{repository: repo}
 	resp, err := h.Handler(context.Background(), Query{ApplicationID: appID})
@@ -117,6 +113,8 @@ func TestHandler_GetApplicationByID_Success(t *testing.T) {
 	assert.Len(t, resp.Secrets, 1)
 	// Verify secret value is masked
 	assert.Contains(t, resp.Secrets[0].Value, "****************")
+	assert.Equal(t, []string{"https://app.example.com/callback"}, resp.RedirectURIs)
+	assert.Equal(t, []string{"https://app.example.com/signed-out"}, resp.PostLogoutRedirectURIs)
 	repo.AssertExpectations(t)
 }
 
@@ -126,9 +124,9 @@ func TestHandler_GetApplicationByID_NoSecrets(t *testing.T) {
 	app := newTestApp(appID)
 
 	repo.On("GetApplicationByID", mock.Anything, appID).Return(app, nil)
-	repo.On("GetTenantByID", mock.Anything, app.TenantID).Return(newTestTenant(app.TenantID), nil)
 	repo.On("ListSecretsFromApplication", mock.Anything, appID).
 		Return((*[]entities.ApplicationSecret)(nil), nil)
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return([]entities.ApplicationRedirectURI{}, nil)
 
 	h := &Handler{repository: repo}
 	resp, err := h.Handler(context.Background(), Query{ApplicationID: appID})
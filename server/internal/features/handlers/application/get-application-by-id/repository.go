@@ -12,19 +12,19 @@ import (
 type IRepository interface {
 	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
 	ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error)
-	GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error)
+	ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error)
 }
 
 type Repository struct {
 	repositories.ApplicationRepository
 	repositories.SecretRepository
-	repositories.TenantRepository
+	repositories.RedirectURIRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		ApplicationRepository: repositories.ApplicationRepository{Store: q},
 		SecretRepository:      repositories.SecretRepository{Store: q},
-		TenantRepository:      repositories.TenantRepository{Store: q},
+		RedirectURIRepository: repositories.RedirectURIRepository{Store: q},
 	}
 }
@@ -7,22 +7,23 @@ import (
 )
 
 type Response struct {
-	ID                    uuid.UUID            `json:"id"`
-	Name                  string               `json:"name"`
-	Description           *string              `json:"description"`
-	Badges                []string             `json:"badges"`
-	CreatedAt             time.Time            `json:"createdAt"`
-	UpdatedAt             *time.Time           `json:"updatedAt"`
-	IsActive              bool                 `json:"isActive"`
-	MfaAuthAppEnabled     bool                 `json:"mfaAuthAppEnabled"`
-	MfaEmailEnabled       bool                 `json:"mfaEmailEnabled"`
-	MfaWebauthnEnabled    bool                 `json:"mfaWebauthnEnabled"`
-	CanSelfSignUp         bool                 `json:"canSelfSignUp"`
-	CanSelfForgotPass     bool                 `json:"canSelfForgotPass"`
-	PasswordHashingSecret string               `json:"passwordHashingSecret"`
-	RefreshTokenTtlDays   int                  `json:"refreshTokenTtlDays"`
-	RequiresHighSecurity  bool                 `json:"requiresHighSecurity"`
-	Secrets               []ApplicationSecrets `json:"secrets"`
+	ID                     uuid.UUID            `json:"id"`
+	Name                   string               `json:"name"`
+	Description            *string              `json:"description"`
+	Badges                 []string             `json:"badges"`
+	CreatedAt              time.Time            `json:"createdAt"`
+	UpdatedAt              *time.Time           `json:"updatedAt"`
+	IsActive               bool                 `json:"isActive"`
+	MfaAuthAppEnabled      bool                 `json:"mfaAuthAppEnabled"`
+	MfaEmailEnabled        bool                 `json:"mfaEmailEnabled"`
+	MfaWebauthnEnabled     bool                 `json:"mfaWebauthnEnabled"`
+	CanSelfSignUp          bool                 `json:"canSelfSignUp"`
+	CanSelfForgotPass      bool                 `json:"canSelfForgotPass"`
+	RefreshTokenTtlDays    int                  `json:"refreshTokenTtlDays"`
+	RequiresHighSecurity   bool                 `json:"requiresHighSecurity"`
+	Secrets                []ApplicationSecrets `json:"secrets"`
+	RedirectURIs           []string             `json:"redirectUris"`
+	PostLogoutRedirectURIs []string             `json:"postLogoutRedirectUris"`
 }
 
 type ApplicationSecrets struct {
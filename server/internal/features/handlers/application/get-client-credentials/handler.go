@@ -0,0 +1,55 @@
+package getclientcredentials
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
+	application, err := s.repository.GetApplicationByID(ctx, query.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if application == nil || application.TenantID != query.TenantID {
+		return nil, &errors.ErrApplicationNotFound
+	}
+
+	credentials, err := s.repository.GetApplicationClientCredentials(ctx, application.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials == nil {
+		return nil, &errors.ErrClientCredentialsNotEnabled
+	}
+
+	response := &Response{
+		ApplicationID: credentials.ApplicationID,
+		Scopes:        credentials.Scopes,
+		Roles:         make([]RoleResponse, 0, len(credentials.Roles)),
+		CreatedAt:     credentials.CreatedAt,
+		UpdatedAt:     credentials.UpdatedAt,
+	}
+
+	for _, role := range credentials.Roles {
+		response.Roles = append(response.Roles, RoleResponse{ID: role.ID, Name: role.Name})
+	}
+
+	return response, nil
+}
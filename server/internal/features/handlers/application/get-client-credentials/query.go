@@ -0,0 +1,8 @@
+package getclientcredentials
+
+import "github.com/google/uuid"
+
+type Query struct {
+	TenantID      uuid.UUID
+	ApplicationID uuid.UUID
+}
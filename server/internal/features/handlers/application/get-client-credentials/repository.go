@@ -0,0 +1,27 @@
+package getclientcredentials
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	GetApplicationClientCredentials(ctx context.Context, applicationID uuid.UUID) (*entities.ApplicationClientCredentials, error)
+}
+
+type Repository struct {
+	repositories.ApplicationRepository
+	repositories.ClientCredentialsRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		ApplicationRepository:       repositories.ApplicationRepository{Store: q},
+		ClientCredentialsRepository: repositories.ClientCredentialsRepository{Store: q},
+	}
+}
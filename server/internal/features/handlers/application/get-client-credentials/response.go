@@ -0,0 +1,20 @@
+package getclientcredentials
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Response struct {
+	ApplicationID uuid.UUID      `json:"applicationId"`
+	Scopes        []string       `json:"scopes"`
+	Roles         []RoleResponse `json:"roles"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     *time.Time     `json:"updatedAt"`
+}
+
+type RoleResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
@@ -5,4 +5,7 @@ import "github.com/google/uuid"
 type Command struct {
 	ApplicationID uuid.UUID `json:"applicationId" validate:"required"`
 	TenantID      uuid.UUID `json:"tenantId" validate:"required"`
+	AdminID       uuid.UUID `json:"-"` // injected server-side
+	IPAddress     string    `json:"-"` // injected server-side
+	UserAgent     string    `json:"-"` // injected server-side
 }
@@ -3,6 +3,10 @@ package removeapplication
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -18,11 +22,31 @@ func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
 }
 
 func (s *Handler) Handler(ctx context.Context, command Command) error {
-	err := s.Repository.RemoveApplication(ctx, command.ApplicationID)
+	application, err := s.Repository.GetApplicationByID(ctx, command.ApplicationID)
 
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if application == nil {
+		return &errors.ErrApplicationNotFound
+	}
+
+	err = s.Repository.RemoveApplication(ctx, command.ApplicationID)
+
+	if err != nil {
+		return err
+	}
+
+	details, err := application_utils.AuditLogChanges(application.ID, application_utils.ApplicationAuditLogFields(application), nil)
+
+	if err != nil {
+		return err
+	}
+
+	// The application is gone, so the tenant can't be derived from it
+	auditLog := entities.NewTenantAuditLog(application.TenantID, &command.AdminID, &application.ID,
+		constants.AuditEventApplicationDeleted, command.IPAddress, command.UserAgent, "success", details)
+
+	return s.Repository.AddAuditLog(ctx, auditLog)
 }
@@ -2,21 +2,26 @@ package removeapplication
 
 import (
 	"context"
+	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 	"github.com/google/uuid"
 )
 
 type IRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
 	RemoveApplication(ctx context.Context, applicationID uuid.UUID) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.ApplicationRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		ApplicationRepository: repositories.ApplicationRepository{Store: q},
+		AuditLogRepository:    repositories.AuditLogRepository{Store: q},
 	}
 }
@@ -0,0 +1,8 @@
+package removeclientcredentials
+
+import "github.com/google/uuid"
+
+type Command struct {
+	TenantID      uuid.UUID
+	ApplicationID uuid.UUID
+}
@@ -0,0 +1,46 @@
+package removeclientcredentials
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	tenantIDString := chi.URLParam(request, "tenantID")
+	tenantIdUUID, err := uuid.Parse(tenantIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	applicationIDString := chi.URLParam(request, "applicationID")
+	applicationIdUUID, err := uuid.Parse(applicationIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	params := repositories.Params[Command, Handler]{
+		DbPool: c.DbPool,
+		New:    New,
+		Request: Command{
+			TenantID:      tenantIdUUID,
+			ApplicationID: applicationIdUUID,
+		},
+	}
+
+	if err := repositories.WithTransaction(request.Context(), params); err != nil {
+		panic(err)
+	}
+
+	http_router.SendJson(writter, nil, http.StatusNoContent)
+}
@@ -0,0 +1,33 @@
+package removeclientcredentials
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, command Command) error {
+	application, err := s.repository.GetApplicationByID(ctx, command.ApplicationID)
+
+	if err != nil {
+		return err
+	}
+
+	if application == nil || application.TenantID != command.TenantID {
+		return &errors.ErrApplicationNotFound
+	}
+
+	return s.repository.RemoveApplicationClientCredentials(ctx, application.ID)
+}
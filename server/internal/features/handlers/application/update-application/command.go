@@ -3,16 +3,22 @@ package updateapplication
 import "github.com/google/uuid"
 
 type Command struct {
-	ID                   uuid.UUID `json:"id" validate:"required"`
+	ID                   uuid.UUID `json:"id"` // taken from the URL; must match it when sent
 	Name                 string    `json:"name" validate:"required,min=3,max=100"`
 	Description          *string   `json:"description" validate:"omitempty,min=3,max=100"`
 	Badges               []string  `json:"badges" validate:"required"`
 	HasMfaEmail          bool      `json:"hasMfaEmail" validate:"boolean"`
 	HasMfaAuthApp        bool      `json:"hasMfaAuthApp" validate:"boolean"`
-	TenantID             uuid.UUID `json:"tenantId" validate:"required"`
+	TenantID             uuid.UUID `json:"tenantId"` // taken from the URL; must match it when sent
 	IsActive             bool      `json:"isActive" validate:"required"`
 	CanSelfSignUp        bool      `json:"canSelfSignUp" validate:"boolean"`
 	CanSelfForgotPass    bool      `json:"canSelfForgotPass" validate:"boolean"`
 	RefreshTokenTTLDays  int       `json:"refreshTokenTtlDays" validate:"min=1,max=365"`
 	RequiresHighSecurity bool      `json:"requiresHighSecurity" validate:"boolean"`
+	// When present the lists replace the registered ones; when omitted they are left untouched.
+	RedirectURIs           []string  `json:"redirectUris" validate:"omitempty,max=20,dive,required,max=512"`
+	PostLogoutRedirectURIs []string  `json:"postLogoutRedirectUris" validate:"omitempty,max=20,dive,required,max=512"`
+	AdminID                uuid.UUID `json:"-"` // injected server-side
+	IPAddress              string    `json:"-"` // injected server-side
+	UserAgent              string    `json:"-"` // injected server-side
 }
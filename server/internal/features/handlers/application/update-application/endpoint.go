@@ -3,8 +3,12 @@ package updateapplication
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -13,19 +17,43 @@ type Endpoint struct {
 }
 
 func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	tenantIdUUID, err := uuid.Parse(chi.URLParam(request, "tenantID"))
+
+	if err != nil {
+		panic(err)
+	}
+
+	applicationIdUUID, err := uuid.Parse(chi.URLParam(request, "applicationID"))
+
+	if err != nil {
+		panic(err)
+	}
+
 	var command Command
 
 	if err := http_router.ParseBodyToSchema(&command, request); err != nil {
 		panic(err)
 	}
 
+	// The admin middlewares only check the URL, so the body may not point elsewhere
+	if (command.ID != uuid.Nil && command.ID != applicationIdUUID) || (command.TenantID != uuid.Nil && command.TenantID != tenantIdUUID) {
+		panic(&errors.ErrRouteBodyMismatch)
+	}
+
+	command.ID = applicationIdUUID
+	command.TenantID = tenantIdUUID
+
+	command.AdminID = http_router.GetUserIDFromContext(request.Context())
+	command.IPAddress = clientip.RealIP(request)
+	command.UserAgent = request.UserAgent()
+
 	params := repositories.Params[Command, Handler]{
 		DbPool:  c.DbPool,
 		New:     New,
 		Request: command,
 	}
 
-	err := repositories.WithTransaction(request.Context(), params)
+	err = repositories.WithTransaction(request.Context(), params)
 
 	if err != nil {
 		panic(err)
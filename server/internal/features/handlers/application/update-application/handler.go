@@ -4,7 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -20,6 +23,16 @@ func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
 }
 
 func (s *Handler) Handler(ctx context.Context, command Command) error {
+	currentApplication, err := s.Repository.GetApplicationByID(ctx, command.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if currentApplication == nil {
+		return &errors.ErrApplicationNotFound
+	}
+
 	now := time.Now().UTC()
 
 	application := entities.Application{
@@ -30,6 +43,7 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 		IsActive:             command.IsActive,
 		HasMfaAuthApp:        command.HasMfaAuthApp,
 		HasMfaEmail:          command.HasMfaEmail,
+		HasMfaPasskey:        currentApplication.HasMfaPasskey,
 		Badges:               command.Badges,
 		CreatedAt:            now,
 		UpdatedAt:            &now,
@@ -39,11 +53,46 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 		RequiresHighSecurity: command.RequiresHighSecurity,
 	}
 
-	err := s.Repository.UpdateApplication(ctx, &application)
+	err = s.Repository.UpdateApplication(ctx, &application)
+
+	if err != nil {
+		return err
+	}
+
+	details, err := application_utils.AuditLogChanges(application.ID,
+		application_utils.ApplicationAuditLogFields(currentApplication), application_utils.ApplicationAuditLogFields(&application))
 
 	if err != nil {
 		return err
 	}
 
+	auditLog := entities.NewAuditLog(command.AdminID, application.ID,
+		constants.AuditEventApplicationUpdated, command.IPAddress, command.UserAgent, "success", details)
+
+	if err := s.Repository.AddAuditLog(ctx, auditLog); err != nil {
+		return err
+	}
+
+	redirectURILists := map[string][]string{
+		constants.RedirectURIKindLogin:      command.RedirectURIs,
+		constants.RedirectURIKindPostLogout: command.PostLogoutRedirectURIs,
+	}
+
+	for kind, uris := range redirectURILists {
+		if uris == nil {
+			continue
+		}
+
+		redirectURIs, err := application_utils.NewRedirectURIs(command.ID, kind, uris)
+
+		if err != nil {
+			return err
+		}
+
+		if err := s.Repository.ReplaceRedirectURIs(ctx, command.ID, kind, redirectURIs); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
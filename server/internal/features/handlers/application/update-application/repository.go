@@ -2,21 +2,30 @@ package updateapplication
 
 import (
 	"context"
+
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
 )
 
 type IRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
 	UpdateApplication(ctx context.Context, application *entities.Application) error
+	ReplaceRedirectURIs(ctx context.Context, applicationID uuid.UUID, kind string, redirectURIs []entities.ApplicationRedirectURI) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.ApplicationRepository
+	repositories.RedirectURIRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		ApplicationRepository: repositories.ApplicationRepository{Store: q},
+		RedirectURIRepository: repositories.RedirectURIRepository{Store: q},
+		AuditLogRepository:    repositories.AuditLogRepository{Store: q},
 	}
 }
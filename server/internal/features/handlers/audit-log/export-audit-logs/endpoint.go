@@ -0,0 +1,63 @@
+package exportauditlogs
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+// Http streams the tenant's audit log as ?format=csv|ndjson (CSV by default),
+// narrowed with the same filters as the audit log search.
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	tenantIdUUID, err := uuid.Parse(chi.URLParam(request, "tenantID"))
+
+	if err != nil {
+		panic(err)
+	}
+
+	format, err := application_utils.ParseAuditLogFormat(request.URL.Query().Get("format"))
+
+	if err != nil {
+		panic(err)
+	}
+
+	filter, err := application_utils.ParseAuditLogFilter(request.URL.Query())
+
+	if err != nil {
+		panic(err)
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if format == constants.AuditLogFormatNDJSON {
+		contentType = "application/x-ndjson"
+	}
+
+	writter.Header().Set("Content-Type", contentType)
+	writter.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="audit-log-%s.%s"`, tenantIdUUID, format))
+
+	auditWriter, err := newAuditLogWriter(format, writter)
+
+	if err != nil {
+		panic(err)
+	}
+
+	params := repositories.Params[Query, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: Query{TenantID: tenantIdUUID, Filter: filter, Writer: auditWriter},
+	}
+
+	if err := repositories.WithTransaction(request.Context(), params); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,53 @@
+package exportauditlogs
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+// batchSize is how many entries are read and written at a time.
+const batchSize = 500
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandler[Query] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler writes every entry of the tenant's audit log matching the filter,
+// newest first, flushing after each batch so the export streams instead of
+// being built in memory.
+func (s *Handler) Handler(ctx context.Context, query Query) error {
+	var beforeID *uuid.UUID
+
+	for {
+		logs, err := s.repository.ListTenantAuditLogs(ctx, query.TenantID, query.Filter, beforeID, batchSize)
+
+		if err != nil {
+			return err
+		}
+
+		for _, log := range logs {
+			if err := query.Writer.Write(log); err != nil {
+				return err
+			}
+		}
+
+		if err := query.Writer.Flush(); err != nil {
+			return err
+		}
+
+		if len(logs) < batchSize {
+			return nil
+		}
+
+		beforeID = &logs[len(logs)-1].ID
+	}
+}
@@ -0,0 +1,12 @@
+package exportauditlogs
+
+import (
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	TenantID uuid.UUID
+	Filter   entities.AuditLogFilter
+	Writer   auditLogWriter
+}
@@ -0,0 +1,152 @@
+package exportauditlogs
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/google/uuid"
+)
+
+var auditLogColumns = []string{
+	"id",
+	"created_at",
+	"event_type",
+	"result",
+	"user_id",
+	"application_id",
+	"ip_address",
+	"user_agent",
+	"details",
+	"chain_sequence",
+	"previous_hash",
+	"hash",
+}
+
+// auditLogRecord is an audit entry as written to an NDJSON export.
+type auditLogRecord struct {
+	ID            uuid.UUID  `json:"id"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	EventType     string     `json:"eventType"`
+	Result        string     `json:"result"`
+	UserID        *uuid.UUID `json:"userId"`
+	ApplicationID *uuid.UUID `json:"applicationId"`
+	IPAddress     string     `json:"ipAddress"`
+	UserAgent     string     `json:"userAgent"`
+	Details       *string    `json:"details"`
+	ChainSequence int64      `json:"chainSequence"`
+	PreviousHash  string     `json:"previousHash"`
+	Hash          string     `json:"hash"`
+}
+
+// auditLogWriter writes audit entries in an export format.
+type auditLogWriter interface {
+	Write(log *entities.AuditLog) error
+	Flush() error
+}
+
+// newAuditLogWriter returns a writer for the format. CSV output starts with
+// the header row.
+func newAuditLogWriter(format string, writer io.Writer) (auditLogWriter, error) {
+	switch format {
+	case constants.AuditLogFormatCSV:
+		csvWriter := csv.NewWriter(writer)
+		if err := csvWriter.Write(auditLogColumns); err != nil {
+			return nil, err
+		}
+		return &csvAuditLogWriter{writer: csvWriter}, nil
+	case constants.AuditLogFormatNDJSON:
+		buffered := bufio.NewWriter(writer)
+		return &ndjsonAuditLogWriter{writer: buffered, encoder: json.NewEncoder(buffered)}, nil
+	}
+
+	return nil, &errors.ErrAuditLogInvalidFormat
+}
+
+type csvAuditLogWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvAuditLogWriter) Write(log *entities.AuditLog) error {
+	details := ""
+	if log.Details != nil {
+		details = *log.Details
+	}
+
+	record := []string{
+		log.ID.String(),
+		log.CreatedAt.UTC().Format(time.RFC3339Nano),
+		log.EventType,
+		log.Result,
+		optionalUUIDString(log.UserID),
+		optionalUUIDString(log.ApplicationID),
+		log.IPAddress,
+		log.UserAgent,
+		details,
+		strconv.FormatInt(log.ChainSequence, 10),
+		log.PreviousHash,
+		log.Hash,
+	}
+
+	for i, cell := range record {
+		record[i] = escapeFormula(cell)
+	}
+
+	return w.writer.Write(record)
+}
+
+// escapeFormula prefixes cells that spreadsheets would evaluate as a formula
+// with a quote, as user agents and details are controlled by clients.
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+
+	return cell
+}
+
+func optionalUUIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+func (w *csvAuditLogWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type ndjsonAuditLogWriter struct {
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (w *ndjsonAuditLogWriter) Write(log *entities.AuditLog) error {
+	return w.encoder.Encode(auditLogRecord{
+		ID:            log.ID,
+		CreatedAt:     log.CreatedAt.UTC(),
+		EventType:     log.EventType,
+		Result:        log.Result,
+		UserID:        log.UserID,
+		ApplicationID: log.ApplicationID,
+		IPAddress:     log.IPAddress,
+		UserAgent:     log.UserAgent,
+		Details:       log.Details,
+		ChainSequence: log.ChainSequence,
+		PreviousHash:  log.PreviousHash,
+		Hash:          log.Hash,
+	})
+}
+
+func (w *ndjsonAuditLogWriter) Flush() error {
+	return w.writer.Flush()
+}
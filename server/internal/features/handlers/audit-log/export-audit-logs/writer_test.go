@@ -0,0 +1,32 @@
+package exportauditlogs
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVAuditLogWriter_EscapesFormulas(t *testing.T) {
+	details := "@SUM(1+1)*cmd|' /C calc'!A0"
+	log := entities.NewAuditLog(uuid.New(), uuid.New(), constants.AuditEventTenantCreated, "203.0.113.7", "=HYPERLINK(\"http://evil\")", "success", &details)
+
+	var buffer bytes.Buffer
+	writer, err := newAuditLogWriter(constants.AuditLogFormatCSV, &buffer)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(log))
+	require.NoError(t, writer.Flush())
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, "'=HYPERLINK(\"http://evil\")", records[1][7])
+	assert.Equal(t, "'"+details, records[1][8])
+	assert.Equal(t, "203.0.113.7", records[1][6])
+}
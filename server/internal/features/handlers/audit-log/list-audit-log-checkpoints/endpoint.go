@@ -0,0 +1,37 @@
+package listauditlogcheckpoints
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	tenantIdUUID, err := uuid.Parse(chi.URLParam(request, "tenantID"))
+
+	if err != nil {
+		panic(err)
+	}
+
+	params := repositories.ParamsRs[Query, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: Query{TenantID: tenantIdUUID},
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	http_router.SendJson(writter, response, http.StatusOK)
+}
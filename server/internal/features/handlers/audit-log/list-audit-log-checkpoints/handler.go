@@ -0,0 +1,45 @@
+package listauditlogcheckpoints
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler returns every signed checkpoint of the tenant's audit log chain,
+// oldest first, for external archival.
+func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
+	checkpoints, err := s.repository.ListAuditLogCheckpoints(ctx, query.TenantID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{Data: make([]CheckpointResponse, 0, len(checkpoints))}
+
+	for _, checkpoint := range checkpoints {
+		response.Data = append(response.Data, CheckpointResponse{
+			ID:            checkpoint.ID,
+			TenantID:      checkpoint.TenantID,
+			ChainSequence: checkpoint.ChainSequence,
+			AuditLogID:    checkpoint.AuditLogID,
+			Hash:          checkpoint.Hash,
+			KeyID:         checkpoint.KeyID,
+			Signature:     checkpoint.Signature,
+			CreatedAt:     checkpoint.CreatedAt,
+		})
+	}
+
+	return response, nil
+}
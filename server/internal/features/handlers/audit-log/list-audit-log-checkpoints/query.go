@@ -0,0 +1,7 @@
+package listauditlogcheckpoints
+
+import "github.com/google/uuid"
+
+type Query struct {
+	TenantID uuid.UUID
+}
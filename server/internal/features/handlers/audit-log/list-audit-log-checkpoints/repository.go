@@ -0,0 +1,24 @@
+package listauditlogcheckpoints
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	ListAuditLogCheckpoints(ctx context.Context, tenantID uuid.UUID) ([]*entities.AuditLogCheckpoint, error)
+}
+
+type Repository struct {
+	repositories.AuditLogCheckpointRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		AuditLogCheckpointRepository: repositories.AuditLogCheckpointRepository{Store: q},
+	}
+}
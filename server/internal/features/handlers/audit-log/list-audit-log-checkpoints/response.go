@@ -0,0 +1,24 @@
+package listauditlogcheckpoints
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CheckpointResponse struct {
+	ID            uuid.UUID `json:"id"`
+	TenantID      uuid.UUID `json:"tenantId"`
+	ChainSequence int64     `json:"chainSequence"`
+	AuditLogID    uuid.UUID `json:"auditLogId"`
+	Hash          string    `json:"hash"`
+	KeyID         string    `json:"kid"`
+	// Signature is a compact JWS over the checkpoint, verifiable with the
+	// tenant's JWKS while the key is published.
+	Signature string    `json:"signature"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Response struct {
+	Data []CheckpointResponse `json:"data"`
+}
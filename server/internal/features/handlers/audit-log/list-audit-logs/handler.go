@@ -0,0 +1,51 @@
+package listauditlogs
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler returns a page of the tenant's audit log, newest first. One more
+// entry than requested is read to tell whether another page follows.
+func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
+	logs, err := s.repository.ListTenantAuditLogs(ctx, query.TenantID, query.Filter, query.Cursor, query.Limit+1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{Data: make([]AuditLogResponse, 0, len(logs))}
+
+	if len(logs) > query.Limit {
+		logs = logs[:query.Limit]
+		response.NextCursor = &logs[len(logs)-1].ID
+	}
+
+	for _, log := range logs {
+		response.Data = append(response.Data, AuditLogResponse{
+			ID:            log.ID,
+			UserID:        log.UserID,
+			ApplicationID: log.ApplicationID,
+			EventType:     log.EventType,
+			Result:        log.Result,
+			IPAddress:     log.IPAddress,
+			UserAgent:     log.UserAgent,
+			Details:       log.Details,
+			CreatedAt:     log.CreatedAt,
+		})
+	}
+
+	return response, nil
+}
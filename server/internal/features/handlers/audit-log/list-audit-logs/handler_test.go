@@ -0,0 +1,73 @@
+package listauditlogs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAuditLogRepo struct {
+	mock.Mock
+}
+
+func (m *mockAuditLogRepo) ListTenantAuditLogs(ctx context.Context, tenantID uuid.UUID, filter entities.AuditLogFilter, beforeID *uuid.UUID, limit int) ([]*entities.AuditLog, error) {
+	args := m.Called(ctx, tenantID, filter, beforeID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.AuditLog), args.Error(1)
+}
+
+func newAuditLogs(count int) []*entities.AuditLog {
+	userID, _ := uuid.NewV7()
+	appID, _ := uuid.NewV7()
+
+	logs := make([]*entities.AuditLog, 0, count)
+	for range count {
+		logs = append(logs, entities.NewAuditLog(userID, appID, constants.AuditEventReauthSuccess, "127.0.0.1", "test", "success", nil))
+	}
+	return logs
+}
+
+func TestHandler_ListAuditLogs_NextPage(t *testing.T) {
+	repo := new(mockAuditLogRepo)
+	tenantID, _ := uuid.NewV7()
+	eventType := constants.AuditEventReauthSuccess
+	query := Query{TenantID: tenantID, Filter: entities.AuditLogFilter{EventType: &eventType}, Limit: 2}
+	logs := newAuditLogs(3)
+
+	repo.On("ListTenantAuditLogs", mock.Anything, tenantID, query.Filter, (*uuid.UUID)(nil), 3).Return(logs, nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), query)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Data, 2)
+	assert.Equal(t, "127.0.0.1", response.Data[0].IPAddress)
+	if assert.NotNil(t, response.NextCursor) {
+		assert.Equal(t, logs[1].ID, *response.NextCursor)
+	}
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_ListAuditLogs_LastPage(t *testing.T) {
+	repo := new(mockAuditLogRepo)
+	tenantID, _ := uuid.NewV7()
+	cursor, _ := uuid.NewV7()
+	query := Query{TenantID: tenantID, Cursor: &cursor, Limit: 2}
+
+	repo.On("ListTenantAuditLogs", mock.Anything, tenantID, query.Filter, &cursor, 3).Return(newAuditLogs(1), nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), query)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Data, 1)
+	assert.Nil(t, response.NextCursor)
+	repo.AssertExpectations(t)
+}
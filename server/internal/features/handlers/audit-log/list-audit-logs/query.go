@@ -0,0 +1,13 @@
+package listauditlogs
+
+import (
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	TenantID uuid.UUID
+	Filter   entities.AuditLogFilter
+	Cursor   *uuid.UUID // ID of the last entry of the previous page
+	Limit    int
+}
@@ -0,0 +1,24 @@
+package listauditlogs
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	ListTenantAuditLogs(ctx context.Context, tenantID uuid.UUID, filter entities.AuditLogFilter, beforeID *uuid.UUID, limit int) ([]*entities.AuditLog, error)
+}
+
+type Repository struct {
+	repositories.AuditLogRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
+	}
+}
@@ -0,0 +1,25 @@
+package listauditlogs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditLogResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	UserID        *uuid.UUID `json:"userId"`
+	ApplicationID *uuid.UUID `json:"applicationId"`
+	EventType     string     `json:"eventType"`
+	Result        string     `json:"result"`
+	IPAddress     string     `json:"ipAddress"`
+	UserAgent     string     `json:"userAgent"`
+	Details       *string    `json:"details"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+type Response struct {
+	Data []AuditLogResponse `json:"data"`
+	// NextCursor is passed as ?cursor= to fetch the next page; it is null on the last page.
+	NextCursor *uuid.UUID `json:"nextCursor"`
+}
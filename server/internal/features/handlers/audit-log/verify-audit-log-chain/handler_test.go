@@ -0,0 +1,189 @@
+package verifyauditlogchain
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/auditlog"
+	"github.com/gate-keeper/internal/infra/signing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAuditLogChainRepo struct {
+	mock.Mock
+}
+
+func (m *mockAuditLogChainRepo) GetAuditLogChainHead(ctx context.Context, tenantID uuid.UUID) (*entities.AuditLogChainHead, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AuditLogChainHead), args.Error(1)
+}
+
+func (m *mockAuditLogChainRepo) ListAuditLogChain(ctx context.Context, tenantID uuid.UUID, afterSequence int64, limit int) ([]*entities.AuditLog, error) {
+	args := m.Called(ctx, tenantID, afterSequence, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.AuditLog), args.Error(1)
+}
+
+func (m *mockAuditLogChainRepo) ListAuditLogCheckpoints(ctx context.Context, tenantID uuid.UUID) ([]*entities.AuditLogCheckpoint, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.AuditLogCheckpoint), args.Error(1)
+}
+
+// Compile-time check
+var _ IRepository = (*mockAuditLogChainRepo)(nil)
+
+func newAuditLogChain(tenantID uuid.UUID, length int) ([]*entities.AuditLog, *entities.AuditLogChainHead) {
+	head := &entities.AuditLogChainHead{TenantID: tenantID, Hash: entities.AuditLogGenesisHash}
+	chain := make([]*entities.AuditLog, 0, length)
+
+	for range length {
+		entry := entities.NewTenantAuditLog(tenantID, nil, nil, constants.AuditEventTenantUpdated, "127.0.0.1", "test", "success", nil)
+		entry.ChainTo(head.ChainSequence, head.Hash)
+
+		chain = append(chain, entry)
+		head.ChainSequence = entry.ChainSequence
+		head.AuditLogID = &entry.ID
+		head.Hash = entry.Hash
+	}
+
+	return chain, head
+}
+
+func TestHandler_VerifyAuditLogChain_Intact(t *testing.T) {
+	repo := new(mockAuditLogChainRepo)
+	tenantID, _ := uuid.NewV7()
+	chain, head := newAuditLogChain(tenantID, 3)
+
+	repo.On("GetAuditLogChainHead", mock.Anything, tenantID).Return(head, nil)
+	repo.On("ListAuditLogCheckpoints", mock.Anything, tenantID).Return([]*entities.AuditLogCheckpoint{}, nil)
+	repo.On("ListAuditLogChain", mock.Anything, tenantID, int64(0), 3).Return(chain, nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), Query{TenantID: tenantID})
+
+	require.NoError(t, err)
+	assert.True(t, response.Valid)
+	assert.Nil(t, response.FirstBrokenLink)
+	assert.Equal(t, int64(3), response.CheckedEntries)
+	assert.Equal(t, head.Hash, response.LastHash)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_VerifyAuditLogChain_NoChain(t *testing.T) {
+	repo := new(mockAuditLogChainRepo)
+	tenantID, _ := uuid.NewV7()
+
+	repo.On("GetAuditLogChainHead", mock.Anything, tenantID).Return(nil, nil)
+	repo.On("ListAuditLogCheckpoints", mock.Anything, tenantID).Return([]*entities.AuditLogCheckpoint{}, nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), Query{TenantID: tenantID})
+
+	require.NoError(t, err)
+	assert.True(t, response.Valid)
+	assert.Zero(t, response.CheckedEntries)
+	repo.AssertNotCalled(t, "ListAuditLogChain", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_VerifyAuditLogChain_ReportsFirstBrokenLink(t *testing.T) {
+	repo := new(mockAuditLogChainRepo)
+	tenantID, _ := uuid.NewV7()
+	chain, head := newAuditLogChain(tenantID, 3)
+	chain[1].EventType = constants.AuditEventTenantCreated
+
+	repo.On("GetAuditLogChainHead", mock.Anything, tenantID).Return(head, nil)
+	repo.On("ListAuditLogCheckpoints", mock.Anything, tenantID).Return([]*entities.AuditLogCheckpoint{}, nil)
+	repo.On("ListAuditLogChain", mock.Anything, tenantID, int64(0), 3).Return(chain, nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), Query{TenantID: tenantID})
+
+	require.NoError(t, err)
+	assert.False(t, response.Valid)
+	require.NotNil(t, response.FirstBrokenLink)
+	assert.Equal(t, constants.AuditLogChainHashMismatch, response.FirstBrokenLink.Reason)
+	assert.Equal(t, chain[1].ID, *response.FirstBrokenLink.AuditLogID)
+	assert.Equal(t, int64(1), response.LastSequence)
+}
+
+func TestHandler_VerifyAuditLogChain_ReportsUnverifiedCheckpoint(t *testing.T) {
+	key, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	signing.SetProvider(signing.NewKeySet(key))
+	t.Cleanup(func() { signing.SetProvider(nil) })
+
+	repo := new(mockAuditLogChainRepo)
+	tenantID, _ := uuid.NewV7()
+	chain, head := newAuditLogChain(tenantID, 2)
+	checkpoint := entities.NewAuditLogCheckpoint(tenantID, 2, chain[1].ID, chain[1].Hash)
+	require.NoError(t, auditlog.SignCheckpoint(checkpoint))
+	checkpoint.ChainSequence = 1
+
+	repo.On("GetAuditLogChainHead", mock.Anything, tenantID).Return(head, nil)
+	repo.On("ListAuditLogCheckpoints", mock.Anything, tenantID).Return([]*entities.AuditLogCheckpoint{checkpoint}, nil)
+	repo.On("ListAuditLogChain", mock.Anything, tenantID, int64(0), 2).Return(chain, nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), Query{TenantID: tenantID})
+
+	require.NoError(t, err)
+	assert.False(t, response.Valid)
+	assert.Nil(t, response.FirstBrokenLink)
+	assert.Equal(t, []int64{1}, response.UnverifiedCheckpoints)
+	assert.Empty(t, response.UnknownKeyCheckpoints)
+}
+
+func TestHandler_VerifyAuditLogChain_PurgedKeyCheckpointKeepsChainValid(t *testing.T) {
+	repo := new(mockAuditLogChainRepo)
+	tenantID, _ := uuid.NewV7()
+	chain, head := newAuditLogChain(tenantID, 2)
+	checkpoint := entities.NewAuditLogCheckpoint(tenantID, 2, chain[1].ID, chain[1].Hash)
+	checkpoint.KeyID = "purged"
+	checkpoint.Signature = "not-a-jws"
+
+	repo.On("GetAuditLogChainHead", mock.Anything, tenantID).Return(head, nil)
+	repo.On("ListAuditLogCheckpoints", mock.Anything, tenantID).Return([]*entities.AuditLogCheckpoint{checkpoint}, nil)
+	repo.On("ListAuditLogChain", mock.Anything, tenantID, int64(0), 2).Return(chain, nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), Query{TenantID: tenantID})
+
+	require.NoError(t, err)
+	assert.True(t, response.Valid)
+	assert.Empty(t, response.UnverifiedCheckpoints)
+	assert.Equal(t, []int64{2}, response.UnknownKeyCheckpoints)
+}
+
+func TestHandler_VerifyAuditLogChain_IgnoresUnverifiedCheckpointWhenWalking(t *testing.T) {
+	repo := new(mockAuditLogChainRepo)
+	tenantID, _ := uuid.NewV7()
+	chain, head := newAuditLogChain(tenantID, 2)
+	forged := entities.NewAuditLogCheckpoint(tenantID, 2, chain[1].ID, strings.Repeat("f", 64))
+	forged.KeyID = "forged"
+	forged.Signature = "not-a-jws"
+
+	repo.On("GetAuditLogChainHead", mock.Anything, tenantID).Return(head, nil)
+	repo.On("ListAuditLogCheckpoints", mock.Anything, tenantID).Return([]*entities.AuditLogCheckpoint{forged}, nil)
+	repo.On("ListAuditLogChain", mock.Anything, tenantID, int64(0), 2).Return(chain, nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), Query{TenantID: tenantID})
+
+	require.NoError(t, err)
+	assert.Nil(t, response.FirstBrokenLink, "a checkpoint that doesn't verify must not pin the chain")
+	assert.Equal(t, int64(2), response.CheckedEntries)
+}
@@ -0,0 +1,28 @@
+package verifyauditlogchain
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetAuditLogChainHead(ctx context.Context, tenantID uuid.UUID) (*entities.AuditLogChainHead, error)
+	ListAuditLogChain(ctx context.Context, tenantID uuid.UUID, afterSequence int64, limit int) ([]*entities.AuditLog, error)
+	ListAuditLogCheckpoints(ctx context.Context, tenantID uuid.UUID) ([]*entities.AuditLogCheckpoint, error)
+}
+
+type Repository struct {
+	repositories.AuditLogRepository
+	repositories.AuditLogCheckpointRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		AuditLogRepository:           repositories.AuditLogRepository{Store: q},
+		AuditLogCheckpointRepository: repositories.AuditLogCheckpointRepository{Store: q},
+	}
+}
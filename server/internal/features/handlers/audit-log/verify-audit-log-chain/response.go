@@ -0,0 +1,25 @@
+package verifyauditlogchain
+
+import "github.com/google/uuid"
+
+type BrokenLinkResponse struct {
+	AuditLogID    *uuid.UUID `json:"auditLogId"` // null when the entry is missing
+	ChainSequence int64      `json:"chainSequence"`
+	Reason        string     `json:"reason"`
+}
+
+type Response struct {
+	Valid          bool   `json:"valid"`
+	CheckedEntries int64  `json:"checkedEntries"`
+	HeadSequence   int64  `json:"headSequence"`
+	LastSequence   int64  `json:"lastSequence"` // last entry that passed verification
+	LastHash       string `json:"lastHash"`
+	// FirstBrokenLink is null when the chain is intact.
+	FirstBrokenLink *BrokenLinkResponse `json:"firstBrokenLink"`
+	// UnverifiedCheckpoints are the sequences of checkpoints whose signature
+	// doesn't verify.
+	UnverifiedCheckpoints []int64 `json:"unverifiedCheckpoints"`
+	// UnknownKeyCheckpoints are the sequences of checkpoints signed with a key
+	// that has since been purged, so they can no longer be checked.
+	UnknownKeyCheckpoints []int64 `json:"unknownKeyCheckpoints"`
+}
@@ -14,5 +14,7 @@ type Command struct {
 	Scope               string     `json:"scope"`
 	State               string     `json:"state" validate:"required"`
 	// OIDC: nonce for ID Token binding (REQUIRED when scope contains "openid")
-	Nonce string `json:"nonce"`
+	Nonce     string `json:"nonce"`
+	IPAddress string `json:"-"` // injected server-side
+	UserAgent string `json:"-"` // injected server-side
 }
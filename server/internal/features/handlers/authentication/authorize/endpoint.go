@@ -3,6 +3,7 @@ package authorize
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -19,6 +20,9 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 		panic(err)
 	}
 
+	command.IPAddress = clientip.RealIP(request)
+	command.UserAgent = request.UserAgent()
+
 	params := repositories.ParamsRs[Command, *Response, Handler]{
 		DbPool:  c.DbPool,
 		New:     New,
@@ -5,8 +5,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -32,6 +34,18 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, &errors.ErrInvalidCodeChallengeMethod
 	}
 
+	// The code will be delivered to RedirectUri, so it must be one the
+	// application registered (exact match).
+	registeredRedirectURIs, err := s.repository.ListRedirectURIs(ctx, command.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !services.MatchRedirectURI(registeredRedirectURIs, constants.RedirectURIKindLogin, command.RedirectUri) {
+		return nil, &errors.ErrRedirectURINotRegistered
+	}
+
 	user, err := s.repository.GetUserByEmail(ctx, command.Email, command.ApplicationID)
 
 	if err != nil {
@@ -106,6 +120,9 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, err
 	}
 
+	authorizationCode.IPAddress = &command.IPAddress
+	authorizationCode.UserAgent = &command.UserAgent
+
 	if err := s.repository.RemoveAuthorizationCode(ctx, user.ID, command.ApplicationID); err != nil {
 		return nil, err
 	}
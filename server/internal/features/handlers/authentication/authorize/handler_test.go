@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -54,10 +56,24 @@ func (m *mockAuthorizeRepo) GetUserCredentialsByUserID(ctx context.Context, user
 	return args.Get(0).(*entities.UserCredentials), args.Error(1)
 }
 
+func (m *mockAuthorizeRepo) ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.ApplicationRedirectURI), args.Error(1)
+}
+
 // ---------------------------------------------------------------------------
 // Helpers
 // ---------------------------------------------------------------------------
 
+func registeredRedirectURIs(appID uuid.UUID) []entities.ApplicationRedirectURI {
+	return []entities.ApplicationRedirectURI{
+		{ApplicationID: appID, URI: "https://app.example.com/callback", Kind: constants.RedirectURIKindLogin},
+	}
+}
+
 func newUser(appID uuid.UUID, active, confirmed bool) *entities.TenantUser {
 	id, _ := uuid.NewV7()
 	return &entities.TenantUser{
@@ -109,6 +125,7 @@ func baseAuthorizeCommand(appID uuid.UUID) Command {
 func TestHandler_Authorize_UserNotFound(t *testing.T) {
 	repo := new(mockAuthorizeRepo)
 	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).
 		Return((*entities.TenantUser)(nil), nil)
@@ -124,6 +141,7 @@ func TestHandler_Authorize_UserNotFound(t *testing.T) {
 func TestHandler_Authorize_UserNotActive(t *testing.T) {
 	repo := new(mockAuthorizeRepo)
 	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
 	user := newUser(appID, false, true)
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
@@ -139,6 +157,7 @@ func TestHandler_Authorize_UserNotActive(t *testing.T) {
 func TestHandler_Authorize_EmailNotConfirmed(t *testing.T) {
 	repo := new(mockAuthorizeRepo)
 	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
 	user := newUser(appID, true, false)
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
@@ -154,6 +173,7 @@ func TestHandler_Authorize_EmailNotConfirmed(t *testing.T) {
 func TestHandler_Authorize_ShouldChangePassword(t *testing.T) {
 	repo := new(mockAuthorizeRepo)
 	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
 	user := newUser(appID, true, true)
 	creds := newCreds(user.ID, true)
 
@@ -171,6 +191,7 @@ func TestHandler_Authorize_ShouldChangePassword(t *testing.T) {
 func TestHandler_Authorize_SessionCodeNotFound(t *testing.T) {
 	repo := new(mockAuthorizeRepo)
 	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
 	user := newUser(appID, true, true)
 	creds := newCreds(user.ID, false)
 
@@ -190,6 +211,7 @@ func TestHandler_Authorize_SessionCodeNotFound(t *testing.T) {
 func TestHandler_Authorize_SessionCodeExpired(t *testing.T) {
 	repo := new(mockAuthorizeRepo)
 	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
 	user := newUser(appID, true, true)
 	creds := newCreds(user.ID, false)
 
@@ -211,6 +233,7 @@ func TestHandler_Authorize_SessionCodeExpired(t *testing.T) {
 func TestHandler_Authorize_Success(t *testing.T) {
 	repo := new(mockAuthorizeRepo)
 	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
 	user := newUser(appID, true, true)
 	creds := newCreds(user.ID, false)
 	session := validSessionCode(user.ID)
@@ -235,9 +258,39 @@ func TestHandler_Authorize_Success(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestHandler_Authorize_StoresClientContextOnCode(t *testing.T) {
+	repo := new(mockAuthorizeRepo)
+	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
+	user := newUser(appID, true, true)
+	creds := newCreds(user.ID, false)
+	session := validSessionCode(user.ID)
+
+	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetAuthorizationSession", mock.Anything, user.ID, "valid-session-token").Return(session, nil)
+	repo.On("DeleteSessionCodeByID", mock.Anything, session.ID).Return(nil)
+	repo.On("RemoveAuthorizationCode", mock.Anything, user.ID, appID).Return(nil)
+	repo.On("AddAuthorizationCode", mock.Anything, mock.MatchedBy(func(code *entities.ApplicationAuthorizationCode) bool {
+		return code.IPAddress != nil && *code.IPAddress == "203.0.113.7" &&
+			code.UserAgent != nil && *code.UserAgent == "Mozilla/5.0"
+	})).Return(nil)
+
+	cmd := baseAuthorizeCommand(appID)
+	cmd.IPAddress = "203.0.113.7"
+	cmd.UserAgent = "Mozilla/5.0"
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), cmd)
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
 func TestHandler_Authorize_Success_WithTOTP(t *testing.T) {
 	repo := new(mockAuthorizeRepo)
 	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
 	user := newUser(appID, true, true)
 	totp := "totp"
 	user.Preferred2FAMethod = &totp
@@ -263,3 +316,18 @@ func TestHandler_Authorize_Success_WithTOTP(t *testing.T) {
 	assert.NotEmpty(t, resp.AuthorizationCode)
 	repo.AssertExpectations(t)
 }
+
+func TestHandler_Authorize_RedirectURINotRegistered(t *testing.T) {
+	repo := new(mockAuthorizeRepo)
+	appID, _ := uuid.NewV7()
+	repo.On("ListRedirectURIs", mock.Anything, appID).Return(registeredRedirectURIs(appID), nil)
+
+	command := baseAuthorizeCommand(appID)
+	command.RedirectUri = "https://attacker.example.com/callback"
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), command)
+
+	assert.ErrorIs(t, err, &errors.ErrRedirectURINotRegistered)
+	repo.AssertNotCalled(t, "GetUserByEmail", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -16,6 +16,7 @@ type IRepository interface {
 	RemoveAuthorizationCode(ctx context.Context, userID, applicationID uuid.UUID) error
 	AddAuthorizationCode(ctx context.Context, authorizationCode *entities.ApplicationAuthorizationCode) error
 	GetUserCredentialsByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserCredentials, error)
+	ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error)
 }
 
 type Repository struct {
@@ -23,6 +24,7 @@ type Repository struct {
 	repositories.SessionRepository
 	repositories.AuthorizationCodeRepository
 	repositories.UserCredentialsRepository
+	repositories.RedirectURIRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -31,5 +33,6 @@ func NewRepository(q *pgstore.Queries) Repository {
 		SessionRepository:           repositories.SessionRepository{Store: q},
 		AuthorizationCodeRepository: repositories.AuthorizationCodeRepository{Store: q},
 		UserCredentialsRepository:   repositories.UserCredentialsRepository{Store: q},
+		RedirectURIRepository:       repositories.RedirectURIRepository{Store: q},
 	}
 }
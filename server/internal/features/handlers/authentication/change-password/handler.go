@@ -79,16 +79,29 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 		return err
 	}
 
-	hashedPassword, err := application_utils.HashPassword(command.NewPassword, tenant.PasswordHashSecret)
+	passwordPolicy := application_utils.TenantPasswordPolicy(tenant, application)
+	hashPolicy := application_utils.TenantPasswordHashPolicy(tenant)
+
+	if err := application_utils.ValidatePasswordChange(ctx, s.repository, passwordPolicy, hashPolicy.Peppers(), userCredentials, command.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := application_utils.HashPassword(command.NewPassword, hashPolicy)
 
 	if err != nil {
 		return err
 	}
 
-	userCredentials.PasswordHash = hashedPassword
-	userCredentials.ShouldChangePass = false
+	if err := application_utils.RecordPasswordHistory(ctx, s.repository, passwordPolicy, userCredentials); err != nil {
+		return err
+	}
+
+	userCredentials.SetPassword(hashedPassword)
+
+	if err := s.repository.UpdateUserCredentials(ctx, userCredentials); err != nil {
+		return err
+	}
 
-	s.repository.UpdateUserCredentials(ctx, userCredentials)
 	s.repository.RevokeRefreshTokenFromUser(ctx, user.ID)
 	s.repository.RevokeAllChangePasswordCodeByUserID(ctx, user.ID)
 
@@ -19,6 +19,9 @@ type IRepository interface {
 	RevokeAllChangePasswordCodeByUserID(ctx context.Context, userID uuid.UUID) error
 	GetUserCredentialsByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserCredentials, error)
 	UpdateUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
+	AddPasswordHistory(ctx context.Context, passwordHistory *entities.PasswordHistory) error
+	ListPasswordHistoryByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]entities.PasswordHistory, error)
+	RemoveOldPasswordHistory(ctx context.Context, userID uuid.UUID, keep int) error
 }
 
 type Repository struct {
@@ -28,6 +31,7 @@ type Repository struct {
 	repositories.UserRepository
 	repositories.RefreshTokenRepository
 	repositories.UserCredentialsRepository
+	repositories.PasswordHistoryRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -38,5 +42,6 @@ func NewRepository(q *pgstore.Queries) Repository {
 		UserRepository:               repositories.UserRepository{Store: q},
 		RefreshTokenRepository:       repositories.RefreshTokenRepository{Store: q},
 		UserCredentialsRepository:    repositories.UserCredentialsRepository{Store: q},
+		PasswordHistoryRepository:    repositories.PasswordHistoryRepository{Store: q},
 	}
 }
@@ -13,4 +13,6 @@ type Command struct {
 	CodeChallenge       string    `json:"codeChallenge" validate:"required"`
 	RedirectUri         string    `json:"redirectUri" validate:"required"`
 	Nonce               string    `json:"nonce"`
+	IPAddress           string    `json:"-"` // injected server-side
+	UserAgent           string    `json:"-"` // injected server-side
 }
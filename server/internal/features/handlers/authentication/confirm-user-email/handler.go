@@ -59,6 +59,9 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, err
 	}
 
+	authorizationCode.IPAddress = &command.IPAddress
+	authorizationCode.UserAgent = &command.UserAgent
+
 	if err := s.repository.AddAuthorizationCode(ctx, authorizationCode); err != nil {
 		return nil, err
 	}
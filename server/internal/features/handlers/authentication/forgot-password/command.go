@@ -5,4 +5,6 @@ import "github.com/google/uuid"
 type Command struct {
 	ApplicationID uuid.UUID `json:"applicationId" validate:"required,uuid"`
 	Email         string    `json:"email" validate:"required,email"`
+	IPAddress     string    `json:"-"` // injected server-side
+	UserAgent     string    `json:"-"` // injected server-side
 }
@@ -3,6 +3,7 @@ package forgotpassword
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
 	"github.com/gate-keeper/internal/infra/database/repositories"
@@ -49,6 +50,13 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 		return nil
 	}
 
+	auditLog := entities.NewAuditLog(user.ID, command.ApplicationID,
+		constants.AuditEventPasswordResetRequest, command.IPAddress, command.UserAgent, "success", nil)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return err
+	}
+
 	userProfile, err := s.repository.GetUserProfileByID(ctx, user.ID)
 
 	if err != nil {
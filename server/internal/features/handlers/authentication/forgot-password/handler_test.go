@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	mailservice "github.com/gate-keeper/internal/infra/mail-service"
 	"github.com/google/uuid"
@@ -51,6 +52,10 @@ func (m *mockForgotPasswordRepo) DeletePasswordResetFromUser(ctx context.Context
 	return m.Called(ctx, userID).Error(0)
 }
 
+func (m *mockForgotPasswordRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
 // Compile-time check
 var _ IRepository = (*mockForgotPasswordRepo)(nil)
 
@@ -72,6 +77,10 @@ func (m *mockMailService) SendForgotPasswordEmail(ctx context.Context, to, userN
 	return m.Called(ctx, to, userName, token, passwordResetID, applicationID).Error(0)
 }
 
+func (m *mockMailService) SendAccountLockedEmail(ctx context.Context, to, userName string, lockedUntil time.Time) error {
+	return m.Called(ctx, to, userName, lockedUntil).Error(0)
+}
+
 var _ mailservice.IMailService = (*mockMailService)(nil)
 
 // ---------------------------------------------------------------------------
@@ -154,6 +163,9 @@ func TestHandler_ForgotPassword_Success(t *testing.T) {
 	repo.On("DeletePasswordResetFromUser", mock.Anything, user.ID).Return(nil)
 	repo.On("CreatePasswordReset", mock.Anything, mock.AnythingOfType("*entities.PasswordResetToken")).Return(nil)
 	repo.On("GetUserProfileByID", mock.Anything, user.ID).Return(profile, nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventPasswordResetRequest && *auditLog.UserID == user.ID
+	})).Return(nil)
 	mailSvc.On("SendForgotPasswordEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Maybe().Return(nil)
 
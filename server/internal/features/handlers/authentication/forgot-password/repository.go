@@ -14,12 +14,14 @@ type IRepository interface {
 	GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error)
 	CreatePasswordReset(ctx context.Context, passwordReset *entities.PasswordResetToken) error
 	DeletePasswordResetFromUser(ctx context.Context, userID uuid.UUID) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.UserRepository
 	repositories.UserProfileRepository
 	repositories.PasswordResetRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -27,5 +29,6 @@ func NewRepository(q *pgstore.Queries) Repository {
 		UserRepository: repositories.UserRepository{Store: q},
 		UserProfileRepository: repositories.UserProfileRepository{Store: q},
 		PasswordResetRepository: repositories.PasswordResetRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
 	}
 }
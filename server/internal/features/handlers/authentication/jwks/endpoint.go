@@ -0,0 +1,29 @@
+package jwks
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/signing"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+)
+
+type Endpoint struct{}
+
+// Http handles GET /.well-known/jwks.json
+// Publishes the public half of every asymmetric key that can still verify
+// tokens, so resource servers can validate tokens without the shared secret.
+func (e *Endpoint) Http(writer http.ResponseWriter, request *http.Request) {
+	response := signing.JWKSet{Keys: []signing.JWK{}}
+
+	for _, key := range signing.Current().PublicKeys() {
+		jwk, err := key.PublicJWK()
+		if err != nil {
+			panic(err)
+		}
+
+		response.Keys = append(response.Keys, *jwk)
+	}
+
+	writer.Header().Set("Cache-Control", "public, max-age=300")
+	http_router.SendJson(writer, response, http.StatusOK)
+}
@@ -12,4 +12,6 @@ type Command struct {
 	ResponseType        string    `json:"responseType" validate:"required"`
 	Scope               string    `json:"scope"`
 	State               string    `json:"state" validate:"required"`
+	IPAddress           string    `json:"-"` // injected server-side
+	UserAgent           string    `json:"-"` // injected server-side
 }
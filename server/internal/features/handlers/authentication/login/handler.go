@@ -3,10 +3,12 @@ package login
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
@@ -33,11 +35,11 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 	}
 
 	if user == nil {
-		return nil, &errors.ErrUserNotFound
+		return nil, s.auditUnknownUserLogin(ctx, command)
 	}
 
 	if !user.IsActive {
-		return nil, &errors.ErrUserNotActive
+		return nil, s.auditFailedLogin(ctx, command, user, "user_not_active", &errors.ErrUserNotActive)
 	}
 
 	userCredentials, err := s.repository.GetUserCredentialsByUserID(ctx, user.ID)
@@ -46,18 +48,66 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, err
 	}
 
-	isPasswordCorrect, err := application_utils.ComparePassword(userCredentials.PasswordHash, command.Password)
+	// Users created through an external provider, or imported without a
+	// password hash, cannot sign in with a password.
+	if userCredentials == nil {
+		return nil, s.auditFailedLogin(ctx, command, user, "no_password", &errors.ErrEmailOrPasswordInvalid)
+	}
+
+	tenant, err := s.repository.GetTenantByID(ctx, user.TenantID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	lockoutPolicy := application_utils.TenantLockoutPolicy(tenant)
+
+	lockout, err := s.repository.GetUserLockoutByUserID(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if reason, err := application_utils.PasswordAttemptBlocked(lockoutPolicy, lockout, now); err != nil {
+		return nil, s.auditFailedLogin(ctx, command, user, reason, err)
+	}
+
+	policy := application_utils.TenantPasswordHashPolicy(tenant)
+
+	isPasswordCorrect, err := application_utils.ComparePassword(userCredentials.PasswordHash, command.Password, policy.Peppers()...)
 
 	if err != nil {
 		return nil, err
 	}
 
 	if !isPasswordCorrect {
-		return nil, &errors.ErrEmailOrPasswordInvalid
+		return nil, s.registerFailedLogin(ctx, command, user, tenant, lockout, now)
+	}
+
+	// A successful login clears the failed attempts and the lockout backoff
+	if lockout != nil {
+		if err := s.repository.RemoveUserLockout(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Upgrade hashes produced with a shared salt, an old pepper or weaker costs
+	if err := application_utils.RehashPasswordIfNeeded(ctx, s.repository, userCredentials, command.Password, policy); err != nil {
+		return nil, err
+	}
+
+	// Passwords older than the tenant's maximum age have to be changed
+	if !userCredentials.ShouldChangePass && services.IsPasswordExpired(application_utils.TenantPasswordPolicy(tenant, nil), userCredentials.PasswordChangedAt, now) {
+		userCredentials.ShouldChangePass = true
+
+		if err := s.repository.UpdateUserCredentials(ctx, userCredentials); err != nil {
+			return nil, err
+		}
 	}
 
 	if !user.IsEmailConfirmed {
-		return nil, &errors.ErrEmailNotConfirmed
+		return nil, s.auditFailedLogin(ctx, command, user, "email_not_confirmed", &errors.ErrEmailNotConfirmed)
 	}
 
 	// Revoke all Password change codes if exists
@@ -103,6 +153,10 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 			return nil, err
 		}
 
+		if err := s.auditMfaChallenge(ctx, command, user); err != nil {
+			return nil, err
+		}
+
 		go func() {
 			if err := s.mailService.SendMfaEmail(ctx, user.Email, userProfile.FirstName, mfaEmailCode.Token); err != nil {
 				panic(err)
@@ -157,6 +211,10 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 			return nil, err
 		}
 
+		if err := s.auditMfaChallenge(ctx, command, user); err != nil {
+			return nil, err
+		}
+
 		response := &Response{
 			MfaType:            user.Preferred2FAMethod,
 			ChangePasswordCode: nil,
@@ -228,6 +286,10 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 			return nil, err
 		}
 
+		if err := s.auditMfaChallenge(ctx, command, user); err != nil {
+			return nil, err
+		}
+
 		optionsJSON, err := json.Marshal(credentialAssertion)
 		if err != nil {
 			return nil, err
@@ -263,6 +325,13 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, err
 	}
 
+	auditLog := entities.NewAuditLog(user.ID, command.ApplicationID,
+		constants.AuditEventLoginSucceeded, command.IPAddress, command.UserAgent, "success", nil)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
 	tokenString := sessionToken.Token
 
 	if changePasswordCode == nil {
@@ -283,3 +352,77 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		UserID:             user.ID,
 	}, nil
 }
+
+// auditUnknownUserLogin records a login attempt with an e-mail that has no
+// account in the application. Nothing is recorded for unknown applications
+// since the entry would belong to no tenant.
+func (s *Handler) auditUnknownUserLogin(ctx context.Context, command Command) error {
+	application, err := s.repository.GetApplicationByID(ctx, command.ApplicationID)
+
+	if err != nil {
+		return err
+	}
+
+	if application == nil {
+		return &errors.ErrUserNotFound
+	}
+
+	details := "reason: unknown_user, email: " + command.Email
+	auditLog := entities.NewTenantAuditLog(application.TenantID, nil, &command.ApplicationID,
+		constants.AuditEventLoginFailed, command.IPAddress, command.UserAgent, "failure", &details)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return err
+	}
+
+	return repositories.CommitAndReturn(&errors.ErrUserNotFound)
+}
+
+// auditFailedLogin records why the login of user was refused. The returned
+// error commits the entry, which would otherwise be rolled back with the
+// failed login.
+func (s *Handler) auditFailedLogin(ctx context.Context, command Command, user *entities.TenantUser, reason string, loginErr error) error {
+	if err := s.addFailedLoginAuditLog(ctx, command, user, reason); err != nil {
+		return err
+	}
+
+	return repositories.CommitAndReturn(loginErr)
+}
+
+func (s *Handler) addFailedLoginAuditLog(ctx context.Context, command Command, user *entities.TenantUser, reason string) error {
+	details := "reason: " + reason
+	auditLog := entities.NewAuditLog(user.ID, command.ApplicationID,
+		constants.AuditEventLoginFailed, command.IPAddress, command.UserAgent, "failure", &details)
+
+	return s.repository.AddAuditLog(ctx, auditLog)
+}
+
+// auditMfaChallenge records that the password was accepted and the login now
+// waits for the user's preferred second factor.
+func (s *Handler) auditMfaChallenge(ctx context.Context, command Command, user *entities.TenantUser) error {
+	details := "method: " + *user.Preferred2FAMethod
+	auditLog := entities.NewAuditLog(user.ID, command.ApplicationID,
+		constants.AuditEventMfaChallengeIssued, command.IPAddress, command.UserAgent, "success", &details)
+
+	return s.repository.AddAuditLog(ctx, auditLog)
+}
+
+// registerFailedLogin audits a wrong password and counts it against the
+// account, which locks once the tenant's threshold is reached. The returned
+// error commits the count and the audit entries, which would otherwise be
+// rolled back with the failed login.
+func (s *Handler) registerFailedLogin(ctx context.Context, command Command, user *entities.TenantUser, tenant *entities.Tenant, lockout *entities.UserLockout, now time.Time) error {
+	if err := s.addFailedLoginAuditLog(ctx, command, user, "invalid_password"); err != nil {
+		return err
+	}
+
+	attempt := application_utils.PasswordAttempt{
+		User:          user,
+		Tenant:        tenant,
+		ApplicationID: command.ApplicationID,
+		IPAddress:     command.IPAddress,
+		UserAgent:     command.UserAgent,
+	}
+
+	return application_utils.RegisterFailedPassword(ctx, s.repository, s.mailService, attempt, lockout, now, &errors.ErrEmailOrPasswordInvalid)
+}
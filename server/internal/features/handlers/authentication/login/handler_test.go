@@ -3,24 +3,28 @@ package login
 import (
 	"context"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
 	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
 	mailservice "github.com/gate-keeper/internal/infra/mail-service"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // testPasswordHash holds an argon2 hash of "testpassword" computed once for the suite.
 var testPasswordHash string
 
 func TestMain(m *testing.M) {
-	hash, err := application_utils.HashPassword("testpassword", "test-salt-key")
+	hash, err := application_utils.HashPassword("testpassword", application_utils.TenantPasswordHashPolicy(newTenant(uuid.Nil)))
 	if err != nil {
 		panic("failed to pre-compute test password hash: " + err.Error())
 	}
@@ -98,6 +102,18 @@ func (m *mockLoginRepo) AddChangePasswordCode(ctx context.Context, code *entitie
 	return m.Called(ctx, code).Error(0)
 }
 
+func (m *mockLoginRepo) UpdateUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error {
+	return m.Called(ctx, userCredentials).Error(0)
+}
+
+func (m *mockLoginRepo) GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Tenant), args.Error(1)
+}
+
 func (m *mockLoginRepo) GetMfaMethodByUserID(ctx context.Context, userID uuid.UUID, method string) (*entities.MfaMethod, error) {
 	args := m.Called(ctx, userID, method)
 	if args.Get(0) == nil {
@@ -114,6 +130,26 @@ func (m *mockLoginRepo) GetUserCredentialsByUserID(ctx context.Context, userID u
 	return args.Get(0).(*entities.UserCredentials), args.Error(1)
 }
 
+func (m *mockLoginRepo) GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserLockout), args.Error(1)
+}
+
+func (m *mockLoginRepo) UpsertUserLockout(ctx context.Context, lockout *entities.UserLockout) error {
+	return m.Called(ctx, lockout).Error(0)
+}
+
+func (m *mockLoginRepo) RemoveUserLockout(ctx context.Context, userID uuid.UUID) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+func (m *mockLoginRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
 // ---------------------------------------------------------------------------
 // Mail service mock
 // ---------------------------------------------------------------------------
@@ -132,6 +168,10 @@ func (m *mockMailService) SendForgotPasswordEmail(ctx context.Context, to, userN
 	return m.Called(ctx, to, userName, token, passwordResetID, applicationID).Error(0)
 }
 
+func (m *mockMailService) SendAccountLockedEmail(ctx context.Context, to, userName string, lockedUntil time.Time) error {
+	return m.Called(ctx, to, userName, lockedUntil).Error(0)
+}
+
 // Compile-time check that mock satisfies the interface.
 var _ mailservice.IMailService = (*mockMailService)(nil)
 
@@ -151,6 +191,17 @@ func newActiveConfirmedUser(appID uuid.UUID) *entities.TenantUser {
 	}
 }
 
+// newTenant returns a tenant whose password policy testPasswordHash satisfies.
+func newTenant(tenantID uuid.UUID) *entities.Tenant {
+	return &entities.Tenant{
+		ID:                      tenantID,
+		PasswordHashSecret:      "test-pepper-key",
+		PasswordHashMemory:      constants.DefaultPasswordHashMemory,
+		PasswordHashIterations:  constants.DefaultPasswordHashIterations,
+		PasswordHashParallelism: constants.DefaultPasswordHashParallelism,
+	}
+}
+
 func newCredentials(userID uuid.UUID, shouldChange bool) *entities.UserCredentials {
 	return &entities.UserCredentials{
 		UserID:           userID,
@@ -160,6 +211,20 @@ func newCredentials(userID uuid.UUID, shouldChange bool) *entities.UserCredentia
 	}
 }
 
+// expectAuditLog expects an audit entry of eventType with details, or with no
+// details when details is empty.
+func expectAuditLog(repo *mockLoginRepo, eventType, details string) {
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		if auditLog.EventType != eventType {
+			return false
+		}
+		if details == "" {
+			return auditLog.Details == nil
+		}
+		return auditLog.Details != nil && *auditLog.Details == details
+	})).Return(nil)
+}
+
 func baseCommand(appID uuid.UUID) Command {
 	return Command{
 		ApplicationID: appID,
@@ -176,13 +241,21 @@ func TestHandler_Login_UserNotFound(t *testing.T) {
 	repo := new(mockLoginRepo)
 	appID, _ := uuid.NewV7()
 
+	tenantID, _ := uuid.NewV7()
+
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).
 		Return((*entities.TenantUser)(nil), nil)
+	repo.On("GetApplicationByID", mock.Anything, appID).Return(&entities.Application{ID: appID, TenantID: tenantID}, nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventLoginFailed && auditLog.UserID == nil &&
+			auditLog.TenantID == tenantID && *auditLog.Details == "reason: unknown_user, email: user@example.com"
+	})).Return(nil)
 
 	h := &Handler{repository: repo, mailService: new(mockMailService)}
 	_, err := h.Handler(context.Background(), baseCommand(appID))
 
-	require.Error(t, err)
+	var commitErr *repositories.CommitError
+	require.ErrorAs(t, err, &commitErr, "the failed login must be recorded")
 	assert.Equal(t, "ErrUserNotFound", err.Error())
 	repo.AssertExpectations(t)
 }
@@ -195,6 +268,8 @@ func TestHandler_Login_UserNotActive(t *testing.T) {
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
 
+	expectAuditLog(repo, constants.AuditEventLoginFailed, "reason: user_not_active")
+
 	h := &Handler{repository: repo, mailService: new(mockMailService)}
 	_, err := h.Handler(context.Background(), baseCommand(appID))
 
@@ -211,10 +286,14 @@ func TestHandler_Login_WrongPassword(t *testing.T) {
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
 	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
 
 	cmd := baseCommand(appID)
 	cmd.Password = "wrongpassword"
 
+	expectAuditLog(repo, constants.AuditEventLoginFailed, "reason: invalid_password")
+
 	h := &Handler{repository: repo, mailService: new(mockMailService)}
 	_, err := h.Handler(context.Background(), cmd)
 
@@ -232,6 +311,10 @@ func TestHandler_Login_EmailNotConfirmed(t *testing.T) {
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
 	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
+
+	expectAuditLog(repo, constants.AuditEventLoginFailed, "reason: email_not_confirmed")
 
 	h := &Handler{repository: repo, mailService: new(mockMailService)}
 	_, err := h.Handler(context.Background(), baseCommand(appID))
@@ -249,9 +332,13 @@ func TestHandler_Login_Success(t *testing.T) {
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
 	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
 	repo.On("RevokeAllChangePasswordCodeByUserID", mock.Anything, user.ID).Return(nil)
 	repo.On("AddSessionCode", mock.Anything, mock.AnythingOfType("*entities.SessionCode")).Return(nil)
 
+	expectAuditLog(repo, constants.AuditEventLoginSucceeded, "")
+
 	h := &Handler{repository: repo, mailService: new(mockMailService)}
 	resp, err := h.Handler(context.Background(), baseCommand(appID))
 
@@ -265,6 +352,67 @@ func TestHandler_Login_Success(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestHandler_Login_RehashesWeakerPasswordHash(t *testing.T) {
+	repo := new(mockLoginRepo)
+	appID, _ := uuid.NewV7()
+	user := newActiveConfirmedUser(appID)
+	tenant := newTenant(user.TenantID)
+
+	// Hash produced before per-user salts: the tenant secret was the salt.
+	legacyHash := "$argon2id$v=19$m=65536,t=3,p=4$dGVzdC1wZXBwZXIta2V5$" +
+		"EslYWrSE7T42JwvE1MtfI0tO5JjcxXvSe7DyVVQE+/s"
+	creds := newCredentials(user.ID, false)
+	creds.PasswordHash = legacyHash
+
+	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(tenant, nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
+	repo.On("UpdateUserCredentials", mock.Anything, creds).Return(nil)
+	repo.On("RevokeAllChangePasswordCodeByUserID", mock.Anything, user.ID).Return(nil)
+	repo.On("AddSessionCode", mock.Anything, mock.AnythingOfType("*entities.SessionCode")).Return(nil)
+
+	expectAuditLog(repo, constants.AuditEventLoginSucceeded, "")
+
+	h := &Handler{repository: repo, mailService: new(mockMailService)}
+	_, err := h.Handler(context.Background(), baseCommand(appID))
+
+	require.NoError(t, err)
+	assert.NotEqual(t, legacyHash, creds.PasswordHash)
+	assert.False(t, application_utils.PasswordNeedsRehash(creds.PasswordHash, application_utils.TenantPasswordHashPolicy(tenant)))
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_Login_UpgradesImportedBcryptHash(t *testing.T) {
+	repo := new(mockLoginRepo)
+	appID, _ := uuid.NewV7()
+	user := newActiveConfirmedUser(appID)
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("testpassword"), bcrypt.MinCost)
+	require.NoError(t, err)
+	creds := newCredentials(user.ID, false)
+	creds.PasswordHash = string(bcryptHash)
+	creds.PasswordAlgorithm = constants.UserCredentialsPasswordAlgorithmBcrypt
+
+	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
+	repo.On("UpdateUserCredentials", mock.Anything, creds).Return(nil)
+	repo.On("RevokeAllChangePasswordCodeByUserID", mock.Anything, user.ID).Return(nil)
+	repo.On("AddSessionCode", mock.Anything, mock.AnythingOfType("*entities.SessionCode")).Return(nil)
+
+	expectAuditLog(repo, constants.AuditEventLoginSucceeded, "")
+
+	h := &Handler{repository: repo, mailService: new(mockMailService)}
+	_, err = h.Handler(context.Background(), baseCommand(appID))
+
+	require.NoError(t, err)
+	assert.Equal(t, constants.UserCredentialsPasswordAlgorithmArgon2id, creds.PasswordAlgorithm)
+	assert.True(t, strings.HasPrefix(creds.PasswordHash, "$argon2id$"))
+	repo.AssertExpectations(t)
+}
+
 func TestHandler_Login_Success_ShouldChangePassword(t *testing.T) {
 	repo := new(mockLoginRepo)
 	appID, _ := uuid.NewV7()
@@ -273,10 +421,14 @@ func TestHandler_Login_Success_ShouldChangePassword(t *testing.T) {
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
 	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
 	repo.On("RevokeAllChangePasswordCodeByUserID", mock.Anything, user.ID).Return(nil)
 	repo.On("AddChangePasswordCode", mock.Anything, mock.AnythingOfType("*entities.ChangePasswordCode")).Return(nil)
 	repo.On("AddSessionCode", mock.Anything, mock.AnythingOfType("*entities.SessionCode")).Return(nil)
 
+	expectAuditLog(repo, constants.AuditEventLoginSucceeded, "")
+
 	h := &Handler{repository: repo, mailService: new(mockMailService)}
 	resp, err := h.Handler(context.Background(), baseCommand(appID))
 
@@ -288,6 +440,37 @@ func TestHandler_Login_Success_ShouldChangePassword(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestHandler_Login_ExpiredPasswordRequiresChange(t *testing.T) {
+	repo := new(mockLoginRepo)
+	appID, _ := uuid.NewV7()
+	user := newActiveConfirmedUser(appID)
+	tenant := newTenant(user.TenantID)
+	tenant.PasswordMaxAgeDays = 90
+	creds := newCredentials(user.ID, false)
+	creds.PasswordChangedAt = time.Now().UTC().AddDate(0, 0, -91)
+
+	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(tenant, nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
+	repo.On("UpdateUserCredentials", mock.Anything, mock.MatchedBy(func(c *entities.UserCredentials) bool {
+		return c.ShouldChangePass
+	})).Return(nil)
+	repo.On("RevokeAllChangePasswordCodeByUserID", mock.Anything, user.ID).Return(nil)
+	repo.On("AddChangePasswordCode", mock.Anything, mock.AnythingOfType("*entities.ChangePasswordCode")).Return(nil)
+	repo.On("AddSessionCode", mock.Anything, mock.AnythingOfType("*entities.SessionCode")).Return(nil)
+
+	expectAuditLog(repo, constants.AuditEventLoginSucceeded, "")
+
+	h := &Handler{repository: repo, mailService: new(mockMailService)}
+	resp, err := h.Handler(context.Background(), baseCommand(appID))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.NotNil(t, resp.ChangePasswordCode)
+	repo.AssertExpectations(t)
+}
+
 func TestHandler_Login_MFA_TOTP(t *testing.T) {
 	repo := new(mockLoginRepo)
 	appID, _ := uuid.NewV7()
@@ -310,11 +493,15 @@ func TestHandler_Login_MFA_TOTP(t *testing.T) {
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
 	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
 	repo.On("RevokeAllChangePasswordCodeByUserID", mock.Anything, user.ID).Return(nil)
 	repo.On("GetMfaMethodByUserID", mock.Anything, user.ID, constants.MfaMethodTotp).Return(mfaMethod, nil)
 	repo.On("GetMfaTotpSecretValidationByUserID", mock.Anything, user.ID).Return(mfaSecret, nil)
 	repo.On("AddMfaTotpCode", mock.Anything, mock.AnythingOfType("*entities.MfaTotpCode")).Return(nil)
 
+	expectAuditLog(repo, constants.AuditEventMfaChallengeIssued, "method: "+totpMethod)
+
 	h := &Handler{repository: repo, mailService: new(mockMailService)}
 	resp, err := h.Handler(context.Background(), baseCommand(appID))
 
@@ -350,6 +537,8 @@ func TestHandler_Login_MFA_Email(t *testing.T) {
 
 	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
 	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
 	repo.On("RevokeAllChangePasswordCodeByUserID", mock.Anything, user.ID).Return(nil)
 	repo.On("GetUserProfileByID", mock.Anything, user.ID).Return(profile, nil)
 	repo.On("GetMfaMethodByUserID", mock.Anything, user.ID, constants.MfaMethodEmail).Return(mfaMethod, nil)
@@ -359,6 +548,8 @@ func TestHandler_Login_MFA_Email(t *testing.T) {
 	mailSvc.On("SendMfaEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Maybe().Return(nil)
 
+	expectAuditLog(repo, constants.AuditEventMfaChallengeIssued, "method: "+emailMethod)
+
 	h := &Handler{repository: repo, mailService: mailSvc}
 	resp, err := h.Handler(context.Background(), baseCommand(appID))
 
@@ -370,3 +561,145 @@ func TestHandler_Login_MFA_Email(t *testing.T) {
 	assert.Nil(t, resp.SessionCode)
 	repo.AssertExpectations(t)
 }
+
+// newLockoutTenant returns a tenant that locks accounts after three failed
+// logins.
+func newLockoutTenant(tenantID uuid.UUID) *entities.Tenant {
+	tenant := newTenant(tenantID)
+	tenant.LockoutThreshold = 3
+	tenant.LockoutDuration = 5 * time.Minute
+	tenant.LockoutMaxDuration = time.Hour
+	return tenant
+}
+
+func TestHandler_Login_WrongPasswordCountsFailedAttempt(t *testing.T) {
+	repo := new(mockLoginRepo)
+	appID, _ := uuid.NewV7()
+	user := newActiveConfirmedUser(appID)
+
+	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(newCredentials(user.ID, false), nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newLockoutTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(nil, nil)
+	repo.On("UpsertUserLockout", mock.Anything, mock.MatchedBy(func(lockout *entities.UserLockout) bool {
+		return lockout.UserID == user.ID && lockout.ApplicationID == appID &&
+			lockout.FailedAttempts == 1 && lockout.LockedUntil == nil
+	})).Return(nil)
+
+	cmd := baseCommand(appID)
+	cmd.Password = "wrongpassword"
+
+	expectAuditLog(repo, constants.AuditEventLoginFailed, "reason: invalid_password")
+
+	h := &Handler{repository: repo, mailService: new(mockMailService)}
+	_, err := h.Handler(context.Background(), cmd)
+
+	var commitErr *repositories.CommitError
+	require.ErrorAs(t, err, &commitErr, "the failed attempt must be committed")
+	assert.ErrorIs(t, err, &errors.ErrEmailOrPasswordInvalid)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_Login_LocksAccountAtThreshold(t *testing.T) {
+	repo := new(mockLoginRepo)
+	mail := new(mockMailService)
+	appID, _ := uuid.NewV7()
+	user := newActiveConfirmedUser(appID)
+	tenant := newLockoutTenant(user.TenantID)
+	tenant.LockoutNotifyUser = true
+	lastFailedAt := time.Now().UTC().Add(-time.Hour)
+	lockout := &entities.UserLockout{UserID: user.ID, ApplicationID: appID, FailedAttempts: 2, LastFailedAt: &lastFailedAt}
+
+	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(newCredentials(user.ID, false), nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(tenant, nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(lockout, nil)
+	repo.On("UpsertUserLockout", mock.Anything, lockout).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventAccountLocked && *auditLog.UserID == user.ID
+	})).Return(nil)
+	repo.On("GetUserProfileByID", mock.Anything, user.ID).Return(&entities.UserProfile{FirstName: "Jane"}, nil)
+	mail.On("SendAccountLockedEmail", mock.Anything, user.Email, "Jane", mock.AnythingOfType("time.Time")).Return(nil).Maybe()
+
+	cmd := baseCommand(appID)
+	cmd.Password = "wrongpassword"
+
+	expectAuditLog(repo, constants.AuditEventLoginFailed, "reason: invalid_password")
+
+	h := &Handler{repository: repo, mailService: mail}
+	_, err := h.Handler(context.Background(), cmd)
+
+	assert.ErrorIs(t, err, &errors.ErrAccountLocked)
+	require.NotNil(t, lockout.LockedUntil)
+	assert.WithinDuration(t, time.Now().UTC().Add(5*time.Minute), *lockout.LockedUntil, time.Minute)
+	assert.Equal(t, 1, lockout.LockoutCount)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_Login_LockedAccountSkipsPasswordCheck(t *testing.T) {
+	repo := new(mockLoginRepo)
+	appID, _ := uuid.NewV7()
+	user := newActiveConfirmedUser(appID)
+	lockedUntil := time.Now().UTC().Add(time.Minute)
+	lockout := &entities.UserLockout{UserID: user.ID, ApplicationID: appID, LockoutCount: 1, LockedUntil: &lockedUntil}
+
+	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(newCredentials(user.ID, false), nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newLockoutTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(lockout, nil)
+
+	expectAuditLog(repo, constants.AuditEventLoginFailed, "reason: account_locked")
+
+	h := &Handler{repository: repo, mailService: new(mockMailService)}
+	_, err := h.Handler(context.Background(), baseCommand(appID))
+
+	assert.ErrorIs(t, err, &errors.ErrAccountLocked)
+	repo.AssertNotCalled(t, "AddSessionCode", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_Login_ThrottledAfterRecentFailures(t *testing.T) {
+	repo := new(mockLoginRepo)
+	appID, _ := uuid.NewV7()
+	user := newActiveConfirmedUser(appID)
+	lastFailedAt := time.Now().UTC()
+	lockout := &entities.UserLockout{UserID: user.ID, ApplicationID: appID, FailedAttempts: 2, LastFailedAt: &lastFailedAt}
+
+	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(newCredentials(user.ID, false), nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newLockoutTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(lockout, nil)
+
+	expectAuditLog(repo, constants.AuditEventLoginFailed, "reason: throttled")
+
+	h := &Handler{repository: repo, mailService: new(mockMailService)}
+	_, err := h.Handler(context.Background(), baseCommand(appID))
+
+	assert.ErrorIs(t, err, &errors.ErrLoginThrottled)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_Login_SuccessClearsFailedAttempts(t *testing.T) {
+	repo := new(mockLoginRepo)
+	appID, _ := uuid.NewV7()
+	user := newActiveConfirmedUser(appID)
+	lastFailedAt := time.Now().UTC().Add(-time.Hour)
+	lockout := &entities.UserLockout{UserID: user.ID, ApplicationID: appID, FailedAttempts: 2, LockoutCount: 1, LastFailedAt: &lastFailedAt}
+
+	repo.On("GetUserByEmail", mock.Anything, "user@example.com", appID).Return(user, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(newCredentials(user.ID, false), nil)
+	repo.On("GetTenantByID", mock.Anything, user.TenantID).Return(newLockoutTenant(user.TenantID), nil)
+	repo.On("GetUserLockoutByUserID", mock.Anything, user.ID).Return(lockout, nil)
+	repo.On("RemoveUserLockout", mock.Anything, user.ID).Return(nil)
+	repo.On("RevokeAllChangePasswordCodeByUserID", mock.Anything, user.ID).Return(nil)
+	repo.On("AddSessionCode", mock.Anything, mock.AnythingOfType("*entities.SessionCode")).Return(nil)
+
+	expectAuditLog(repo, constants.AuditEventLoginSucceeded, "")
+
+	h := &Handler{repository: repo, mailService: new(mockMailService)}
+	resp, err := h.Handler(context.Background(), baseCommand(appID))
+
+	require.NoError(t, err)
+	assert.NotNil(t, resp.SessionCode)
+	repo.AssertExpectations(t)
+}
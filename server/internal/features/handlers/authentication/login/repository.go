@@ -21,8 +21,14 @@ type IRepository interface {
 	AddChangePasswordCode(ctx context.Context, changePasswordCode *entities.ChangePasswordCode) error
 	GetMfaMethodByUserID(ctx context.Context, userID uuid.UUID, method string) (*entities.MfaMethod, error)
 	GetUserCredentialsByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserCredentials, error)
+	UpdateUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
+	GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error)
 	GetWebAuthnCredentialsByMfaMethodID(ctx context.Context, mfaMethodID uuid.UUID) ([]entities.MfaPasskeyCredentials, error)
 	AddMfaPasskeySession(ctx context.Context, session *entities.MfaPasskeySession) error
+	GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error)
+	UpsertUserLockout(ctx context.Context, lockout *entities.UserLockout) error
+	RemoveUserLockout(ctx context.Context, userID uuid.UUID) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
@@ -33,6 +39,9 @@ type Repository struct {
 	repositories.ChangePasswordCodeRepository
 	repositories.SessionRepository
 	repositories.UserCredentialsRepository
+	repositories.TenantRepository
+	repositories.UserLockoutRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -44,5 +53,8 @@ func NewRepository(q *pgstore.Queries) Repository {
 		ChangePasswordCodeRepository: repositories.ChangePasswordCodeRepository{Store: q},
 		SessionRepository:            repositories.SessionRepository{Store: q},
 		UserCredentialsRepository:    repositories.UserCredentialsRepository{Store: q},
+		TenantRepository:             repositories.TenantRepository{Store: q},
+		UserLockoutRepository:        repositories.UserLockoutRepository{Store: q},
+		AuditLogRepository:           repositories.AuditLogRepository{Store: q},
 	}
 }
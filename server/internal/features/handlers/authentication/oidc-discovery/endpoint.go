@@ -4,7 +4,11 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/gate-keeper/internal/domain/constants"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/signing"
 	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/google/uuid"
 )
 
 type Endpoint struct{}
@@ -15,7 +19,10 @@ type OIDCDiscoveryResponse struct {
 	Issuer                            string   `json:"issuer"`
 	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
 	TokenEndpoint                     string   `json:"token_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
 	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	EndSessionEndpoint                string   `json:"end_session_endpoint"`
 	JwksURI                           string   `json:"jwks_uri"`
 	ResponseTypesSupported            []string `json:"response_types_supported"`
 	SubjectTypesSupported             []string `json:"subject_types_supported"`
@@ -38,24 +45,32 @@ func (e *Endpoint) Http(writer http.ResponseWriter, request *http.Request) {
 		baseURL = "http://localhost:8080"
 	}
 
+	signingAlg := signing.AlgorithmHS256
+	if key, err := signing.Current().SigningKey(uuid.Nil); err == nil {
+		signingAlg = key.Algorithm
+	}
+
 	response := OIDCDiscoveryResponse{
 		Issuer:                            issuer,
-		AuthorizationEndpoint:             baseURL + "/v1/auth/authorize",
-		TokenEndpoint:                     baseURL + "/v1/auth/sign-in",
+		AuthorizationEndpoint:             baseURL + "/oauth2/authorize",
+		TokenEndpoint:                     baseURL + "/oauth2/token",
+		IntrospectionEndpoint:             baseURL + "/oauth2/introspect",
+		RevocationEndpoint:                baseURL + "/oauth2/revoke",
 		UserinfoEndpoint:                  baseURL + "/v1/auth/userinfo",
+		EndSessionEndpoint:                baseURL + "/oauth2/logout",
 		JwksURI:                           baseURL + "/.well-known/jwks.json",
 		ResponseTypesSupported:            []string{"code"},
 		SubjectTypesSupported:             []string{"public"},
-		IDTokenSigningAlgValuesSupported:  []string{"HS256"},
-		ScopesSupported:                   []string{"openid", "profile", "email", "offline_access"},
-		TokenEndpointAuthMethodsSupported: []string{"client_secret_post"},
+		IDTokenSigningAlgValuesSupported:  []string{signingAlg},
+		ScopesSupported:                   constants.SupportedScopes,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
 		ClaimsSupported: []string{
 			"sub", "iss", "aud", "exp", "iat", "nbf", "jti",
 			"name", "given_name", "family_name", "email",
-			"nonce", "auth_time",
+			"nonce", "auth_time", application_utils.RolesClaim(), "permissions",
 		},
 		CodeChallengeMethodsSupported: []string{"S256", "plain"},
-		GrantTypesSupported:           []string{"authorization_code", "refresh_token"},
+		GrantTypesSupported:           []string{"authorization_code", "refresh_token", "client_credentials"},
 	}
 
 	http_router.SendJson(writer, response, http.StatusOK)
@@ -7,4 +7,6 @@ type Command struct {
 	PasswordResetId    uuid.UUID `json:"passwordResetId" validate:"required"`
 	NewPassword        string    `json:"newPassword" validate:"required"`
 	ApplicationID      uuid.UUID `json:"applicationId" validate:"required"`
+	IPAddress          string    `json:"-"` // injected server-side
+	UserAgent          string    `json:"-"` // injected server-side
 }
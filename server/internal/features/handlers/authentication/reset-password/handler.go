@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
@@ -36,7 +38,15 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 	}
 
 	if passwordResetToken.Token != command.PasswordResetToken {
-		return &errors.ErrPasswordResetTokenMismatch
+		details := "reason: token_mismatch"
+		auditLog := entities.NewAuditLog(passwordResetToken.UserID, command.ApplicationID,
+			constants.AuditEventPasswordReset, command.IPAddress, command.UserAgent, "failure", &details)
+
+		if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+			return err
+		}
+
+		return repositories.CommitAndReturn(&errors.ErrPasswordResetTokenMismatch)
 	}
 
 	user, err := s.repository.GetUserByID(ctx, passwordResetToken.UserID)
@@ -65,29 +75,52 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 		return err
 	}
 
-	hashedPassword, err := application_utils.HashPassword(command.NewPassword, tenant.PasswordHashSecret)
+	userCredentials, err := s.repository.GetUserCredentialsByUserID(ctx, user.ID)
 
 	if err != nil {
 		return err
 	}
 
-	userCredentials, err := s.repository.GetUserCredentialsByUserID(ctx, user.ID)
+	passwordPolicy := application_utils.TenantPasswordPolicy(tenant, application)
+	hashPolicy := application_utils.TenantPasswordHashPolicy(tenant)
+
+	if userCredentials == nil {
+		err = application_utils.ValidatePassword(passwordPolicy, command.NewPassword)
+	} else {
+		err = application_utils.ValidatePasswordChange(ctx, s.repository, passwordPolicy, hashPolicy.Peppers(), userCredentials, command.NewPassword)
+	}
 
 	if err != nil {
 		return err
 	}
 
-	userCredentials.PasswordHash = hashedPassword
-	userCredentials.ShouldChangePass = false
+	hashedPassword, err := application_utils.HashPassword(command.NewPassword, hashPolicy)
 
-	if _, err := s.repository.UpdateUser(ctx, user); err != nil {
+	if err != nil {
 		return err
 	}
 
-	if err := s.repository.UpdateUserCredentials(ctx, userCredentials); err != nil {
+	if _, err := s.repository.UpdateUser(ctx, user); err != nil {
 		return err
 	}
 
+	// Users imported without a password hash set their first password here.
+	if userCredentials == nil {
+		if err := s.repository.AddUserCredentials(ctx, entities.NewUserCredentials(user.ID, hashedPassword, false)); err != nil {
+			return err
+		}
+	} else {
+		if err := application_utils.RecordPasswordHistory(ctx, s.repository, passwordPolicy, userCredentials); err != nil {
+			return err
+		}
+
+		userCredentials.SetPassword(hashedPassword)
+
+		if err := s.repository.UpdateUserCredentials(ctx, userCredentials); err != nil {
+			return err
+		}
+	}
+
 	if err := s.repository.RevokeRefreshTokenFromUser(ctx, user.ID); err != nil {
 		return err
 	}
@@ -96,5 +129,8 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 		return err
 	}
 
-	return nil
+	auditLog := entities.NewAuditLog(user.ID, application.ID,
+		constants.AuditEventPasswordReset, command.IPAddress, command.UserAgent, "success", nil)
+
+	return s.repository.AddAuditLog(ctx, auditLog)
 }
@@ -5,7 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -70,6 +74,10 @@ func (m *mockResetPasswordRepo) UpdateUserCredentials(ctx context.Context, userC
 	return m.Called(ctx, userCredentials).Error(0)
 }
 
+func (m *mockResetPasswordRepo) AddUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error {
+	return m.Called(ctx, userCredentials).Error(0)
+}
+
 func (m *mockResetPasswordRepo) GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -78,6 +86,26 @@ func (m *mockResetPasswordRepo) GetTenantByID(ctx context.Context, id uuid.UUID)
 	return args.Get(0).(*entities.Tenant), args.Error(1)
 }
 
+func (m *mockResetPasswordRepo) AddPasswordHistory(ctx context.Context, passwordHistory *entities.PasswordHistory) error {
+	return m.Called(ctx, passwordHistory).Error(0)
+}
+
+func (m *mockResetPasswordRepo) ListPasswordHistoryByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]entities.PasswordHistory, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.PasswordHistory), args.Error(1)
+}
+
+func (m *mockResetPasswordRepo) RemoveOldPasswordHistory(ctx context.Context, userID uuid.UUID, keep int) error {
+	return m.Called(ctx, userID, keep).Error(0)
+}
+
+func (m *mockResetPasswordRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
 // Compile-time check
 var _ IRepository = (*mockResetPasswordRepo)(nil)
 
@@ -190,6 +218,9 @@ func TestHandler_ResetPassword_TokenMismatch(t *testing.T) {
 	resetToken := newValidResetToken(userID)
 
 	repo.On("GetPasswordResetByTokenID", mock.Anything, resetToken.ID).Return(resetToken, nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventPasswordReset && auditLog.Result == "failure"
+	})).Return(nil)
 
 	h := &Handler{repository: repo}
 	err := h.Handler(context.Background(), Command{
@@ -201,6 +232,9 @@ func TestHandler_ResetPassword_TokenMismatch(t *testing.T) {
 
 	require.Error(t, err)
 	assert.Equal(t, "ErrPasswordResetTokenMismatch", err.Error())
+
+	var commitErr *repositories.CommitError
+	require.ErrorAs(t, err, &commitErr, "the failed attempt must be recorded")
 	repo.AssertExpectations(t)
 }
 
@@ -263,9 +297,13 @@ func TestHandler_ResetPassword_Success(t *testing.T) {
 	repo.On("GetTenantByID", mock.Anything, app.TenantID).Return(newResetTenant(app.TenantID), nil)
 	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
 	repo.On("UpdateUser", mock.Anything, mock.AnythingOfType("*entities.TenantUser")).Return(user, nil)
+	repo.On("RemoveOldPasswordHistory", mock.Anything, user.ID, 0).Return(nil)
 	repo.On("UpdateUserCredentials", mock.Anything, mock.AnythingOfType("*entities.UserCredentials")).Return(nil)
 	repo.On("RevokeRefreshTokenFromUser", mock.Anything, user.ID).Return(nil)
 	repo.On("DeletePasswordResetFromUser", mock.Anything, user.ID).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventPasswordReset && auditLog.Result == "success"
+	})).Return(nil)
 
 	h := &Handler{repository: repo}
 	err := h.Handler(context.Background(), Command{
@@ -281,3 +319,115 @@ func TestHandler_ResetPassword_Success(t *testing.T) {
 	assert.NotEqual(t, "old-password-hash", creds.PasswordHash)
 	repo.AssertExpectations(t)
 }
+
+func TestHandler_ResetPassword_CreatesMissingCredentials(t *testing.T) {
+	repo := new(mockResetPasswordRepo)
+	orgID, _ := uuid.NewV7()
+	app := newResetApp(orgID)
+	user := newResetUser(app.ID)
+	resetToken := newValidResetToken(user.ID)
+
+	repo.On("GetPasswordResetByTokenID", mock.Anything, resetToken.ID).Return(resetToken, nil)
+	repo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+	repo.On("GetApplicationByID", mock.Anything, app.ID).Return(app, nil)
+	repo.On("GetTenantByID", mock.Anything, app.TenantID).Return(newResetTenant(app.TenantID), nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(nil, nil)
+	repo.On("UpdateUser", mock.Anything, mock.AnythingOfType("*entities.TenantUser")).Return(user, nil)
+	repo.On("AddUserCredentials", mock.Anything, mock.MatchedBy(func(c *entities.UserCredentials) bool {
+		return c.UserID == user.ID && !c.ShouldChangePass
+	})).Return(nil)
+	repo.On("RevokeRefreshTokenFromUser", mock.Anything, user.ID).Return(nil)
+	repo.On("DeletePasswordResetFromUser", mock.Anything, user.ID).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventPasswordReset && auditLog.Result == "success"
+	})).Return(nil)
+
+	h := &Handler{repository: repo}
+	err := h.Handler(context.Background(), Command{
+		PasswordResetId:    resetToken.ID,
+		PasswordResetToken: resetToken.Token,
+		NewPassword:        "NewPassword123!",
+		ApplicationID:      app.ID,
+	})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_ResetPassword_RecordsPasswordHistory(t *testing.T) {
+	repo := new(mockResetPasswordRepo)
+	orgID, _ := uuid.NewV7()
+	app := newResetApp(orgID)
+	user := newResetUser(app.ID)
+	resetToken := newValidResetToken(user.ID)
+	creds := newResetCredentials(user.ID)
+	tenant := newResetTenant(app.TenantID)
+	tenant.PasswordHistoryCount = 3
+
+	repo.On("GetPasswordResetByTokenID", mock.Anything, resetToken.ID).Return(resetToken, nil)
+	repo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+	repo.On("GetApplicationByID", mock.Anything, app.ID).Return(app, nil)
+	repo.On("GetTenantByID", mock.Anything, app.TenantID).Return(tenant, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("ListPasswordHistoryByUserID", mock.Anything, user.ID, 2).Return([]entities.PasswordHistory{}, nil)
+	repo.On("UpdateUser", mock.Anything, mock.AnythingOfType("*entities.TenantUser")).Return(user, nil)
+	repo.On("AddPasswordHistory", mock.Anything, mock.MatchedBy(func(h *entities.PasswordHistory) bool {
+		return h.UserID == user.ID && h.PasswordHash == "old-password-hash"
+	})).Return(nil)
+	repo.On("RemoveOldPasswordHistory", mock.Anything, user.ID, 2).Return(nil)
+	repo.On("UpdateUserCredentials", mock.Anything, mock.AnythingOfType("*entities.UserCredentials")).Return(nil)
+	repo.On("RevokeRefreshTokenFromUser", mock.Anything, user.ID).Return(nil)
+	repo.On("DeletePasswordResetFromUser", mock.Anything, user.ID).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventPasswordReset && auditLog.Result == "success"
+	})).Return(nil)
+
+	h := &Handler{repository: repo}
+	err := h.Handler(context.Background(), Command{
+		PasswordResetId:    resetToken.ID,
+		PasswordResetToken: resetToken.Token,
+		NewPassword:        "NewPassword123!",
+		ApplicationID:      app.ID,
+	})
+
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().UTC(), creds.PasswordChangedAt, time.Minute)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_ResetPassword_RejectsRecentPassword(t *testing.T) {
+	repo := new(mockResetPasswordRepo)
+	orgID, _ := uuid.NewV7()
+	app := newResetApp(orgID)
+	user := newResetUser(app.ID)
+	resetToken := newValidResetToken(user.ID)
+	creds := newResetCredentials(user.ID)
+	tenant := newResetTenant(app.TenantID)
+	tenant.PasswordHistoryCount = 3
+
+	previousHash, err := application_utils.HashPassword("NewPassword123!", application_utils.TenantPasswordHashPolicy(tenant))
+	require.NoError(t, err)
+
+	repo.On("GetPasswordResetByTokenID", mock.Anything, resetToken.ID).Return(resetToken, nil)
+	repo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+	repo.On("GetApplicationByID", mock.Anything, app.ID).Return(app, nil)
+	repo.On("GetTenantByID", mock.Anything, app.TenantID).Return(tenant, nil)
+	repo.On("GetUserCredentialsByUserID", mock.Anything, user.ID).Return(creds, nil)
+	repo.On("ListPasswordHistoryByUserID", mock.Anything, user.ID, 2).
+		Return([]entities.PasswordHistory{{UserID: user.ID, PasswordHash: previousHash}}, nil)
+
+	h := &Handler{repository: repo}
+	err = h.Handler(context.Background(), Command{
+		PasswordResetId:    resetToken.ID,
+		PasswordResetToken: resetToken.Token,
+		NewPassword:        "NewPassword123!",
+		ApplicationID:      app.ID,
+	})
+
+	var policyErr *errors.PasswordPolicyError
+	require.ErrorAs(t, err, &policyErr)
+	require.Len(t, policyErr.Violations, 1)
+	assert.Equal(t, constants.PasswordViolationRecentlyReused, policyErr.Violations[0].Code)
+	repo.AssertNotCalled(t, "UpdateUserCredentials", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
@@ -19,6 +19,11 @@ type IRepository interface {
 	DeletePasswordResetFromUser(ctx context.Context, userID uuid.UUID) error
 	GetUserCredentialsByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserCredentials, error)
 	UpdateUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
+	AddUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
+	AddPasswordHistory(ctx context.Context, passwordHistory *entities.PasswordHistory) error
+	ListPasswordHistoryByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]entities.PasswordHistory, error)
+	RemoveOldPasswordHistory(ctx context.Context, userID uuid.UUID, keep int) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
@@ -28,6 +33,8 @@ type Repository struct {
 	repositories.ApplicationRepository
 	repositories.TenantRepository
 	repositories.UserCredentialsRepository
+	repositories.PasswordHistoryRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -38,5 +45,7 @@ func NewRepository(q *pgstore.Queries) Repository {
 		ApplicationRepository:     repositories.ApplicationRepository{Store: q},
 		TenantRepository:          repositories.TenantRepository{Store: q},
 		UserCredentialsRepository: repositories.UserCredentialsRepository{Store: q},
+		PasswordHistoryRepository: repositories.PasswordHistoryRepository{Store: q},
+		AuditLogRepository:        repositories.AuditLogRepository{Store: q},
 	}
 }
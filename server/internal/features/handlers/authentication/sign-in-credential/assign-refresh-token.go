@@ -7,17 +7,23 @@ import (
 	"github.com/gate-keeper/internal/domain/entities"
 )
 
-func assignRefreshToken(ctx context.Context, handler *Handler, user entities.TenantUser, ttlDays int) (*entities.RefreshToken, error) {
+// assignRefreshToken starts a new refresh token family bound to the client, the
+// granted scope and the user session, which the refresh_token grant later enforces.
+// The families of the user's other sessions are left alone.
+func assignRefreshToken(ctx context.Context, handler *Handler, user entities.TenantUser, application entities.Application, scope string, session entities.UserSession) (*entities.RefreshToken, error) {
 	currentDate := time.Now().UTC()
-	futureDate := currentDate.Add(time.Hour * 24 * time.Duration(ttlDays)).UTC()
+	futureDate := currentDate.Add(time.Hour * 24 * time.Duration(application.RefreshTokenTTLDays)).UTC()
 
-	handler.repository.RevokeRefreshTokenFromUser(ctx, user.ID)
 	refreshToken, err := entities.CreateRefreshToken(user.ID, futureDate)
 
 	if err != nil {
 		return nil, err
 	}
 
+	refreshToken.ApplicationID = &application.ID
+	refreshToken.Scope = &scope
+	refreshToken.SessionID = &session.ID
+
 	if _, err := handler.repository.AddRefreshToken(ctx, refreshToken); err != nil {
 		return nil, err
 	}
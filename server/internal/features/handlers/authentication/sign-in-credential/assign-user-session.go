@@ -0,0 +1,30 @@
+package signincredential
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+)
+
+// assignUserSession opens the session the issued tokens belong to. It records
+// the browser the authorization code was issued to rather than the client
+// redeeming it, and lives as long as the refresh token family it starts.
+func assignUserSession(ctx context.Context, handler *Handler, user entities.TenantUser, application entities.Application, authorizationCode entities.ApplicationAuthorizationCode) (*entities.UserSession, error) {
+	var ipAddress, userAgent string
+
+	if authorizationCode.IPAddress != nil {
+		ipAddress = *authorizationCode.IPAddress
+	}
+
+	if authorizationCode.UserAgent != nil {
+		userAgent = *authorizationCode.UserAgent
+	}
+
+	session := entities.NewUserSession(user.ID, application.ID, ipAddress, userAgent, nil, application.RefreshTokenTTLDays*24*60)
+
+	if err := handler.repository.AddUserSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
@@ -5,6 +5,8 @@ import (
 	"log/slog"
 	"strings"
 
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
@@ -78,7 +80,19 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, &errors.ErrUserNotFound
 	}
 
-	refreshToken, err := assignRefreshToken(ctx, s, *user, application.RefreshTokenTTLDays)
+	// Determine the granted scope; the refresh token is bound to it
+	scope := "openid profile email"
+	if authorizationCode.Scope != nil && *authorizationCode.Scope != "" {
+		scope = *authorizationCode.Scope
+	}
+
+	session, err := assignUserSession(ctx, s, *user, *application, *authorizationCode)
+
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := assignRefreshToken(ctx, s, *user, *application, scope, *session)
 
 	if err != nil {
 		return nil, err
@@ -94,6 +108,18 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, &errors.ErrUserProfileNotFound
 	}
 
+	roles, err := application_utils.UserRoleNames(ctx, s.repository, user.ID, application.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := application_utils.UserPermissionNames(ctx, s.repository, user.ID, application.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
 	jwtClaims := application_utils.JWTClaims{
 		UserID:      user.ID,
 		Email:       user.Email,
@@ -101,6 +127,11 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		LastName:    userProfile.LastName,
 		DisplayName: userProfile.DisplayName,
 		TenantID:    user.TenantID,
+		ClientID:    application.ID,
+		Scope:       scope,
+		Roles:       roles,
+		Permissions: permissions,
+		SessionID:   session.ID,
 	}
 
 	jwtToken, err := application_utils.CreateToken(jwtClaims)
@@ -109,12 +140,7 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, err
 	}
 
-	// Determine scope and issue OIDC ID Token when openid scope was requested
-	scope := "openid profile email"
-	if authorizationCode.Scope != nil && *authorizationCode.Scope != "" {
-		scope = *authorizationCode.Scope
-	}
-
+	// Issue OIDC ID Token when openid scope was requested
 	var idToken string
 	if strings.Contains(scope, "openid") {
 		audience := command.ClientID.String()
@@ -124,6 +150,16 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		}
 	}
 
+	// The session carries the browser the user signed in from, not the
+	// client redeeming the code
+	details := "grant_type: authorization_code, scope: " + scope
+	auditLog := entities.NewAuditLog(user.ID, application.ID,
+		constants.AuditEventTokenIssued, session.IPAddress, session.UserAgent, "success", &details)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
 	slog.InfoContext(ctx, "User signed in successfully")
 
 	return &Response{
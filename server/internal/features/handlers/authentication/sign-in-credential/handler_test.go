@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/google/uuid"
@@ -55,10 +56,6 @@ func (m *mockSignInRepo) GetAuthorizationCodeById(ctx context.Context, code uuid
 	return args.Get(0).(*entities.ApplicationAuthorizationCode), args.Error(1)
 }
 
-func (m *mockSignInRepo) RevokeRefreshTokenFromUser(ctx context.Context, userID uuid.UUID) error {
-	return m.Called(ctx, userID).Error(0)
-}
-
 func (m *mockSignInRepo) AddRefreshToken(ctx context.Context, rt *entities.RefreshToken) (*entities.RefreshToken, error) {
 	args := m.Called(ctx, rt)
 	if args.Get(0) == nil {
@@ -75,6 +72,24 @@ func (m *mockSignInRepo) GetApplicationByID(ctx context.Context, applicationID u
 	return args.Get(0).(*entities.Application), args.Error(1)
 }
 
+func (m *mockSignInRepo) GetUserRolesFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]entities.ApplicationRole, error) {
+	args := m.Called(ctx, userID, applicationID)
+	return args.Get(0).([]entities.ApplicationRole), args.Error(1)
+}
+
+func (m *mockSignInRepo) GetUserPermissionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, userID, applicationID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockSignInRepo) AddUserSession(ctx context.Context, session *entities.UserSession) error {
+	return m.Called(ctx, session).Error(0)
+}
+
+func (m *mockSignInRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
 // ---------------------------------------------------------------------------
 // Helpers
 // ---------------------------------------------------------------------------
@@ -316,9 +331,14 @@ func TestHandler_SignIn_Success(t *testing.T) {
 	repo.On("RemoveAuthorizationCode", mock.Anything, user.ID, appID).Return(nil)
 	repo.On("GetApplicationByID", mock.Anything, appID).Return(app, nil)
 	repo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
-	repo.On("RevokeRefreshTokenFromUser", mock.Anything, user.ID).Return(nil)
+	repo.On("AddUserSession", mock.Anything, mock.AnythingOfType("*entities.UserSession")).Return(nil)
 	repo.On("AddRefreshToken", mock.Anything, mock.AnythingOfType("*entities.RefreshToken")).Return(rt, nil)
 	repo.On("GetUserProfileByID", mock.Anything, user.ID).Return(profile, nil)
+	repo.On("GetUserRolesFromApplication", mock.Anything, user.ID, appID).Return([]entities.ApplicationRole{{Name: "editor"}}, nil)
+	repo.On("GetUserPermissionsFromApplication", mock.Anything, user.ID, appID).Return([]string{"posts:write"}, nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventTokenIssued && *auditLog.UserID == user.ID
+	})).Return(nil)
 
 	h := &Handler{repository: repo}
 	resp, err := h.Handler(context.Background(), Command{
@@ -337,4 +357,74 @@ func TestHandler_SignIn_Success(t *testing.T) {
 	assert.Equal(t, user.Email, resp.User.Email)
 	assert.Equal(t, profile.FirstName, resp.User.FirstName)
 	repo.AssertExpectations(t)
+
+	claims, err := application_utils.ParseTokenClaims(resp.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"editor"}, claims["roles"])
+	assert.Equal(t, []any{"posts:write"}, claims["permissions"])
+}
+
+func TestHandler_SignIn_CreatesUserSession(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-jwt-secret-for-unit-tests")
+
+	repo := new(mockSignInRepo)
+	appID, _ := uuid.NewV7()
+	user := newTestAppUser(appID)
+	verifier, _ := application_utils.GenerateCodeVerifier()
+	authCode := newValidAuthCode(appID, user.ID, verifier)
+	ipAddress := "203.0.113.7"
+	userAgent := "Mozilla/5.0"
+	authCode.IPAddress = &ipAddress
+	authCode.UserAgent = &userAgent
+
+	app := &entities.Application{
+		ID:                  appID,
+		Name:                "Test App",
+		IsActive:            true,
+		RefreshTokenTTLDays: 7,
+	}
+
+	var session *entities.UserSession
+	var refreshToken *entities.RefreshToken
+
+	repo.On("GetAuthorizationCodeById", mock.Anything, authCode.ID).Return(authCode, nil)
+	repo.On("ListSecretsFromApplication", mock.Anything, appID).Return(newTestSecrets(appID), nil)
+	repo.On("RemoveAuthorizationCode", mock.Anything, user.ID, appID).Return(nil)
+	repo.On("GetApplicationByID", mock.Anything, appID).Return(app, nil)
+	repo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+	repo.On("AddUserSession", mock.Anything, mock.AnythingOfType("*entities.UserSession")).
+		Run(func(args mock.Arguments) { session = args.Get(1).(*entities.UserSession) }).
+		Return(nil)
+	repo.On("AddRefreshToken", mock.Anything, mock.AnythingOfType("*entities.RefreshToken")).
+		Run(func(args mock.Arguments) { refreshToken = args.Get(1).(*entities.RefreshToken) }).
+		Return(newTestRefreshToken(user.ID), nil)
+	repo.On("GetUserProfileByID", mock.Anything, user.ID).Return(newTestProfile(user.ID), nil)
+	repo.On("GetUserRolesFromApplication", mock.Anything, user.ID, appID).Return([]entities.ApplicationRole{}, nil)
+	repo.On("GetUserPermissionsFromApplication", mock.Anything, user.ID, appID).Return([]string{}, nil)
+	repo.On("AddAuditLog", mock.Anything, mock.AnythingOfType("*entities.AuditLog")).Return(nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Command{
+		AuthorizationCode: authCode.ID,
+		ClientID:          appID,
+		ClientSecret:      testClientSecret,
+		CodeVerifier:      verifier,
+		RedirectURI:       testRedirectURI,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, session)
+	assert.Equal(t, user.ID, session.UserID)
+	assert.Equal(t, appID, session.ApplicationID)
+	assert.Equal(t, "203.0.113.7", session.IPAddress)
+	assert.Equal(t, userAgent, session.UserAgent)
+	assert.WithinDuration(t, time.Now().UTC().Add(7*24*time.Hour), session.ExpiresAt, time.Minute)
+
+	require.NotNil(t, refreshToken.SessionID)
+	assert.Equal(t, session.ID, *refreshToken.SessionID)
+
+	claims, err := application_utils.ParseTokenClaims(resp.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, session.ID.String(), claims["sid"])
+	repo.AssertExpectations(t)
 }
@@ -14,9 +14,12 @@ type IRepository interface {
 	ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error)
 	RemoveAuthorizationCode(ctx context.Context, userID, applicationId uuid.UUID) error
 	GetAuthorizationCodeById(ctx context.Context, code uuid.UUID) (*entities.ApplicationAuthorizationCode, error)
-	RevokeRefreshTokenFromUser(ctx context.Context, userID uuid.UUID) error
 	AddRefreshToken(ctx context.Context, refreshToken *entities.RefreshToken) (*entities.RefreshToken, error)
 	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	GetUserRolesFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]entities.ApplicationRole, error)
+	GetUserPermissionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]string, error)
+	AddUserSession(ctx context.Context, session *entities.UserSession) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
@@ -26,6 +29,10 @@ type Repository struct {
 	repositories.AuthorizationCodeRepository
 	repositories.RefreshTokenRepository
 	repositories.ApplicationRepository
+	repositories.RoleRepository
+	repositories.PermissionRepository
+	repositories.UserSessionRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -36,5 +43,9 @@ func NewRepository(q *pgstore.Queries) Repository {
 		AuthorizationCodeRepository: repositories.AuthorizationCodeRepository{Store: q},
 		RefreshTokenRepository: repositories.RefreshTokenRepository{Store: q},
 		ApplicationRepository: repositories.ApplicationRepository{Store: q},
+		RoleRepository: repositories.RoleRepository{Store: q},
+		PermissionRepository: repositories.PermissionRepository{Store: q},
+		UserSessionRepository: repositories.UserSessionRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
 	}
 }
@@ -9,4 +9,6 @@ type Command struct {
 	LastName      string    `json:"lastName" validate:"required"`
 	Email         string    `json:"email" validate:"required,email"`
 	Password      string    `json:"password" validate:"required"`
+	IPAddress     string    `json:"-"` // injected server-side
+	UserAgent     string    `json:"-"` // injected server-side
 }
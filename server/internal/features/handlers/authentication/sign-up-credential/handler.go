@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
 	application_utils "github.com/gate-keeper/internal/features/utils"
@@ -53,7 +54,11 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 		return err
 	}
 
-	hashedPassword, err := application_utils.HashPassword(command.Password, tenant.PasswordHashSecret)
+	if err := application_utils.ValidatePassword(application_utils.TenantPasswordPolicy(tenant, application), command.Password); err != nil {
+		return err
+	}
+
+	hashedPassword, err := application_utils.HashPassword(command.Password, application_utils.TenantPasswordHashPolicy(tenant))
 
 	if err != nil {
 		return err
@@ -100,6 +105,13 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 		return err
 	}
 
+	auditLog := entities.NewAuditLog(user.ID, command.ApplicationID,
+		constants.AuditEventUserSignedUp, command.IPAddress, command.UserAgent, "success", nil)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return err
+	}
+
 	go func() {
 		if err := s.mailService.SendEmailConfirmationEmail(ctx, user.Email, userProfile.FirstName, emailConfirmation.Token); err != nil {
 			panic(err)
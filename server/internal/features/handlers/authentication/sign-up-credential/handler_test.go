@@ -2,11 +2,15 @@ package signupcredential
 
 import (
 	"context"
+	"crypto/sha1"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/breach"
 	mailservice "github.com/gate-keeper/internal/infra/mail-service"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -57,6 +61,10 @@ func (m *mockSignUpRepo) GetTenantByID(ctx context.Context, id uuid.UUID) (*enti
 	return args.Get(0).(*entities.Tenant), args.Error(1)
 }
 
+func (m *mockSignUpRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
 // Compile-time check
 var _ IRepository = (*mockSignUpRepo)(nil)
 
@@ -78,6 +86,10 @@ func (m *mockMailService) SendForgotPasswordEmail(ctx context.Context, to, userN
 	return m.Called(ctx, to, userName, token, passwordResetID, applicationID).Error(0)
 }
 
+func (m *mockMailService) SendAccountLockedEmail(ctx context.Context, to, userName string, lockedUntil time.Time) error {
+	return m.Called(ctx, to, userName, lockedUntil).Error(0)
+}
+
 // Compile-time check
 var _ mailservice.IMailService = (*mockMailService)(nil)
 
@@ -187,6 +199,9 @@ func TestHandler_SignUp_Success(t *testing.T) {
 		Return(nil)
 	repo.On("AddEmailConfirmation", mock.Anything, mock.AnythingOfType("*entities.EmailConfirmation")).
 		Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventUserSignedUp
+	})).Return(nil)
 	mail.On("SendEmailConfirmationEmail", mock.Anything, "john@example.com", "John", mock.AnythingOfType("string")).
 		Return(nil)
 
@@ -197,3 +212,116 @@ func TestHandler_SignUp_Success(t *testing.T) {
 	repo.AssertExpectations(t)
 	// Note: mail is sent in a goroutine, so we don't assert it immediately
 }
+
+func TestHandler_SignUp_PasswordPolicyViolation(t *testing.T) {
+	repo := new(mockSignUpRepo)
+	mail := new(mockMailService)
+	appID, _ := uuid.NewV7()
+	app := newTestApplication(appID)
+	tenant := newSignUpTenant(app.TenantID)
+	tenant.PasswordMinLength = 16
+	tenant.PasswordForbiddenWords = []string{"secret"}
+
+	repo.On("IsUserExistsByEmail", mock.Anything, "john@example.com", appID).
+		Return(false, nil)
+	repo.On("GetApplicationByID", mock.Anything, appID).
+		Return(app, nil)
+	repo.On("GetTenantByID", mock.Anything, app.TenantID).
+		Return(tenant, nil)
+
+	h := &Handler{repository: repo, mailService: mail}
+	cmd := baseSignUpCommand(appID)
+	cmd.Password = "TenantSecret1!"
+	err := h.Handler(context.Background(), cmd)
+
+	var policyErr *errors.PasswordPolicyError
+	require.ErrorAs(t, err, &policyErr)
+
+	codes := []string{}
+	for _, violation := range policyErr.Violations {
+		codes = append(codes, violation.Code)
+	}
+	// "tenant" comes from the tenant name, "secret" from the configured list.
+	assert.Equal(t, []string{
+		constants.PasswordViolationTooShort,
+		constants.PasswordViolationForbiddenWord,
+		constants.PasswordViolationForbiddenWord,
+	}, codes)
+	repo.AssertNotCalled(t, "AddUser", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+// breachedPasswords is an in-memory breach.Corpus keyed by SHA-1 digest.
+type breachedPasswords map[[sha1.Size]byte]int
+
+func (b breachedPasswords) IsBreached(digest [sha1.Size]byte, threshold int) (bool, error) {
+	return b[digest] >= threshold, nil
+}
+
+func TestHandler_SignUp_BreachedPassword(t *testing.T) {
+	breach.SetCorpus(breachedPasswords{sha1.Sum([]byte("Password123!")): 3})
+	t.Cleanup(func() { breach.SetCorpus(nil) })
+
+	repo := new(mockSignUpRepo)
+	mail := new(mockMailService)
+	appID, _ := uuid.NewV7()
+	app := newTestApplication(appID)
+	tenant := newSignUpTenant(app.TenantID)
+	tenant.BreachedPasswordCheck = true
+
+	repo.On("IsUserExistsByEmail", mock.Anything, "john@example.com", appID).
+		Return(false, nil)
+	repo.On("GetApplicationByID", mock.Anything, appID).
+		Return(app, nil)
+	repo.On("GetTenantByID", mock.Anything, app.TenantID).
+		Return(tenant, nil)
+
+	h := &Handler{repository: repo, mailService: mail}
+	cmd := baseSignUpCommand(appID)
+	cmd.Password = "Password123!"
+	err := h.Handler(context.Background(), cmd)
+
+	assert.ErrorIs(t, err, &errors.ErrPasswordBreached)
+	repo.AssertNotCalled(t, "AddUser", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_SignUp_BreachedPasswordBelowThreshold(t *testing.T) {
+	breach.SetCorpus(breachedPasswords{sha1.Sum([]byte("Password123!")): 3})
+	t.Cleanup(func() { breach.SetCorpus(nil) })
+
+	repo := new(mockSignUpRepo)
+	mail := new(mockMailService)
+	appID, _ := uuid.NewV7()
+	app := newTestApplication(appID)
+	tenant := newSignUpTenant(app.TenantID)
+	tenant.BreachedPasswordCheck = true
+	tenant.BreachedPasswordThreshold = 10
+
+	repo.On("IsUserExistsByEmail", mock.Anything, "john@example.com", appID).
+		Return(false, nil)
+	repo.On("GetApplicationByID", mock.Anything, appID).
+		Return(app, nil)
+	repo.On("GetTenantByID", mock.Anything, app.TenantID).
+		Return(tenant, nil)
+	repo.On("AddUser", mock.Anything, mock.AnythingOfType("*entities.TenantUser")).
+		Return(nil)
+	repo.On("AddUserProfile", mock.Anything, mock.AnythingOfType("*entities.UserProfile")).
+		Return(nil)
+	repo.On("AddUserCredentials", mock.Anything, mock.AnythingOfType("*entities.UserCredentials")).
+		Return(nil)
+	repo.On("AddEmailConfirmation", mock.Anything, mock.AnythingOfType("*entities.EmailConfirmation")).
+		Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.AnythingOfType("*entities.AuditLog")).
+		Return(nil)
+	mail.On("SendEmailConfirmationEmail", mock.Anything, "john@example.com", "John", mock.AnythingOfType("string")).
+		Return(nil).Maybe()
+
+	h := &Handler{repository: repo, mailService: mail}
+	cmd := baseSignUpCommand(appID)
+	cmd.Password = "Password123!"
+	err := h.Handler(context.Background(), cmd)
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
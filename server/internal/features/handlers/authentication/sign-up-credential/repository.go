@@ -17,6 +17,7 @@ type IRepository interface {
 	AddEmailConfirmation(ctx context.Context, emailConfirmation *entities.EmailConfirmation) error
 	AddUser(ctx context.Context, newUser *entities.TenantUser) error
 	AddUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
@@ -26,6 +27,7 @@ type Repository struct {
 	repositories.UserProfileRepository
 	repositories.EmailConfirmationRepository
 	repositories.UserCredentialsRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -36,5 +38,6 @@ func NewRepository(q *pgstore.Queries) Repository {
 		UserProfileRepository:       repositories.UserProfileRepository{Store: q},
 		EmailConfirmationRepository: repositories.EmailConfirmationRepository{Store: q},
 		UserCredentialsRepository:   repositories.UserCredentialsRepository{Store: q},
+		AuditLogRepository:          repositories.AuditLogRepository{Store: q},
 	}
 }
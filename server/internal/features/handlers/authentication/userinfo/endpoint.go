@@ -1,6 +1,7 @@
 package userinfo
 
 import (
+	"encoding/json"
 	"net/http"
 
 	application_utils "github.com/gate-keeper/internal/features/utils"
@@ -21,6 +22,27 @@ type UserInfoResponse struct {
 	FamilyName string  `json:"family_name"`
 	Email      string  `json:"email"`
 	Picture    *string `json:"picture,omitempty"`
+	// Roles is serialized under the configurable roles claim name and only
+	// when the access token was issued to an application.
+	Roles []string `json:"-"`
+}
+
+type userInfoFields UserInfoResponse
+
+func (r UserInfoResponse) MarshalJSON() ([]byte, error) {
+	fields, err := json.Marshal(userInfoFields(r))
+	if err != nil || r.Roles == nil {
+		return fields, err
+	}
+
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(fields, &claims); err != nil {
+		return nil, err
+	}
+
+	claims[application_utils.RolesClaim()] = r.Roles
+
+	return json.Marshal(claims)
 }
 
 // Http handles GET /v1/auth/userinfo
@@ -34,8 +56,8 @@ func (e *Endpoint) Http(writer http.ResponseWriter, request *http.Request) {
 
 	tokenString := authHeader[7:]
 
-	isValid, _, err := application_utils.ValidateToken(tokenString)
-	if err != nil || !isValid {
+	tokenClaims, err := application_utils.ValidateAccessToken(request.Context(), tokenString)
+	if err != nil {
 		http.Error(writer, `{"error":"invalid_token","error_description":"Token validation failed"}`, http.StatusUnauthorized)
 		return
 	}
@@ -54,5 +76,14 @@ func (e *Endpoint) Http(writer http.ResponseWriter, request *http.Request) {
 		Email:      claims.Email,
 	}
 
+	if roles, ok := tokenClaims[application_utils.RolesClaim()].([]interface{}); ok {
+		response.Roles = make([]string, 0, len(roles))
+		for _, role := range roles {
+			if name, ok := role.(string); ok {
+				response.Roles = append(response.Roles, name)
+			}
+		}
+	}
+
 	http_router.SendJson(writer, response, http.StatusOK)
 }
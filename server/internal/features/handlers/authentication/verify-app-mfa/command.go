@@ -7,4 +7,6 @@ type Command struct {
 	Email         string     `json:"email" validate:"required,email"`
 	MfaID         *uuid.UUID `json:"mfaId" validate:"required,uuid"`
 	ApplicationID uuid.UUID  `json:"applicationId" validate:"required"`
+	IPAddress     string     `json:"-"` // injected server-side
+	UserAgent     string     `json:"-"` // injected server-side
 }
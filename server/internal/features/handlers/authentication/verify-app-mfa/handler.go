@@ -6,6 +6,7 @@ import (
 	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 	mailservice "github.com/gate-keeper/internal/infra/mail-service"
@@ -70,7 +71,12 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 	isValid := totp.Validate(command.Code, mfaTotpCode.Secret)
 
 	if !isValid {
-		return nil, &errors.ErrInvalidMfaAuthAppCode
+		if err := application_utils.AuditMfaChallengeFailed(ctx, s.repository, user.ID, command.ApplicationID,
+			constants.MfaMethodTotp, "invalid_code", command.IPAddress, command.UserAgent); err != nil {
+			return nil, err
+		}
+
+		return nil, repositories.CommitAndReturn(&errors.ErrInvalidMfaAuthAppCode)
 	}
 
 	authorizationSession, err := entities.CreateSessionCode(user.ID, command.ApplicationID)
@@ -83,6 +89,11 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, err
 	}
 
+	if err := application_utils.AuditMfaChallengePassed(ctx, s.repository, user.ID, command.ApplicationID,
+		constants.MfaMethodTotp, command.IPAddress, command.UserAgent); err != nil {
+		return nil, err
+	}
+
 	return &Response{
 		SessionCode: authorizationSession.Token,
 	}, nil
@@ -0,0 +1,12 @@
+package verifybackupcodemfa
+
+import "github.com/google/uuid"
+
+type Command struct {
+	Code          string    `json:"code" validate:"required"`
+	Email         string    `json:"email" validate:"required,email"`
+	MfaID         uuid.UUID `json:"mfaId" validate:"required"` // the mfaId returned by login
+	ApplicationID uuid.UUID `json:"applicationId" validate:"required"`
+	IPAddress     string    `json:"-"` // injected server-side
+	UserAgent     string    `json:"-"` // injected server-side
+}
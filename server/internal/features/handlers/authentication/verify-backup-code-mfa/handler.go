@@ -0,0 +1,181 @@
+package verifybackupcodemfa
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+// lowBackupCodeCount is the number of remaining codes at or below which the
+// user is warned to generate new ones.
+const lowBackupCodeCount = 3
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, command Command) (*Response, error) {
+	user, err := s.repository.GetUserByEmail(ctx, command.Email, command.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, &errors.ErrUserNotFound
+	}
+
+	if !user.IsActive {
+		return nil, &errors.ErrUserNotActive
+	}
+
+	if !user.IsEmailConfirmed {
+		return nil, &errors.ErrEmailNotConfirmed
+	}
+
+	// A backup code replaces the second factor only, so the login step must
+	// have issued an MFA challenge first
+	removeChallenge, err := s.findMfaChallenge(ctx, user.ID, command.MfaID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	used, err := s.repository.UseBackupCode(ctx, user.ID, entities.HashBackupCode(entities.NormalizeBackupCode(command.Code)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !used {
+		auditLog := entities.NewAuditLog(user.ID, command.ApplicationID,
+			constants.AuditEventBackupCodeUsed, command.IPAddress, command.UserAgent, "failure", nil)
+
+		if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+			return nil, err
+		}
+
+		return nil, repositories.CommitAndReturn(&errors.ErrBackupCodeInvalid)
+	}
+
+	if err := removeChallenge(ctx); err != nil {
+		return nil, err
+	}
+
+	sessionCode, err := entities.CreateSessionCode(user.ID, command.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.AddSessionCode(ctx, sessionCode); err != nil {
+		return nil, err
+	}
+
+	remaining, err := s.repository.CountUnusedBackupCodesByUserID(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	details := fmt.Sprintf("remaining_backup_codes: %d", remaining)
+	auditLog := entities.NewAuditLog(user.ID, command.ApplicationID,
+		constants.AuditEventBackupCodeUsed, command.IPAddress, command.UserAgent, "success", &details)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
+	loginDetails := "mfa_method: backup_code"
+	loginLog := entities.NewAuditLog(user.ID, command.ApplicationID,
+		constants.AuditEventLoginSucceeded, command.IPAddress, command.UserAgent, "success", &loginDetails)
+
+	if err := s.repository.AddAuditLog(ctx, loginLog); err != nil {
+		return nil, err
+	}
+
+	response := &Response{
+		SessionCode:          sessionCode.Token,
+		RemainingBackupCodes: remaining,
+	}
+
+	if remaining <= lowBackupCodeCount {
+		warning := fmt.Sprintf("Only %d backup codes left, generate new ones from your account settings", remaining)
+		response.Warning = &warning
+	}
+
+	return response, nil
+}
+
+// findMfaChallenge looks up the pending challenge login created for the user's
+// preferred method: an app code, an e-mail code or a passkey session. It
+// returns a function that removes the challenge once the backup code is used.
+func (s *Handler) findMfaChallenge(ctx context.Context, userID, challengeID uuid.UUID) (func(context.Context) error, error) {
+	mfaMethods, err := s.repository.GetUserMfaMethods(ctx, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	userMethods := make(map[uuid.UUID]bool, len(mfaMethods))
+	emailMethods := make(map[uuid.UUID]bool, len(mfaMethods))
+	for _, mfaMethod := range mfaMethods {
+		userMethods[mfaMethod.ID] = mfaMethod.Enabled
+		emailMethods[mfaMethod.ID] = mfaMethod.Enabled && mfaMethod.Type == constants.MfaMethodEmail
+	}
+
+	totpCode, err := s.repository.GetMfaTotpCodeByID(ctx, challengeID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if totpCode != nil && userMethods[totpCode.MfaMethodID] {
+		return func(ctx context.Context) error {
+			return s.repository.DeleteMfaTotpCode(ctx, totpCode.ID)
+		}, nil
+	}
+
+	// E-mail challenges are identified by the MFA method, so the code last
+	// sent for it must still be pending
+	if emailMethods[challengeID] {
+		emailCode, err := s.repository.GetLatestMfaEmailCodeByMfaMethodID(ctx, challengeID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if emailCode != nil && emailCode.ExpiresAt.After(time.Now().UTC()) {
+			return func(ctx context.Context) error {
+				return s.repository.DeleteEmailMfaCodeByID(ctx, emailCode.ID)
+			}, nil
+		}
+	}
+
+	passkeySession, err := s.repository.GetMfaPasskeySessionByID(ctx, challengeID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if passkeySession != nil && passkeySession.UserID == userID && !passkeySession.IsExpired() {
+		return func(ctx context.Context) error {
+			return s.repository.DeleteMfaPasskeySession(ctx, passkeySession.ID)
+		}, nil
+	}
+
+	return nil, &errors.ErrMfaChallengeNotFound
+}
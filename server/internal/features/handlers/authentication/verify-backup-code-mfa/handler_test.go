@@ -0,0 +1,236 @@
+package verifybackupcodemfa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBackupCodeRepo struct {
+	mock.Mock
+}
+
+func (m *mockBackupCodeRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
+func (m *mockBackupCodeRepo) AddSessionCode(ctx context.Context, sessionCode *entities.SessionCode) error {
+	return m.Called(ctx, sessionCode).Error(0)
+}
+
+func (m *mockBackupCodeRepo) CountUnusedBackupCodesByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockBackupCodeRepo) DeleteEmailMfaCodeByID(ctx context.Context, emailMfaCodeID uuid.UUID) error {
+	return m.Called(ctx, emailMfaCodeID).Error(0)
+}
+
+func (m *mockBackupCodeRepo) DeleteMfaPasskeySession(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *mockBackupCodeRepo) DeleteMfaTotpCode(ctx context.Context, appMfaCodeID uuid.UUID) error {
+	return m.Called(ctx, appMfaCodeID).Error(0)
+}
+
+func (m *mockBackupCodeRepo) GetLatestMfaEmailCodeByMfaMethodID(ctx context.Context, mfaMethodID uuid.UUID) (*entities.MfaEmailCode, error) {
+	args := m.Called(ctx, mfaMethodID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.MfaEmailCode), args.Error(1)
+}
+
+func (m *mockBackupCodeRepo) GetMfaPasskeySessionByID(ctx context.Context, id uuid.UUID) (*entities.MfaPasskeySession, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.MfaPasskeySession), args.Error(1)
+}
+
+func (m *mockBackupCodeRepo) GetMfaTotpCodeByID(ctx context.Context, id uuid.UUID) (*entities.MfaTotpCode, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.MfaTotpCode), args.Error(1)
+}
+
+func (m *mockBackupCodeRepo) GetUserByEmail(ctx context.Context, email string, applicationID uuid.UUID) (*entities.TenantUser, error) {
+	args := m.Called(ctx, email, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.TenantUser), args.Error(1)
+}
+
+func (m *mockBackupCodeRepo) GetUserMfaMethods(ctx context.Context, userID uuid.UUID) ([]*entities.MfaMethod, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*entities.MfaMethod), args.Error(1)
+}
+
+func (m *mockBackupCodeRepo) UseBackupCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	args := m.Called(ctx, userID, codeHash)
+	return args.Bool(0), args.Error(1)
+}
+
+// setup returns a user whose login is waiting on a TOTP challenge.
+func setup(repo *mockBackupCodeRepo) (Command, *entities.TenantUser, *entities.MfaTotpCode) {
+	appID, _ := uuid.NewV7()
+	user := &entities.TenantUser{ID: uuid.Must(uuid.NewV7()), Email: "user@example.com", IsActive: true, IsEmailConfirmed: true}
+	mfaMethod := &entities.MfaMethod{ID: uuid.Must(uuid.NewV7()), UserID: user.ID, Type: constants.MfaMethodTotp, Enabled: true}
+	totpCode := entities.NewMfaTotpCode(mfaMethod.ID, "JBSWY3DPEHPK3PXP")
+
+	repo.On("GetUserByEmail", mock.Anything, user.Email, appID).Return(user, nil)
+	repo.On("GetUserMfaMethods", mock.Anything, user.ID).Return([]*entities.MfaMethod{mfaMethod}, nil)
+
+	return Command{
+		Code:          "abcd efgh",
+		Email:         user.Email,
+		MfaID:         totpCode.ID,
+		ApplicationID: appID,
+	}, user, totpCode
+}
+
+func TestHandler_VerifyBackupCode(t *testing.T) {
+	repo := new(mockBackupCodeRepo)
+	cmd, user, totpCode := setup(repo)
+
+	repo.On("GetMfaTotpCodeByID", mock.Anything, totpCode.ID).Return(totpCode, nil)
+	repo.On("UseBackupCode", mock.Anything, user.ID, entities.HashBackupCode("ABCD-EFGH")).Return(true, nil)
+	repo.On("DeleteMfaTotpCode", mock.Anything, totpCode.ID).Return(nil)
+	repo.On("AddSessionCode", mock.Anything, mock.AnythingOfType("*entities.SessionCode")).Return(nil)
+	repo.On("CountUnusedBackupCodesByUserID", mock.Anything, user.ID).Return(int64(7), nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventBackupCodeUsed && auditLog.Result == "success"
+	})).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventLoginSucceeded && *auditLog.Details == "mfa_method: backup_code"
+	})).Return(nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), cmd)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.SessionCode)
+	assert.Equal(t, int64(7), resp.RemainingBackupCodes)
+	assert.Nil(t, resp.Warning)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_VerifyBackupCode_WarnsWhenFewCodesRemain(t *testing.T) {
+	repo := new(mockBackupCodeRepo)
+	cmd, user, totpCode := setup(repo)
+
+	repo.On("GetMfaTotpCodeByID", mock.Anything, totpCode.ID).Return(totpCode, nil)
+	repo.On("UseBackupCode", mock.Anything, user.ID, mock.Anything).Return(true, nil)
+	repo.On("DeleteMfaTotpCode", mock.Anything, totpCode.ID).Return(nil)
+	repo.On("AddSessionCode", mock.Anything, mock.Anything).Return(nil)
+	repo.On("CountUnusedBackupCodesByUserID", mock.Anything, user.ID).Return(int64(2), nil)
+	repo.On("AddAuditLog", mock.Anything, mock.Anything).Return(nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Warning)
+	assert.Contains(t, *resp.Warning, "2 backup codes")
+}
+
+func TestHandler_VerifyBackupCode_InvalidCode(t *testing.T) {
+	repo := new(mockBackupCodeRepo)
+	cmd, user, totpCode := setup(repo)
+
+	repo.On("GetMfaTotpCodeByID", mock.Anything, totpCode.ID).Return(totpCode, nil)
+	repo.On("UseBackupCode", mock.Anything, user.ID, mock.Anything).Return(false, nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventBackupCodeUsed && auditLog.Result == "failure"
+	})).Return(nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), cmd)
+
+	var commitErr *repositories.CommitError
+	require.ErrorAs(t, err, &commitErr, "the failed attempt must be audited")
+	assert.ErrorIs(t, err, &errors.ErrBackupCodeInvalid)
+	repo.AssertNotCalled(t, "DeleteMfaTotpCode", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "AddSessionCode", mock.Anything, mock.Anything)
+}
+
+func TestHandler_VerifyBackupCode_EmailChallenge(t *testing.T) {
+	repo := new(mockBackupCodeRepo)
+	cmd, user, _ := setup(repo)
+	emailMethod := &entities.MfaMethod{ID: uuid.Must(uuid.NewV7()), UserID: user.ID, Type: constants.MfaMethodEmail, Enabled: true}
+	emailCode := entities.NewMfaEmailCode(emailMethod.ID)
+	// Login returns the e-mail MFA method as the challenge
+	cmd.MfaID = emailMethod.ID
+
+	repo.ExpectedCalls = nil
+	repo.On("GetUserByEmail", mock.Anything, user.Email, cmd.ApplicationID).Return(user, nil)
+	repo.On("GetUserMfaMethods", mock.Anything, user.ID).Return([]*entities.MfaMethod{emailMethod}, nil)
+	repo.On("GetMfaTotpCodeByID", mock.Anything, emailMethod.ID).Return(nil, nil)
+	repo.On("GetLatestMfaEmailCodeByMfaMethodID", mock.Anything, emailMethod.ID).Return(emailCode, nil)
+	repo.On("UseBackupCode", mock.Anything, user.ID, mock.Anything).Return(true, nil)
+	repo.On("DeleteEmailMfaCodeByID", mock.Anything, emailCode.ID).Return(nil)
+	repo.On("AddSessionCode", mock.Anything, mock.Anything).Return(nil)
+	repo.On("CountUnusedBackupCodesByUserID", mock.Anything, user.ID).Return(int64(9), nil)
+	repo.On("AddAuditLog", mock.Anything, mock.Anything).Return(nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), cmd)
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_VerifyBackupCode_EmailChallengeWithoutPendingCode(t *testing.T) {
+	repo := new(mockBackupCodeRepo)
+	cmd, user, _ := setup(repo)
+	emailMethod := &entities.MfaMethod{ID: uuid.Must(uuid.NewV7()), UserID: user.ID, Type: constants.MfaMethodEmail, Enabled: true}
+	expiredCode := entities.NewMfaEmailCode(emailMethod.ID)
+	expiredCode.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+	cmd.MfaID = emailMethod.ID
+
+	repo.ExpectedCalls = nil
+	repo.On("GetUserByEmail", mock.Anything, user.Email, cmd.ApplicationID).Return(user, nil)
+	repo.On("GetUserMfaMethods", mock.Anything, user.ID).Return([]*entities.MfaMethod{emailMethod}, nil)
+	repo.On("GetMfaTotpCodeByID", mock.Anything, emailMethod.ID).Return(nil, nil)
+	repo.On("GetLatestMfaEmailCodeByMfaMethodID", mock.Anything, emailMethod.ID).Return(expiredCode, nil)
+	repo.On("GetMfaPasskeySessionByID", mock.Anything, emailMethod.ID).Return(nil, nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), cmd)
+
+	assert.ErrorIs(t, err, &errors.ErrMfaChallengeNotFound)
+	repo.AssertNotCalled(t, "UseBackupCode", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_VerifyBackupCode_RequiresPendingChallenge(t *testing.T) {
+	repo := new(mockBackupCodeRepo)
+	cmd, _, _ := setup(repo)
+
+	// A challenge of another user's method, or one that was already used
+	otherUsersCode := entities.NewMfaTotpCode(uuid.Must(uuid.NewV7()), "JBSWY3DPEHPK3PXP")
+	repo.On("GetMfaTotpCodeByID", mock.Anything, cmd.MfaID).Return(otherUsersCode, nil)
+	repo.On("GetMfaPasskeySessionByID", mock.Anything, cmd.MfaID).Return(&entities.MfaPasskeySession{
+		ID: cmd.MfaID, UserID: uuid.Must(uuid.NewV7()), ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), cmd)
+
+	assert.ErrorIs(t, err, &errors.ErrMfaChallengeNotFound)
+	repo.AssertNotCalled(t, "UseBackupCode", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,43 @@
+package verifybackupcodemfa
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
+	AddSessionCode(ctx context.Context, sessionCode *entities.SessionCode) error
+	CountUnusedBackupCodesByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteEmailMfaCodeByID(ctx context.Context, emailMfaCodeID uuid.UUID) error
+	DeleteMfaPasskeySession(ctx context.Context, id uuid.UUID) error
+	DeleteMfaTotpCode(ctx context.Context, appMfaCodeID uuid.UUID) error
+	GetLatestMfaEmailCodeByMfaMethodID(ctx context.Context, mfaMethodID uuid.UUID) (*entities.MfaEmailCode, error)
+	GetMfaPasskeySessionByID(ctx context.Context, id uuid.UUID) (*entities.MfaPasskeySession, error)
+	GetMfaTotpCodeByID(ctx context.Context, id uuid.UUID) (*entities.MfaTotpCode, error)
+	GetUserByEmail(ctx context.Context, email string, applicationID uuid.UUID) (*entities.TenantUser, error)
+	GetUserMfaMethods(ctx context.Context, userID uuid.UUID) ([]*entities.MfaMethod, error)
+	UseBackupCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+}
+
+type Repository struct {
+	repositories.AuditLogRepository
+	repositories.BackupCodeRepository
+	repositories.MfaRepository
+	repositories.SessionRepository
+	repositories.UserRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		AuditLogRepository:   repositories.AuditLogRepository{Store: q},
+		BackupCodeRepository: repositories.BackupCodeRepository{Store: q},
+		MfaRepository:        repositories.MfaRepository{Store: q},
+		SessionRepository:    repositories.SessionRepository{Store: q},
+		UserRepository:       repositories.UserRepository{Store: q},
+	}
+}
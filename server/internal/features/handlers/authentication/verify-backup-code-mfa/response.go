@@ -0,0 +1,7 @@
+package verifybackupcodemfa
+
+type Response struct {
+	SessionCode          string  `json:"sessionCode"`
+	RemainingBackupCodes int64   `json:"remainingBackupCodes"`
+	Warning              *string `json:"warning,omitempty"`
+}
@@ -6,4 +6,6 @@ type Command struct {
 	Code          string    `json:"code" validate:"required"`
 	Email         string    `json:"email" validate:"required,email"`
 	ApplicationID uuid.UUID `json:"applicationId" validate:"required"`
+	IPAddress     string    `json:"-"` // injected server-side
+	UserAgent     string    `json:"-"` // injected server-side
 }
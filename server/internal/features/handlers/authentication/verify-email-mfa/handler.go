@@ -7,6 +7,7 @@ import (
 	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 	mailservice "github.com/gate-keeper/internal/infra/mail-service"
@@ -63,11 +64,21 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 	}
 
 	if emailMfaCode == nil {
-		return nil, &errors.ErrEmailMfaCodeNotFound
+		if err := application_utils.AuditMfaChallengeFailed(ctx, s.repository, user.ID, command.ApplicationID,
+			constants.MfaMethodEmail, "invalid_code", command.IPAddress, command.UserAgent); err != nil {
+			return nil, err
+		}
+
+		return nil, repositories.CommitAndReturn(&errors.ErrEmailMfaCodeNotFound)
 	}
 
 	if emailMfaCode.ExpiresAt.Before(time.Now().UTC()) {
-		return nil, &errors.ErrEmailMfaCodeExpired
+		if err := application_utils.AuditMfaChallengeFailed(ctx, s.repository, user.ID, command.ApplicationID,
+			constants.MfaMethodEmail, "expired_code", command.IPAddress, command.UserAgent); err != nil {
+			return nil, err
+		}
+
+		return nil, repositories.CommitAndReturn(&errors.ErrEmailMfaCodeExpired)
 	}
 
 	s.repository.DeleteEmailMfaCodeByID(ctx, emailMfaCode.ID)
@@ -82,6 +93,11 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, err
 	}
 
+	if err := application_utils.AuditMfaChallengePassed(ctx, s.repository, user.ID, command.ApplicationID,
+		constants.MfaMethodEmail, command.IPAddress, command.UserAgent); err != nil {
+		return nil, err
+	}
+
 	return &Response{
 		SessionCode: sessionCode.Token,
 	}, nil
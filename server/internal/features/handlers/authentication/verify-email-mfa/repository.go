@@ -14,18 +14,21 @@ type IRepository interface {
 	GetMfaEmailCodeByToken(ctx context.Context, mfaMethodID uuid.UUID, token string) (*entities.MfaEmailCode, error)
 	GetUserByEmail(ctx context.Context, email string, applicationID uuid.UUID) (*entities.TenantUser, error)
 	GetMfaMethodByUserID(ctx context.Context, userID uuid.UUID, method string) (*entities.MfaMethod, error)
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.SessionRepository
 	repositories.MfaRepository
 	repositories.UserRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
-		SessionRepository: repositories.SessionRepository{Store: q},
-		MfaRepository: repositories.MfaRepository{Store: q},
-		UserRepository: repositories.UserRepository{Store: q},
+		SessionRepository:  repositories.SessionRepository{Store: q},
+		MfaRepository:      repositories.MfaRepository{Store: q},
+		UserRepository:     repositories.UserRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
 	}
 }
@@ -11,4 +11,6 @@ type Command struct {
 	ApplicationID uuid.UUID       `json:"applicationId" validate:"required"`
 	SessionID     uuid.UUID       `json:"sessionId" validate:"required"`
 	AssertionData json.RawMessage `json:"assertionData" validate:"required"`
+	IPAddress     string          `json:"-"` // injected server-side
+	UserAgent     string          `json:"-"` // injected server-side
 }
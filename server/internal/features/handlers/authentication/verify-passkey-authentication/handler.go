@@ -96,7 +96,12 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 
 	updatedCredential, err := wa.ValidateLogin(waUser, sessionData, parsedResponse)
 	if err != nil {
-		return nil, &errors.ErrWebAuthnAuthenticationFailed
+		if err := application_utils.AuditMfaChallengeFailed(ctx, s.repository, user.ID, command.ApplicationID,
+			constants.MfaMethodWebauthn, "invalid_assertion", command.IPAddress, command.UserAgent); err != nil {
+			return nil, err
+		}
+
+		return nil, repositories.CommitAndReturn(&errors.ErrWebAuthnAuthenticationFailed)
 	}
 
 	// Update sign count for the used credential
@@ -123,6 +128,11 @@ func (s *Handler) Handler(ctx context.Context, command Command) (*Response, erro
 		return nil, err
 	}
 
+	if err := application_utils.AuditMfaChallengePassed(ctx, s.repository, user.ID, command.ApplicationID,
+		constants.MfaMethodWebauthn, command.IPAddress, command.UserAgent); err != nil {
+		return nil, err
+	}
+
 	return &Response{
 		SessionCode: authorizationSession.Token,
 	}, nil
@@ -18,6 +18,7 @@ type IRepository interface {
 	GetWebAuthnCredentialsByMfaMethodID(ctx context.Context, mfaMethodID uuid.UUID) ([]entities.MfaPasskeyCredentials, error)
 	UpdateWebAuthnCredentialSignCount(ctx context.Context, credID uuid.UUID, signCount uint32) error
 	AddSessionCode(ctx context.Context, sessionCode *entities.SessionCode) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
@@ -25,6 +26,7 @@ type Repository struct {
 	repositories.UserRepository
 	repositories.UserProfileRepository
 	repositories.SessionRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -33,5 +35,6 @@ func NewRepository(q *pgstore.Queries) Repository {
 		UserRepository:        repositories.UserRepository{Store: q},
 		UserProfileRepository: repositories.UserProfileRepository{Store: q},
 		SessionRepository:     repositories.SessionRepository{Store: q},
+		AuditLogRepository:    repositories.AuditLogRepository{Store: q},
 	}
 }
@@ -0,0 +1,45 @@
+package authorizecallback
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/clientip"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+// Http only accepts a form-encoded POST, so the session code and e-mail never
+// end up in a URL, where browser history, proxies and access logs keep them.
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	if err := request.ParseForm(); err != nil {
+		panic(errors.NewOAuthError(errors.OAuthInvalidRequest, "Malformed request body"))
+	}
+
+	query := Query{
+		RequestID:   request.PostForm.Get("request_id"),
+		SessionCode: request.PostForm.Get("session_code"),
+		Email:       request.PostForm.Get("email"),
+		IPAddress:   clientip.RealIP(request),
+		UserAgent:   request.UserAgent(),
+	}
+
+	params := repositories.ParamsRs[Query, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: query,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// 303 makes the browser follow the redirect with a GET.
+	http.Redirect(writter, request, response.RedirectTo, http.StatusSeeOther)
+}
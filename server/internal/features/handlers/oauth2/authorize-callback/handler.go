@@ -0,0 +1,107 @@
+package authorizecallback
+
+import (
+	"context"
+	goerrors "errors"
+	"net/url"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/features/handlers/authentication/authorize"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	repository IRepository
+	authorize  repositories.ServiceHandlerRs[authorize.Command, *authorize.Response]
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+		authorize:  authorize.New(q),
+	}
+}
+
+// Handler finishes a browser authorization request: the session code is
+// exchanged for an authorization code through the regular authorize handler,
+// and the stored request is consumed once it succeeds. The client parameters come from the stored
+// request only, never from the browser.
+func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
+	requestID, err := uuid.Parse(query.RequestID)
+	if err != nil {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "request_id is missing or malformed")
+	}
+
+	authorizationRequest, err := s.repository.GetAuthorizationRequestByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorizationRequest == nil || authorizationRequest.IsExpired() {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "authorization request not found or expired")
+	}
+
+	var state, nonce string
+	if authorizationRequest.State != nil {
+		state = *authorizationRequest.State
+	}
+	if authorizationRequest.Nonce != nil {
+		nonce = *authorizationRequest.Nonce
+	}
+
+	authorized, err := s.authorize.Handler(ctx, authorize.Command{
+		ApplicationID:       authorizationRequest.ApplicationID,
+		SessionCode:         query.SessionCode,
+		Email:               query.Email,
+		CodeChallenge:       authorizationRequest.CodeChallenge,
+		CodeChallengeMethod: authorizationRequest.CodeChallengeMethod,
+		RedirectUri:         authorizationRequest.RedirectUri,
+		ResponseType:        authorizationRequest.ResponseType,
+		Scope:               authorizationRequest.Scope,
+		State:               state,
+		Nonce:               nonce,
+		IPAddress:           query.IPAddress,
+		UserAgent:           query.UserAgent,
+	})
+
+	if err != nil {
+		var customError *errors.CustomError
+		if !goerrors.As(err, &customError) {
+			return nil, err
+		}
+
+		redirectTo, err := application_utils.AuthorizationErrorRedirect(
+			authorizationRequest.RedirectUri,
+			errors.NewOAuthError(errors.OAuthAccessDenied, customError.Message),
+			state,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &Response{RedirectTo: redirectTo}, nil
+	}
+
+	// The request is only consumed once a code was issued, so a failed
+	// attempt can be retried until it expires.
+	if err := s.repository.RemoveAuthorizationRequest(ctx, authorizationRequest.ID); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{"code": {authorized.AuthorizationCode}}
+
+	if state != "" {
+		params.Set("state", state)
+	}
+
+	redirectTo, err := application_utils.AuthorizationRedirect(authorizationRequest.RedirectUri, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{RedirectTo: redirectTo}, nil
+}
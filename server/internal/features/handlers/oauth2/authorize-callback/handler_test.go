@@ -0,0 +1,142 @@
+package authorizecallback
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/features/handlers/authentication/authorize"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Mocks
+// ---------------------------------------------------------------------------
+
+type mockCallbackRepo struct{ mock.Mock }
+
+func (m *mockCallbackRepo) GetAuthorizationRequestByID(ctx context.Context, id uuid.UUID) (*entities.AuthorizationRequest, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AuthorizationRequest), args.Error(1)
+}
+
+func (m *mockCallbackRepo) RemoveAuthorizationRequest(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+// Compile-time check
+var _ IRepository = (*mockCallbackRepo)(nil)
+
+type mockAuthorize struct{ mock.Mock }
+
+func (m *mockAuthorize) Handler(ctx context.Context, command authorize.Command) (*authorize.Response, error) {
+	args := m.Called(ctx, command)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authorize.Response), args.Error(1)
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+func storedRequest() *entities.AuthorizationRequest {
+	state := "xyz"
+	authorizationRequest, _ := entities.NewAuthorizationRequest(
+		uuid.New(),
+		"https://app.example.com/callback?tenant=acme",
+		"code",
+		"openid email",
+		&state,
+		nil,
+		"challenge",
+		"S256",
+	)
+	return authorizationRequest
+}
+
+func setup(authorizationRequest *entities.AuthorizationRequest) (*mockCallbackRepo, *mockAuthorize, *Handler) {
+	repo := new(mockCallbackRepo)
+	authorizeService := new(mockAuthorize)
+
+	repo.On("GetAuthorizationRequestByID", mock.Anything, authorizationRequest.ID).Return(authorizationRequest, nil)
+	repo.On("RemoveAuthorizationRequest", mock.Anything, authorizationRequest.ID).Return(nil)
+
+	return repo, authorizeService, &Handler{repository: repo, authorize: authorizeService}
+}
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestHandler_RedirectsBackWithCode(t *testing.T) {
+	authorizationRequest := storedRequest()
+	repo, authorizeService, handler := setup(authorizationRequest)
+
+	authorizeService.On("Handler", mock.Anything, mock.MatchedBy(func(command authorize.Command) bool {
+		return command.ApplicationID == authorizationRequest.ApplicationID &&
+			command.RedirectUri == authorizationRequest.RedirectUri &&
+			command.CodeChallenge == "challenge" &&
+			command.SessionCode == "session-code" &&
+			command.State == "xyz" &&
+			command.IPAddress == "203.0.113.7" &&
+			command.UserAgent == "Mozilla/5.0"
+	})).Return(&authorize.Response{AuthorizationCode: "the-code"}, nil)
+
+	response, err := handler.Handler(context.Background(), Query{
+		RequestID:   authorizationRequest.ID.String(),
+		SessionCode: "session-code",
+		Email:       "user@example.com",
+		IPAddress:   "203.0.113.7",
+		UserAgent:   "Mozilla/5.0",
+	})
+	require.NoError(t, err)
+
+	redirectTo, err := url.Parse(response.RedirectTo)
+	require.NoError(t, err)
+	assert.Equal(t, "app.example.com", redirectTo.Host)
+	assert.Equal(t, "the-code", redirectTo.Query().Get("code"))
+	assert.Equal(t, "xyz", redirectTo.Query().Get("state"))
+	assert.Equal(t, "acme", redirectTo.Query().Get("tenant"))
+	repo.AssertCalled(t, "RemoveAuthorizationRequest", mock.Anything, authorizationRequest.ID)
+}
+
+func TestHandler_LoginFailureIsRedirectedAsAccessDenied(t *testing.T) {
+	authorizationRequest := storedRequest()
+	repo, authorizeService, handler := setup(authorizationRequest)
+
+	authorizeService.On("Handler", mock.Anything, mock.Anything).Return(nil, &errors.ErrSessionCodeExpired)
+
+	response, err := handler.Handler(context.Background(), Query{RequestID: authorizationRequest.ID.String(), SessionCode: "old"})
+	require.NoError(t, err)
+
+	redirectTo, err := url.Parse(response.RedirectTo)
+	require.NoError(t, err)
+	assert.Equal(t, errors.OAuthAccessDenied, redirectTo.Query().Get("error"))
+	assert.Equal(t, "xyz", redirectTo.Query().Get("state"))
+	assert.Empty(t, redirectTo.Query().Get("code"))
+	repo.AssertNotCalled(t, "RemoveAuthorizationRequest", mock.Anything, mock.Anything)
+}
+
+func TestHandler_ExpiredRequestIsNotRedirected(t *testing.T) {
+	authorizationRequest := storedRequest()
+	authorizationRequest.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+	_, authorizeService, handler := setup(authorizationRequest)
+
+	_, err := handler.Handler(context.Background(), Query{RequestID: authorizationRequest.ID.String(), SessionCode: "code"})
+
+	var oauthErr *errors.OAuthError
+	require.ErrorAs(t, err, &oauthErr)
+	assert.Equal(t, errors.OAuthInvalidRequest, oauthErr.Code)
+	authorizeService.AssertNotCalled(t, "Handler", mock.Anything, mock.Anything)
+}
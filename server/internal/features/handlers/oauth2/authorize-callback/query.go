@@ -0,0 +1,12 @@
+package authorizecallback
+
+// Query is posted by the hosted login UI once the user signed in (and passed
+// MFA), carrying the session code the login handlers issued.
+type Query struct {
+	RequestID   string
+	SessionCode string
+	Email       string
+
+	IPAddress string // injected server-side
+	UserAgent string // injected server-side
+}
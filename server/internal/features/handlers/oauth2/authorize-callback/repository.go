@@ -0,0 +1,25 @@
+package authorizecallback
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetAuthorizationRequestByID(ctx context.Context, id uuid.UUID) (*entities.AuthorizationRequest, error)
+	RemoveAuthorizationRequest(ctx context.Context, id uuid.UUID) error
+}
+
+type Repository struct {
+	repositories.AuthorizationRequestRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		AuthorizationRequestRepository: repositories.AuthorizationRequestRepository{Store: q},
+	}
+}
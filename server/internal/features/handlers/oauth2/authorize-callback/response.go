@@ -0,0 +1,7 @@
+package authorizecallback
+
+type Response struct {
+	// RedirectTo is the client's redirect_uri carrying either the
+	// authorization code or the error.
+	RedirectTo string
+}
@@ -0,0 +1,186 @@
+package authorize
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler validates a browser authorization request and sends the user to the
+// hosted login UI. Problems with client_id or redirect_uri are returned as
+// errors because redirecting to an unregistered URI would turn GateKeeper into
+// an open redirector (RFC 6749 §4.1.2.1); every other problem is reported to
+// the client through its redirect_uri.
+func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
+	applicationID, err := uuid.Parse(query.ClientID)
+	if err != nil {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "client_id is missing or malformed")
+	}
+
+	application, err := s.repository.GetApplicationByID(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if application == nil || !application.IsActive {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "unknown client_id")
+	}
+
+	registeredRedirectURIs, err := s.repository.ListRedirectURIs(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !services.MatchRedirectURI(registeredRedirectURIs, constants.RedirectURIKindLogin, query.RedirectURI) {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "redirect_uri is missing or not registered for this client")
+	}
+
+	if query.ResponseType != "code" {
+		return refuse(query, errors.OAuthUnsupportedResponseType, "only the code response type is supported")
+	}
+
+	// PKCE is mandatory, as it already is for the JSON authorize endpoint.
+	if query.CodeChallenge == "" {
+		return refuse(query, errors.OAuthInvalidRequest, "code_challenge is required")
+	}
+
+	// RFC 7636 §4.3 defaults to plain, which only protects the code when the
+	// challenge can't be observed, so S256 is assumed instead.
+	codeChallengeMethod := query.CodeChallengeMethod
+	if codeChallengeMethod == "" {
+		codeChallengeMethod = "S256"
+	}
+
+	if codeChallengeMethod != "S256" && codeChallengeMethod != "plain" {
+		return refuse(query, errors.OAuthInvalidRequest, "code_challenge_method must be S256 or plain")
+	}
+
+	// Public clients have no secret to fall back on if the code leaks
+	// (OAuth 2.0 Security BCP §2.1.1).
+	if codeChallengeMethod == "plain" {
+		secrets, err := s.repository.ListSecretsFromApplication(ctx, application.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !hasActiveSecret(secrets) {
+			return refuse(query, errors.OAuthInvalidRequest, "public clients must use the S256 code_challenge_method")
+		}
+	}
+
+	// Tokens only ever carry the OpenID Connect scopes, so anything else
+	// would be silently dropped (RFC 6749 §4.1.2.1)
+	for _, requested := range strings.Fields(query.Scope) {
+		if !slices.Contains(constants.SupportedScopes, requested) {
+			return refuse(query, errors.OAuthInvalidScope, fmt.Sprintf("scope %q is not supported", requested))
+		}
+	}
+
+	// There is no GateKeeper browser session to reuse, so the user always
+	// has to interact with the login UI.
+	if query.Prompt == "none" {
+		return refuse(query, errors.OAuthLoginRequired, "user interaction is required")
+	}
+
+	scope := strings.Join(strings.Fields(query.Scope), " ")
+	if scope == "" {
+		scope = constants.ScopeOpenID
+	}
+
+	authorizationRequest, err := entities.NewAuthorizationRequest(
+		application.ID,
+		query.RedirectURI,
+		query.ResponseType,
+		scope,
+		optional(query.State),
+		optional(query.Nonce),
+		query.CodeChallenge,
+		codeChallengeMethod,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.AddAuthorizationRequest(ctx, authorizationRequest); err != nil {
+		return nil, err
+	}
+
+	// The legacy parameters are forwarded as well so the login UI pages keep
+	// working unchanged; request_id is what the callback trusts.
+	params := url.Values{
+		"request_id":            {authorizationRequest.ID.String()},
+		"redirect_uri":          {query.RedirectURI},
+		"response_type":         {query.ResponseType},
+		"scope":                 {scope},
+		"state":                 {query.State},
+		"code_challenge":        {query.CodeChallenge},
+		"code_challenge_method": {codeChallengeMethod},
+	}
+
+	if query.Nonce != "" {
+		params.Set("nonce", query.Nonce)
+	}
+
+	loginURL := os.Getenv("CLIENT_APPLICATION_URL") + "/auth/" + application.ID.String() + "/sign-in?" + params.Encode()
+
+	return &Response{RedirectTo: loginURL}, nil
+}
+
+func refuse(query Query, code, description string) (*Response, error) {
+	redirectTo, err := application_utils.AuthorizationErrorRedirect(query.RedirectURI, errors.NewOAuthError(code, description), query.State)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{RedirectTo: redirectTo}, nil
+}
+
+// hasActiveSecret reports whether the application has a secret that hasn't
+// expired, i.e. whether it is a confidential client.
+func hasActiveSecret(secrets *[]entities.ApplicationSecret) bool {
+	if secrets == nil {
+		return false
+	}
+
+	now := time.Now().UTC()
+
+	for _, secret := range *secrets {
+		if secret.ExpiresAt == nil || secret.ExpiresAt.After(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
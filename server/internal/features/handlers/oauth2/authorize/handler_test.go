@@ -0,0 +1,218 @@
+package authorize
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Repository mock
+// ---------------------------------------------------------------------------
+
+type mockAuthorizeRepo struct{ mock.Mock }
+
+func (m *mockAuthorizeRepo) GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Application), args.Error(1)
+}
+
+func (m *mockAuthorizeRepo) AddAuthorizationRequest(ctx context.Context, authorizationRequest *entities.AuthorizationRequest) error {
+	return m.Called(ctx, authorizationRequest).Error(0)
+}
+
+func (m *mockAuthorizeRepo) ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.ApplicationRedirectURI), args.Error(1)
+}
+
+func (m *mockAuthorizeRepo) ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entities.ApplicationSecret), args.Error(1)
+}
+
+// Compile-time check
+var _ IRepository = (*mockAuthorizeRepo)(nil)
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+func setup(t *testing.T) (*mockAuthorizeRepo, *entities.Application) {
+	t.Setenv("CLIENT_APPLICATION_URL", "https://login.example.com")
+
+	repo := new(mockAuthorizeRepo)
+	application := &entities.Application{ID: uuid.New(), TenantID: uuid.New(), IsActive: true}
+
+	repo.On("GetApplicationByID", mock.Anything, application.ID).Return(application, nil)
+	repo.On("AddAuthorizationRequest", mock.Anything, mock.Anything).Return(nil)
+	repo.On("ListRedirectURIs", mock.Anything, application.ID).Return([]entities.ApplicationRedirectURI{
+		{ApplicationID: application.ID, URI: "https://app.example.com/callback", Kind: constants.RedirectURIKindLogin},
+	}, nil)
+
+	return repo, application
+}
+
+func baseQuery(application *entities.Application) Query {
+	return Query{
+		ResponseType:        "code",
+		ClientID:            application.ID.String(),
+		RedirectURI:         "https://app.example.com/callback",
+		State:               "xyz",
+		Nonce:               "n-0S6",
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: "S256",
+	}
+}
+
+func authorize(t *testing.T, repo *mockAuthorizeRepo, query Query) *url.URL {
+	t.Helper()
+	handler := &Handler{repository: repo}
+
+	response, err := handler.Handler(context.Background(), query)
+	require.NoError(t, err)
+
+	redirectTo, err := url.Parse(response.RedirectTo)
+	require.NoError(t, err)
+	return redirectTo
+}
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestHandler_RedirectsToHostedLogin(t *testing.T) {
+	repo, application := setup(t)
+
+	redirectTo := authorize(t, repo, baseQuery(application))
+
+	assert.Equal(t, "login.example.com", redirectTo.Host)
+	assert.Equal(t, "/auth/"+application.ID.String()+"/sign-in", redirectTo.Path)
+	assert.Equal(t, "xyz", redirectTo.Query().Get("state"))
+
+	stored := repo.Calls[2].Arguments.Get(1).(*entities.AuthorizationRequest)
+	assert.Equal(t, stored.ID.String(), redirectTo.Query().Get("request_id"))
+	assert.Equal(t, "openid", stored.Scope)
+	assert.Equal(t, "https://app.example.com/callback", stored.RedirectUri)
+	assert.Equal(t, "n-0S6", *stored.Nonce)
+}
+
+func TestHandler_UnknownClientIsNotRedirected(t *testing.T) {
+	repo, application := setup(t)
+	query := baseQuery(application)
+	query.ClientID = uuid.NewString()
+
+	repo.On("GetApplicationByID", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := (&Handler{repository: repo}).Handler(context.Background(), query)
+
+	var oauthErr *errors.OAuthError
+	require.ErrorAs(t, err, &oauthErr)
+	assert.Equal(t, errors.OAuthInvalidRequest, oauthErr.Code)
+}
+
+func TestHandler_UnregisteredRedirectURIIsNotRedirected(t *testing.T) {
+	repo, application := setup(t)
+
+	for _, redirectURI := range []string{"", "/callback", "https://app.example.com/callback#fragment", "https://evil.example.com/callback"} {
+		query := baseQuery(application)
+		query.RedirectURI = redirectURI
+
+		_, err := (&Handler{repository: repo}).Handler(context.Background(), query)
+
+		var oauthErr *errors.OAuthError
+		require.ErrorAs(t, err, &oauthErr, redirectURI)
+	}
+
+	repo.AssertNotCalled(t, "AddAuthorizationRequest", mock.Anything, mock.Anything)
+}
+
+func TestHandler_ErrorsAreRedirectedToClient(t *testing.T) {
+	repo, application := setup(t)
+
+	cases := map[string]func(*Query){
+		errors.OAuthUnsupportedResponseType: func(q *Query) { q.ResponseType = "token" },
+		errors.OAuthInvalidRequest:          func(q *Query) { q.CodeChallenge = "" },
+		errors.OAuthLoginRequired:           func(q *Query) { q.Prompt = "none" },
+		errors.OAuthInvalidScope:            func(q *Query) { q.Scope = "openid admin" },
+	}
+
+	for code, mutate := range cases {
+		query := baseQuery(application)
+		mutate(&query)
+
+		redirectTo := authorize(t, repo, query)
+
+		assert.Equal(t, "app.example.com", redirectTo.Host)
+		assert.Equal(t, code, redirectTo.Query().Get("error"))
+		assert.Equal(t, "xyz", redirectTo.Query().Get("state"))
+	}
+
+	repo.AssertNotCalled(t, "AddAuthorizationRequest", mock.Anything, mock.Anything)
+}
+
+func TestHandler_KeepsSupportedScopes(t *testing.T) {
+	repo, application := setup(t)
+	query := baseQuery(application)
+	query.Scope = "openid  email offline_access"
+
+	redirectTo := authorize(t, repo, query)
+
+	assert.Equal(t, "login.example.com", redirectTo.Host)
+	stored := repo.Calls[2].Arguments.Get(1).(*entities.AuthorizationRequest)
+	assert.Equal(t, "openid email offline_access", stored.Scope)
+}
+
+func TestHandler_DefaultsCodeChallengeMethodToS256(t *testing.T) {
+	repo, application := setup(t)
+	query := baseQuery(application)
+	query.CodeChallengeMethod = ""
+
+	redirectTo := authorize(t, repo, query)
+
+	assert.Equal(t, "S256", redirectTo.Query().Get("code_challenge_method"))
+}
+
+func TestHandler_PublicClientCannotUsePlain(t *testing.T) {
+	repo, application := setup(t)
+	query := baseQuery(application)
+	query.CodeChallengeMethod = "plain"
+
+	repo.On("ListSecretsFromApplication", mock.Anything, application.ID).Return(&[]entities.ApplicationSecret{}, nil)
+
+	redirectTo := authorize(t, repo, query)
+
+	assert.Equal(t, "app.example.com", redirectTo.Host)
+	assert.Equal(t, errors.OAuthInvalidRequest, redirectTo.Query().Get("error"))
+	repo.AssertNotCalled(t, "AddAuthorizationRequest", mock.Anything, mock.Anything)
+}
+
+func TestHandler_ConfidentialClientCanUsePlain(t *testing.T) {
+	repo, application := setup(t)
+	query := baseQuery(application)
+	query.CodeChallengeMethod = "plain"
+
+	repo.On("ListSecretsFromApplication", mock.Anything, application.ID).Return(&[]entities.ApplicationSecret{{ApplicationID: application.ID, Value: "secret"}}, nil)
+
+	redirectTo := authorize(t, repo, query)
+
+	assert.Equal(t, "login.example.com", redirectTo.Host)
+	assert.Equal(t, "plain", redirectTo.Query().Get("code_challenge_method"))
+}
@@ -0,0 +1,14 @@
+package authorize
+
+// Query is the RFC 6749 §4.1.1 / OIDC Core §3.1.2.1 authorization request.
+type Query struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Prompt              string
+}
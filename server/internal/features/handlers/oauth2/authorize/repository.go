@@ -0,0 +1,33 @@
+package authorize
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	AddAuthorizationRequest(ctx context.Context, authorizationRequest *entities.AuthorizationRequest) error
+	ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error)
+	ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error)
+}
+
+type Repository struct {
+	repositories.ApplicationRepository
+	repositories.AuthorizationRequestRepository
+	repositories.RedirectURIRepository
+	repositories.SecretRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		ApplicationRepository:          repositories.ApplicationRepository{Store: q},
+		AuthorizationRequestRepository: repositories.AuthorizationRequestRepository{Store: q},
+		RedirectURIRepository:          repositories.RedirectURIRepository{Store: q},
+		SecretRepository:               repositories.SecretRepository{Store: q},
+	}
+}
@@ -0,0 +1,7 @@
+package authorize
+
+type Response struct {
+	// RedirectTo is either the hosted login UI or, when the request was
+	// refused, the client's redirect_uri carrying the error.
+	RedirectTo string
+}
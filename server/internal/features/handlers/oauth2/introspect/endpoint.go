@@ -0,0 +1,44 @@
+package introspect
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	form, credentials, err := http_router.ParseOAuthForm(request)
+
+	if err != nil {
+		panic(err)
+	}
+
+	query := Query{
+		Token:         form.Get("token"),
+		TokenTypeHint: form.Get("token_type_hint"),
+		ClientID:      credentials.ClientID,
+		ClientSecret:  credentials.ClientSecret,
+	}
+
+	params := repositories.ParamsRs[Query, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: query,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	writter.Header().Set("Cache-Control", "no-store")
+
+	http_router.SendJson(writter, response, http.StatusOK)
+}
@@ -0,0 +1,180 @@
+package introspect
+
+import (
+	"context"
+	"os"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler reports whether a token is currently active. Besides signature and
+// expiry it consults live state: refresh token rotation/revocation, the linked
+// user session and the user account. Tokens of another tenant are reported as
+// inactive so a client can never probe tokens outside its own tenant.
+func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
+	application, err := application_utils.AuthenticateClient(ctx, s.repository, query.ClientID, query.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Token == "" {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "token is required")
+	}
+
+	// Refresh tokens are opaque identifiers, access tokens are JWTs, so the
+	// token_type_hint is not needed to find the token.
+	if refreshTokenID, err := uuid.Parse(query.Token); err == nil {
+		return s.introspectRefreshToken(ctx, application, refreshTokenID)
+	}
+
+	return s.introspectAccessToken(ctx, application, query.Token)
+}
+
+func (s *Handler) introspectRefreshToken(ctx context.Context, application *entities.Application, refreshTokenID uuid.UUID) (*Response, error) {
+	refreshToken, err := s.repository.GetRefreshTokenByID(ctx, refreshTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if refreshToken == nil || refreshToken.IsUsed() || refreshToken.IsRevoked() || refreshToken.IsExpired() {
+		return inactive(), nil
+	}
+
+	user, active, err := s.activeUser(ctx, application, refreshToken.UserID, refreshToken.SessionID)
+	if err != nil || !active {
+		return inactive(), err
+	}
+
+	response := &Response{
+		Active:    true,
+		TokenType: "refresh_token",
+		Exp:       refreshToken.ExpiresAt.Unix(),
+		Iat:       refreshToken.CreatedAt.Unix(),
+		Sub:       user.ID.String(),
+		Iss:       issuer(),
+		OrgID:     user.TenantID.String(),
+	}
+
+	if refreshToken.ApplicationID != nil {
+		response.ClientID = refreshToken.ApplicationID.String()
+	}
+
+	if refreshToken.Scope != nil {
+		response.Scope = *refreshToken.Scope
+	}
+
+	return response, nil
+}
+
+func (s *Handler) introspectAccessToken(ctx context.Context, application *entities.Application, token string) (*Response, error) {
+	claims, err := application_utils.ParseTokenClaims(token)
+	if err != nil {
+		return inactive(), nil
+	}
+
+	orgID, _ := claims["org_id"].(string)
+	if orgID != application.TenantID.String() {
+		return inactive(), nil
+	}
+
+	jti, _ := claims["jti"].(string)
+	if revoked, err := s.repository.IsAccessTokenRevoked(ctx, jti); err != nil || revoked {
+		return inactive(), err
+	}
+
+	sub, _ := claims["sub"].(string)
+	clientID, _ := claims["client_id"].(string)
+
+	// Tokens issued to an application (client_credentials) have no user behind them.
+	if sub != clientID {
+		userID, err := uuid.Parse(sub)
+		if err != nil {
+			return inactive(), nil
+		}
+
+		var sessionID *uuid.UUID
+		if sid, ok := claims["sid"].(string); ok {
+			if parsed, err := uuid.Parse(sid); err == nil {
+				sessionID = &parsed
+			}
+		}
+
+		if _, active, err := s.activeUser(ctx, application, userID, sessionID); err != nil || !active {
+			return inactive(), err
+		}
+	}
+
+	response := &Response{
+		Active:    true,
+		TokenType: "Bearer",
+		Sub:       sub,
+		ClientID:  clientID,
+		OrgID:     orgID,
+	}
+
+	response.Scope, _ = claims["scope"].(string)
+	response.Iss, _ = claims["iss"].(string)
+	response.Jti, _ = claims["jti"].(string)
+
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		response.Exp = exp.Unix()
+	}
+
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		response.Iat = iat.Unix()
+	}
+
+	return response, nil
+}
+
+// activeUser checks that the user still exists in the caller's tenant, is
+// active, and that the session the token is bound to has not been revoked.
+func (s *Handler) activeUser(ctx context.Context, application *entities.Application, userID uuid.UUID, sessionID *uuid.UUID) (*entities.TenantUser, bool, error) {
+	user, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if user == nil || !user.IsActive || user.TenantID != application.TenantID {
+		return nil, false, nil
+	}
+
+	if sessionID != nil {
+		session, err := s.repository.GetUserSessionByID(ctx, *sessionID, user.ID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if session == nil || !session.IsActive() {
+			return nil, false, nil
+		}
+	}
+
+	return user, true, nil
+}
+
+func inactive() *Response {
+	return &Response{Active: false}
+}
+
+func issuer() string {
+	if issuer := os.Getenv("ISSUER_URL"); issuer != "" {
+		return issuer
+	}
+	return "https://proxymity.tech/guard"
+}
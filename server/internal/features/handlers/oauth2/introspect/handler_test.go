@@ -0,0 +1,239 @@
+package introspect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Repository mock
+// ---------------------------------------------------------------------------
+
+type mockIntrospectRepo struct{ mock.Mock }
+
+func (m *mockIntrospectRepo) GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Application), args.Error(1)
+}
+
+func (m *mockIntrospectRepo) ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entities.ApplicationSecret), args.Error(1)
+}
+
+func (m *mockIntrospectRepo) GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.TenantUser), args.Error(1)
+}
+
+func (m *mockIntrospectRepo) GetRefreshTokenByID(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.RefreshToken), args.Error(1)
+}
+
+func (m *mockIntrospectRepo) GetUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) (*entities.UserSession, error) {
+	args := m.Called(ctx, sessionID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserSession), args.Error(1)
+}
+
+func (m *mockIntrospectRepo) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	args := m.Called(ctx, jti)
+	return args.Bool(0), args.Error(1)
+}
+
+// Compile-time check
+var _ IRepository = (*mockIntrospectRepo)(nil)
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+func setup(t *testing.T) (*mockIntrospectRepo, *entities.Application, *entities.TenantUser) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	repo := new(mockIntrospectRepo)
+	application := &entities.Application{ID: uuid.New(), TenantID: uuid.New(), IsActive: true}
+	user := &entities.TenantUser{ID: uuid.New(), TenantID: application.TenantID, IsActive: true}
+
+	repo.On("GetApplicationByID", mock.Anything, application.ID).Return(application, nil)
+	repo.On("ListSecretsFromApplication", mock.Anything, application.ID).Return(&[]entities.ApplicationSecret{{Value: "secret"}}, nil)
+	repo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+
+	return repo, application, user
+}
+
+func introspect(t *testing.T, repo *mockIntrospectRepo, application *entities.Application, token string) *Response {
+	t.Helper()
+	handler := &Handler{repository: repo}
+
+	response, err := handler.Handler(context.Background(), Query{Token: token, ClientID: application.ID.String(), ClientSecret: "secret"})
+	require.NoError(t, err)
+	return response
+}
+
+func refreshTokenFor(user *entities.TenantUser, application *entities.Application) *entities.RefreshToken {
+	refreshToken, _ := entities.CreateRefreshToken(user.ID, time.Now().UTC().Add(time.Hour))
+	scope := "openid"
+	refreshToken.ApplicationID = &application.ID
+	refreshToken.Scope = &scope
+	return refreshToken
+}
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestHandler_RequiresClientAuthentication(t *testing.T) {
+	repo, application, _ := setup(t)
+	handler := &Handler{repository: repo}
+
+	_, err := handler.Handler(context.Background(), Query{Token: "x", ClientID: application.ID.String(), ClientSecret: "wrong"})
+
+	var oauthErr *errors.OAuthError
+	require.ErrorAs(t, err, &oauthErr)
+	assert.Equal(t, errors.OAuthInvalidClient, oauthErr.Code)
+}
+
+func TestHandler_ActiveAccessToken(t *testing.T) {
+	repo, application, user := setup(t)
+
+	token, err := application_utils.CreateToken(application_utils.JWTClaims{
+		UserID:   user.ID,
+		TenantID: user.TenantID,
+		ClientID: application.ID,
+		Scope:    "openid email",
+	})
+	require.NoError(t, err)
+
+	repo.On("IsAccessTokenRevoked", mock.Anything, mock.Anything).Return(false, nil)
+	response := introspect(t, repo, application, token)
+
+	assert.True(t, response.Active)
+	assert.Equal(t, user.ID.String(), response.Sub)
+	assert.Equal(t, application.ID.String(), response.ClientID)
+	assert.Equal(t, "openid email", response.Scope)
+	assert.Equal(t, user.TenantID.String(), response.OrgID)
+	assert.NotZero(t, response.Exp)
+	assert.NotZero(t, response.Iat)
+}
+
+func TestHandler_AccessTokenOfDeactivatedUserIsInactive(t *testing.T) {
+	repo, application, user := setup(t)
+	user.IsActive = false
+
+	token, err := application_utils.CreateToken(application_utils.JWTClaims{UserID: user.ID, TenantID: user.TenantID})
+	require.NoError(t, err)
+
+	repo.On("IsAccessTokenRevoked", mock.Anything, mock.Anything).Return(false, nil)
+	assert.Equal(t, &Response{Active: false}, introspect(t, repo, application, token))
+}
+
+func TestHandler_AccessTokenOfAnotherTenantIsInactive(t *testing.T) {
+	repo, application, _ := setup(t)
+
+	token, err := application_utils.CreateToken(application_utils.JWTClaims{UserID: uuid.New(), TenantID: uuid.New()})
+	require.NoError(t, err)
+
+	assert.Equal(t, &Response{Active: false}, introspect(t, repo, application, token))
+}
+
+func TestHandler_ClientCredentialsToken(t *testing.T) {
+	repo, application, _ := setup(t)
+
+	token, err := application_utils.CreateClientToken(application_utils.ClientClaims{
+		ApplicationID: application.ID,
+		TenantID:      application.TenantID,
+		Scope:         "orders:read",
+	})
+	require.NoError(t, err)
+
+	repo.On("IsAccessTokenRevoked", mock.Anything, mock.Anything).Return(false, nil)
+	response := introspect(t, repo, application, token)
+
+	assert.True(t, response.Active)
+	assert.Equal(t, application.ID.String(), response.Sub)
+	assert.Equal(t, "orders:read", response.Scope)
+	repo.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+}
+
+func TestHandler_MalformedTokenIsInactive(t *testing.T) {
+	repo, application, _ := setup(t)
+
+	assert.Equal(t, &Response{Active: false}, introspect(t, repo, application, "not-a-token"))
+}
+
+func TestHandler_ActiveRefreshToken(t *testing.T) {
+	repo, application, user := setup(t)
+	refreshToken := refreshTokenFor(user, application)
+	repo.On("GetRefreshTokenByID", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+
+	response := introspect(t, repo, application, refreshToken.ID.String())
+
+	assert.True(t, response.Active)
+	assert.Equal(t, "refresh_token", response.TokenType)
+	assert.Equal(t, user.ID.String(), response.Sub)
+	assert.Equal(t, application.ID.String(), response.ClientID)
+	assert.Equal(t, "openid", response.Scope)
+}
+
+func TestHandler_RotatedRefreshTokenIsInactive(t *testing.T) {
+	repo, application, user := setup(t)
+	refreshToken := refreshTokenFor(user, application)
+	_, err := refreshToken.Rotate(time.Now().UTC().Add(time.Hour))
+	require.NoError(t, err)
+	repo.On("GetRefreshTokenByID", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+
+	assert.Equal(t, &Response{Active: false}, introspect(t, repo, application, refreshToken.ID.String()))
+}
+
+func TestHandler_RefreshTokenOfRevokedSessionIsInactive(t *testing.T) {
+	repo, application, user := setup(t)
+	refreshToken := refreshTokenFor(user, application)
+	sessionID := uuid.New()
+	refreshToken.SessionID = &sessionID
+
+	repo.On("GetRefreshTokenByID", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+	repo.On("GetUserSessionByID", mock.Anything, sessionID, user.ID).Return(&entities.UserSession{
+		ID:        sessionID,
+		ExpiresAt: time.Now().UTC().Add(time.Hour),
+		IsRevoked: true,
+	}, nil)
+
+	assert.Equal(t, &Response{Active: false}, introspect(t, repo, application, refreshToken.ID.String()))
+}
+
+func TestHandler_RevokedAccessTokenIsInactive(t *testing.T) {
+	repo, application, user := setup(t)
+
+	token, err := application_utils.CreateToken(application_utils.JWTClaims{UserID: user.ID, TenantID: user.TenantID})
+	require.NoError(t, err)
+
+	repo.On("IsAccessTokenRevoked", mock.Anything, mock.Anything).Return(true, nil)
+
+	assert.Equal(t, &Response{Active: false}, introspect(t, repo, application, token))
+	repo.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+}
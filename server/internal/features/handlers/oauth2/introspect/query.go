@@ -0,0 +1,9 @@
+package introspect
+
+// Query is the RFC 7662 §2.1 introspection request.
+type Query struct {
+	Token         string
+	TokenTypeHint string
+	ClientID      string
+	ClientSecret  string
+}
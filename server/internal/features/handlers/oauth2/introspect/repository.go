@@ -0,0 +1,39 @@
+package introspect
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error)
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error)
+	GetRefreshTokenByID(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error)
+	GetUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) (*entities.UserSession, error)
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+type Repository struct {
+	repositories.ApplicationRepository
+	repositories.SecretRepository
+	repositories.UserRepository
+	repositories.RefreshTokenRepository
+	repositories.UserSessionRepository
+	repositories.RevokedAccessTokenRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		ApplicationRepository:        repositories.ApplicationRepository{Store: q},
+		SecretRepository:             repositories.SecretRepository{Store: q},
+		UserRepository:               repositories.UserRepository{Store: q},
+		RefreshTokenRepository:       repositories.RefreshTokenRepository{Store: q},
+		UserSessionRepository:        repositories.UserSessionRepository{Store: q},
+		RevokedAccessTokenRepository: repositories.RevokedAccessTokenRepository{Store: q},
+	}
+}
@@ -0,0 +1,16 @@
+package introspect
+
+// Response is the RFC 7662 §2.2 introspection response. Inactive tokens only
+// carry "active": false so nothing leaks about them.
+type Response struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+	OrgID     string `json:"org_id,omitempty"`
+}
@@ -0,0 +1,43 @@
+package logout
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+// Http serves both GET and form-encoded POST, as required by OIDC
+// RP-Initiated Logout §2.
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	if err := request.ParseForm(); err != nil {
+		panic(errors.NewOAuthError(errors.OAuthInvalidRequest, "Malformed request body"))
+	}
+
+	query := Query{
+		IDTokenHint:           request.Form.Get("id_token_hint"),
+		ClientID:              request.Form.Get("client_id"),
+		PostLogoutRedirectURI: request.Form.Get("post_logout_redirect_uri"),
+		State:                 request.Form.Get("state"),
+	}
+
+	params := repositories.ParamsRs[Query, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: query,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	writter.Header().Set("Cache-Control", "no-store")
+	http.Redirect(writter, request, response.RedirectTo, http.StatusFound)
+}
@@ -0,0 +1,142 @@
+package logout
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler ends the session the ID token was issued for and revokes its
+// refresh tokens, leaving the user signed in on other devices. There is
+// no GateKeeper browser session to fall back on, so id_token_hint is the only
+// way to know who is logging out and is therefore required. As on the
+// authorization endpoint, nothing is redirected to a URI that was not
+// registered for the client.
+func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
+	if query.IDTokenHint == "" {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "id_token_hint is required")
+	}
+
+	claims, err := application_utils.ParseIDTokenHint(query.IDTokenHint)
+	if err != nil {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "id_token_hint is invalid")
+	}
+
+	userID, err := uuid.Parse(stringClaim(claims, "sub"))
+	if err != nil {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "id_token_hint is invalid")
+	}
+
+	applicationID, err := uuid.Parse(stringClaim(claims, "aud"))
+	if err != nil {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "id_token_hint is invalid")
+	}
+
+	// OIDC RP-Initiated Logout §2: client_id must match the ID token audience.
+	if query.ClientID != "" && query.ClientID != applicationID.String() {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "client_id does not match the id_token_hint audience")
+	}
+
+	application, err := s.repository.GetApplicationByID(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if application == nil || application.TenantID.String() != stringClaim(claims, "org_id") {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "unknown client")
+	}
+
+	// No session with the client outlives its refresh tokens, so an older
+	// hint can't name a session that is still worth ending.
+	if !application_utils.IDTokenHintIssuedWithin(claims, time.Hour*24*time.Duration(application.RefreshTokenTTLDays)) {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "id_token_hint has expired")
+	}
+
+	if query.PostLogoutRedirectURI != "" {
+		registeredRedirectURIs, err := s.repository.ListRedirectURIs(ctx, application.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !services.MatchRedirectURI(registeredRedirectURIs, constants.RedirectURIKindPostLogout, query.PostLogoutRedirectURI) {
+			return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "post_logout_redirect_uri is not registered for this client")
+		}
+	}
+
+	if err := s.endSessions(ctx, userID, application.ID, application_utils.SessionIDFromClaims(claims)); err != nil {
+		return nil, err
+	}
+
+	if query.PostLogoutRedirectURI == "" {
+		return &Response{
+			RedirectTo: os.Getenv("CLIENT_APPLICATION_URL") + "/auth/" + application.ID.String() + "/sign-in",
+		}, nil
+	}
+
+	params := url.Values{}
+	if query.State != "" {
+		params.Set("state", query.State)
+	}
+
+	redirectTo, err := application_utils.AuthorizationRedirect(query.PostLogoutRedirectURI, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{RedirectTo: redirectTo}, nil
+}
+
+// endSessions revokes the session named by the hint's sid claim. Hints issued
+// without a session can't tell which one is logging out, so every session
+// and refresh token of the user with the client is revoked instead.
+func (s *Handler) endSessions(ctx context.Context, userID, applicationID, sessionID uuid.UUID) error {
+	if sessionID != uuid.Nil {
+		if err := s.repository.RevokeUserSessionByID(ctx, sessionID, userID); err != nil {
+			return err
+		}
+
+		return s.repository.RevokeRefreshTokensFromSession(ctx, userID, sessionID)
+	}
+
+	if err := s.repository.RevokeUserSessionsFromApplication(ctx, userID, applicationID); err != nil {
+		return err
+	}
+
+	return s.repository.RevokeRefreshTokensFromApplication(ctx, userID, applicationID)
+}
+
+// stringClaim reads a string claim. An audience given as a single-element
+// array is accepted as well.
+func stringClaim(claims jwt.MapClaims, name string) string {
+	switch value := claims[name].(type) {
+	case string:
+		return value
+	case []interface{}:
+		if len(value) == 1 {
+			audience, _ := value[0].(string)
+			return audience
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,233 @@
+package logout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/signing"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Repository mock
+// ---------------------------------------------------------------------------
+
+type mockLogoutRepo struct{ mock.Mock }
+
+func (m *mockLogoutRepo) GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Application), args.Error(1)
+}
+
+func (m *mockLogoutRepo) ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error) {
+	args := m.Called(ctx, applicationID)
+	return args.Get(0).([]entities.ApplicationRedirectURI), args.Error(1)
+}
+
+func (m *mockLogoutRepo) RevokeUserSessionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) error {
+	return m.Called(ctx, userID, applicationID).Error(0)
+}
+
+func (m *mockLogoutRepo) RevokeRefreshTokensFromApplication(ctx context.Context, userID, applicationID uuid.UUID) error {
+	return m.Called(ctx, userID, applicationID).Error(0)
+}
+
+func (m *mockLogoutRepo) RevokeUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) error {
+	return m.Called(ctx, sessionID, userID).Error(0)
+}
+
+func (m *mockLogoutRepo) RevokeRefreshTokensFromSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	return m.Called(ctx, userID, sessionID).Error(0)
+}
+
+// Compile-time check
+var _ IRepository = (*mockLogoutRepo)(nil)
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+const postLogoutRedirectURI = "https://app.example.com/signed-out"
+
+func setup(t *testing.T) (*mockLogoutRepo, *entities.Application, string) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	t.Setenv("CLIENT_APPLICATION_URL", "https://login.example.com")
+
+	repo := new(mockLogoutRepo)
+	application := &entities.Application{ID: uuid.New(), TenantID: uuid.New(), IsActive: true, RefreshTokenTTLDays: 30}
+	userID := uuid.New()
+
+	idToken, err := application_utils.CreateIDToken(application_utils.JWTClaims{
+		UserID:   userID,
+		TenantID: application.TenantID,
+	}, nil, application.ID.String())
+	require.NoError(t, err)
+
+	postLogout, _ := entities.NewApplicationRedirectURI(application.ID, postLogoutRedirectURI, constants.RedirectURIKindPostLogout)
+
+	repo.On("GetApplicationByID", mock.Anything, application.ID).Return(application, nil)
+	repo.On("ListRedirectURIs", mock.Anything, application.ID).Return([]entities.ApplicationRedirectURI{*postLogout}, nil)
+	repo.On("RevokeUserSessionsFromApplication", mock.Anything, userID, application.ID).Return(nil)
+	repo.On("RevokeRefreshTokensFromApplication", mock.Anything, userID, application.ID).Return(nil)
+
+	return repo, application, idToken
+}
+
+func requireOAuthError(t *testing.T, err error, code string) {
+	t.Helper()
+
+	var oauthErr *errors.OAuthError
+	require.ErrorAs(t, err, &oauthErr)
+	assert.Equal(t, code, oauthErr.Code)
+}
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestHandler_RedirectsToPostLogoutURIWithState(t *testing.T) {
+	repo, _, idToken := setup(t)
+	handler := &Handler{repository: repo}
+
+	response, err := handler.Handler(context.Background(), Query{
+		IDTokenHint:           idToken,
+		PostLogoutRedirectURI: postLogoutRedirectURI,
+		State:                 "xyz",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, postLogoutRedirectURI+"?state=xyz", response.RedirectTo)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_WithoutPostLogoutURIRedirectsToSignIn(t *testing.T) {
+	repo, application, idToken := setup(t)
+	handler := &Handler{repository: repo}
+
+	response, err := handler.Handler(context.Background(), Query{IDTokenHint: idToken})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://login.example.com/auth/"+application.ID.String()+"/sign-in", response.RedirectTo)
+	repo.AssertCalled(t, "RevokeUserSessionsFromApplication", mock.Anything, mock.Anything, application.ID)
+	repo.AssertCalled(t, "RevokeRefreshTokensFromApplication", mock.Anything, mock.Anything, application.ID)
+}
+
+func TestHandler_EndsOnlyTheSessionOfTheHint(t *testing.T) {
+	repo, application, _ := setup(t)
+	handler := &Handler{repository: repo}
+
+	userID := uuid.New()
+	sessionID := uuid.New()
+	idToken, err := application_utils.CreateIDToken(application_utils.JWTClaims{
+		UserID:    userID,
+		TenantID:  application.TenantID,
+		SessionID: sessionID,
+	}, nil, application.ID.String())
+	require.NoError(t, err)
+
+	repo.On("RevokeUserSessionByID", mock.Anything, sessionID, userID).Return(nil)
+	repo.On("RevokeRefreshTokensFromSession", mock.Anything, userID, sessionID).Return(nil)
+
+	_, err = handler.Handler(context.Background(), Query{IDTokenHint: idToken})
+
+	require.NoError(t, err)
+	repo.AssertCalled(t, "RevokeUserSessionByID", mock.Anything, sessionID, userID)
+	repo.AssertCalled(t, "RevokeRefreshTokensFromSession", mock.Anything, userID, sessionID)
+	repo.AssertNotCalled(t, "RevokeUserSessionsFromApplication", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "RevokeRefreshTokensFromApplication", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_RequiresIDTokenHint(t *testing.T) {
+	repo, _, _ := setup(t)
+	handler := &Handler{repository: repo}
+
+	_, err := handler.Handler(context.Background(), Query{PostLogoutRedirectURI: postLogoutRedirectURI})
+
+	requireOAuthError(t, err, errors.OAuthInvalidRequest)
+	repo.AssertNotCalled(t, "RevokeUserSessionsFromApplication", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_RejectsTamperedIDTokenHint(t *testing.T) {
+	repo, _, idToken := setup(t)
+	handler := &Handler{repository: repo}
+
+	_, err := handler.Handler(context.Background(), Query{IDTokenHint: idToken + "x"})
+
+	requireOAuthError(t, err, errors.OAuthInvalidRequest)
+	repo.AssertNotCalled(t, "RevokeUserSessionsFromApplication", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_RejectsIDTokenHintOlderThanRefreshTokens(t *testing.T) {
+	repo, application, _ := setup(t)
+	handler := &Handler{repository: repo}
+
+	key, err := signing.Current().SigningKey(application.TenantID)
+	require.NoError(t, err)
+
+	stale := jwt.NewWithClaims(key.SigningMethod(), jwt.MapClaims{
+		"sub":    uuid.NewString(),
+		"aud":    application.ID.String(),
+		"org_id": application.TenantID.String(),
+		"iat":    time.Now().Add(-31 * 24 * time.Hour).Unix(),
+	})
+	stale.Header["kid"] = key.ID
+	idToken, err := stale.SignedString(key.PrivateKey)
+	require.NoError(t, err)
+
+	_, err = handler.Handler(context.Background(), Query{IDTokenHint: idToken})
+
+	requireOAuthError(t, err, errors.OAuthInvalidRequest)
+	repo.AssertNotCalled(t, "RevokeUserSessionsFromApplication", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_RejectsMismatchedClientID(t *testing.T) {
+	repo, _, idToken := setup(t)
+	handler := &Handler{repository: repo}
+
+	_, err := handler.Handler(context.Background(), Query{IDTokenHint: idToken, ClientID: uuid.New().String()})
+
+	requireOAuthError(t, err, errors.OAuthInvalidRequest)
+	repo.AssertNotCalled(t, "RevokeUserSessionsFromApplication", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_RejectsUnregisteredPostLogoutURI(t *testing.T) {
+	repo, _, idToken := setup(t)
+	handler := &Handler{repository: repo}
+
+	_, err := handler.Handler(context.Background(), Query{
+		IDTokenHint:           idToken,
+		PostLogoutRedirectURI: "https://evil.example.com/",
+	})
+
+	requireOAuthError(t, err, errors.OAuthInvalidRequest)
+	repo.AssertNotCalled(t, "RevokeUserSessionsFromApplication", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "RevokeRefreshTokensFromApplication", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_RejectsLoginRedirectURIAsPostLogoutURI(t *testing.T) {
+	repo, application, idToken := setup(t)
+	login, _ := entities.NewApplicationRedirectURI(application.ID, "https://app.example.com/callback", constants.RedirectURIKindLogin)
+	repo.ExpectedCalls = nil
+	repo.On("GetApplicationByID", mock.Anything, application.ID).Return(application, nil)
+	repo.On("ListRedirectURIs", mock.Anything, application.ID).Return([]entities.ApplicationRedirectURI{*login}, nil)
+	handler := &Handler{repository: repo}
+
+	_, err := handler.Handler(context.Background(), Query{
+		IDTokenHint:           idToken,
+		PostLogoutRedirectURI: "https://app.example.com/callback",
+	})
+
+	requireOAuthError(t, err, errors.OAuthInvalidRequest)
+}
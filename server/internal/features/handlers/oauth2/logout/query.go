@@ -0,0 +1,9 @@
+package logout
+
+// Query is the OIDC RP-Initiated Logout 1.0 §2 logout request.
+type Query struct {
+	IDTokenHint           string
+	ClientID              string
+	PostLogoutRedirectURI string
+	State                 string
+}
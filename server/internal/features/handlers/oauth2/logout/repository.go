@@ -0,0 +1,35 @@
+package logout
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error)
+	RevokeUserSessionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) error
+	RevokeRefreshTokensFromApplication(ctx context.Context, userID, applicationID uuid.UUID) error
+	RevokeUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) error
+	RevokeRefreshTokensFromSession(ctx context.Context, userID, sessionID uuid.UUID) error
+}
+
+type Repository struct {
+	repositories.ApplicationRepository
+	repositories.RedirectURIRepository
+	repositories.UserSessionRepository
+	repositories.RefreshTokenRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		ApplicationRepository:  repositories.ApplicationRepository{Store: q},
+		RedirectURIRepository:  repositories.RedirectURIRepository{Store: q},
+		UserSessionRepository:  repositories.UserSessionRepository{Store: q},
+		RefreshTokenRepository: repositories.RefreshTokenRepository{Store: q},
+	}
+}
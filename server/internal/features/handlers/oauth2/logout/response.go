@@ -0,0 +1,7 @@
+package logout
+
+type Response struct {
+	// RedirectTo is the client's post_logout_redirect_uri carrying state or,
+	// when none was requested, the hosted sign-in page.
+	RedirectTo string
+}
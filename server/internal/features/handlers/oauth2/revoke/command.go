@@ -0,0 +1,9 @@
+package revoke
+
+// Command is the RFC 7009 §2.1 revocation request.
+type Command struct {
+	Token         string
+	TokenTypeHint string
+	ClientID      string
+	ClientSecret  string
+}
@@ -0,0 +1,41 @@
+package revoke
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	form, credentials, err := http_router.ParseOAuthForm(request)
+
+	if err != nil {
+		panic(err)
+	}
+
+	command := Command{
+		Token:         form.Get("token"),
+		TokenTypeHint: form.Get("token_type_hint"),
+		ClientID:      credentials.ClientID,
+		ClientSecret:  credentials.ClientSecret,
+	}
+
+	params := repositories.Params[Command, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: command,
+	}
+
+	if err := repositories.WithTransaction(request.Context(), params); err != nil {
+		panic(err)
+	}
+
+	writter.Header().Set("Cache-Control", "no-store")
+	writter.WriteHeader(http.StatusOK)
+}
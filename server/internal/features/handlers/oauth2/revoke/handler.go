@@ -0,0 +1,100 @@
+package revoke
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler revokes a refresh token (its whole rotation family) or an access
+// token (its jti goes to the denylist until it can no longer be refreshed). Per RFC 7009
+// §2.2 unknown, invalid and already expired tokens are not an error: the
+// client only cares that the token can no longer be used.
+func (s *Handler) Handler(ctx context.Context, command Command) error {
+	application, err := application_utils.AuthenticateClient(ctx, s.repository, command.ClientID, command.ClientSecret)
+	if err != nil {
+		return err
+	}
+
+	if command.Token == "" {
+		return errors.NewOAuthError(errors.OAuthInvalidRequest, "token is required")
+	}
+
+	// Refresh tokens are opaque identifiers, access tokens are JWTs, so the
+	// token_type_hint is not needed to find the token.
+	if refreshTokenID, err := uuid.Parse(command.Token); err == nil {
+		return s.revokeRefreshToken(ctx, application, refreshTokenID)
+	}
+
+	return s.revokeAccessToken(ctx, application, command.Token)
+}
+
+func (s *Handler) revokeRefreshToken(ctx context.Context, application *entities.Application, refreshTokenID uuid.UUID) error {
+	refreshToken, err := s.repository.GetRefreshTokenByID(ctx, refreshTokenID)
+	if err != nil {
+		return err
+	}
+
+	if refreshToken == nil {
+		return nil
+	}
+
+	if refreshToken.ApplicationID != nil {
+		if *refreshToken.ApplicationID != application.ID {
+			return errors.NewOAuthError(errors.OAuthUnauthorizedClient, "token was not issued to this client")
+		}
+	} else {
+		// Tokens issued before refresh tokens were bound to a client can be
+		// revoked by any client of the user's tenant.
+		user, err := s.repository.GetUserByID(ctx, refreshToken.UserID)
+		if err != nil {
+			return err
+		}
+
+		if user == nil || user.TenantID != application.TenantID {
+			return nil
+		}
+	}
+
+	return s.repository.RevokeRefreshTokenFamily(ctx, refreshToken.FamilyID)
+}
+
+func (s *Handler) revokeAccessToken(ctx context.Context, application *entities.Application, token string) error {
+	// Tokens that just expired can still be refreshed, so they are revoked too.
+	claims, err := application_utils.ParseTokenClaimsWithLeeway(token, application_utils.RefreshLeeway)
+	if err != nil {
+		return nil
+	}
+
+	if orgID, _ := claims["org_id"].(string); orgID != application.TenantID.String() {
+		return nil
+	}
+
+	if clientID, _ := claims["client_id"].(string); clientID != "" && clientID != application.ID.String() {
+		return errors.NewOAuthError(errors.OAuthUnauthorizedClient, "token was not issued to this client")
+	}
+
+	jti, _ := claims["jti"].(string)
+	exp, err := claims.GetExpirationTime()
+
+	if jti == "" || err != nil || exp == nil {
+		return nil
+	}
+
+	return s.repository.RevokeAccessToken(ctx, jti, exp.Time.UTC().Add(application_utils.RefreshLeeway))
+}
@@ -0,0 +1,194 @@
+package revoke
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Repository mock
+// ---------------------------------------------------------------------------
+
+type mockRevokeRepo struct{ mock.Mock }
+
+func (m *mockRevokeRepo) GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Application), args.Error(1)
+}
+
+func (m *mockRevokeRepo) ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entities.ApplicationSecret), args.Error(1)
+}
+
+func (m *mockRevokeRepo) GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.TenantUser), args.Error(1)
+}
+
+func (m *mockRevokeRepo) GetRefreshTokenByID(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.RefreshToken), args.Error(1)
+}
+
+func (m *mockRevokeRepo) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	return m.Called(ctx, familyID).Error(0)
+}
+
+func (m *mockRevokeRepo) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	return m.Called(ctx, jti, expiresAt).Error(0)
+}
+
+// Compile-time check
+var _ IRepository = (*mockRevokeRepo)(nil)
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+func setup(t *testing.T) (*mockRevokeRepo, *entities.Application, *entities.TenantUser) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	repo := new(mockRevokeRepo)
+	application := &entities.Application{ID: uuid.New(), TenantID: uuid.New(), IsActive: true}
+	user := &entities.TenantUser{ID: uuid.New(), TenantID: application.TenantID, IsActive: true}
+
+	repo.On("GetApplicationByID", mock.Anything, application.ID).Return(application, nil)
+	repo.On("ListSecretsFromApplication", mock.Anything, application.ID).Return(&[]entities.ApplicationSecret{{Value: "secret"}}, nil)
+	repo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+
+	return repo, application, user
+}
+
+func revoke(repo *mockRevokeRepo, application *entities.Application, token string) error {
+	handler := &Handler{repository: repo}
+
+	return handler.Handler(context.Background(), Command{Token: token, ClientID: application.ID.String(), ClientSecret: "secret"})
+}
+
+// ---------------------------------------------------------------------------
+// Tests
+// ---------------------------------------------------------------------------
+
+func TestHandler_RequiresClientAuthentication(t *testing.T) {
+	repo, application, _ := setup(t)
+	handler := &Handler{repository: repo}
+
+	err := handler.Handler(context.Background(), Command{Token: "x", ClientID: application.ID.String(), ClientSecret: "wrong"})
+
+	var oauthErr *errors.OAuthError
+	require.ErrorAs(t, err, &oauthErr)
+	assert.Equal(t, errors.OAuthInvalidClient, oauthErr.Code)
+}
+
+func TestHandler_RequiresToken(t *testing.T) {
+	repo, application, _ := setup(t)
+
+	var oauthErr *errors.OAuthError
+	require.ErrorAs(t, revoke(repo, application, ""), &oauthErr)
+	assert.Equal(t, errors.OAuthInvalidRequest, oauthErr.Code)
+}
+
+func TestHandler_RevokesRefreshTokenFamily(t *testing.T) {
+	repo, application, user := setup(t)
+
+	refreshToken, _ := entities.CreateRefreshToken(user.ID, time.Now().UTC().Add(time.Hour))
+	refreshToken.ApplicationID = &application.ID
+	refreshToken.FamilyID = uuid.New()
+
+	repo.On("GetRefreshTokenByID", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+	repo.On("RevokeRefreshTokenFamily", mock.Anything, refreshToken.FamilyID).Return(nil)
+
+	require.NoError(t, revoke(repo, application, refreshToken.ID.String()))
+	repo.AssertCalled(t, "RevokeRefreshTokenFamily", mock.Anything, refreshToken.FamilyID)
+}
+
+func TestHandler_RefreshTokenOfAnotherClientIsRefused(t *testing.T) {
+	repo, application, user := setup(t)
+
+	otherApplicationID := uuid.New()
+	refreshToken, _ := entities.CreateRefreshToken(user.ID, time.Now().UTC().Add(time.Hour))
+	refreshToken.ApplicationID = &otherApplicationID
+
+	repo.On("GetRefreshTokenByID", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+
+	var oauthErr *errors.OAuthError
+	require.ErrorAs(t, revoke(repo, application, refreshToken.ID.String()), &oauthErr)
+	assert.Equal(t, errors.OAuthUnauthorizedClient, oauthErr.Code)
+	repo.AssertNotCalled(t, "RevokeRefreshTokenFamily", mock.Anything, mock.Anything)
+}
+
+func TestHandler_UnknownRefreshTokenSucceeds(t *testing.T) {
+	repo, application, _ := setup(t)
+
+	tokenID := uuid.New()
+	repo.On("GetRefreshTokenByID", mock.Anything, tokenID).Return(nil, nil)
+
+	require.NoError(t, revoke(repo, application, tokenID.String()))
+	repo.AssertNotCalled(t, "RevokeRefreshTokenFamily", mock.Anything, mock.Anything)
+}
+
+func TestHandler_AccessTokenIsDenylistedUntilRefreshLeewayEnds(t *testing.T) {
+	repo, application, user := setup(t)
+
+	token, err := application_utils.CreateToken(application_utils.JWTClaims{
+		UserID:   user.ID,
+		TenantID: user.TenantID,
+		ClientID: application.ID,
+	})
+	require.NoError(t, err)
+
+	claims, err := application_utils.ParseTokenClaims(token)
+	require.NoError(t, err)
+	exp, err := claims.GetExpirationTime()
+	require.NoError(t, err)
+
+	repo.On("RevokeAccessToken", mock.Anything, claims["jti"], exp.Time.UTC().Add(application_utils.RefreshLeeway)).Return(nil)
+
+	require.NoError(t, revoke(repo, application, token))
+	repo.AssertCalled(t, "RevokeAccessToken", mock.Anything, claims["jti"], exp.Time.UTC().Add(application_utils.RefreshLeeway))
+}
+
+func TestHandler_AccessTokenOfAnotherClientIsRefused(t *testing.T) {
+	repo, application, user := setup(t)
+
+	token, err := application_utils.CreateToken(application_utils.JWTClaims{
+		UserID:   user.ID,
+		TenantID: user.TenantID,
+		ClientID: uuid.New(),
+	})
+	require.NoError(t, err)
+
+	var oauthErr *errors.OAuthError
+	require.ErrorAs(t, revoke(repo, application, token), &oauthErr)
+	assert.Equal(t, errors.OAuthUnauthorizedClient, oauthErr.Code)
+	repo.AssertNotCalled(t, "RevokeAccessToken", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_InvalidAccessTokenSucceeds(t *testing.T) {
+	repo, application, _ := setup(t)
+
+	require.NoError(t, revoke(repo, application, "not-a-token"))
+	repo.AssertNotCalled(t, "RevokeAccessToken", mock.Anything, mock.Anything, mock.Anything)
+}
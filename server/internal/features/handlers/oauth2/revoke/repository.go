@@ -0,0 +1,38 @@
+package revoke
+
+import (
+	"context"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error)
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error)
+	GetRefreshTokenByID(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+}
+
+type Repository struct {
+	repositories.ApplicationRepository
+	repositories.SecretRepository
+	repositories.UserRepository
+	repositories.RefreshTokenRepository
+	repositories.RevokedAccessTokenRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		ApplicationRepository:        repositories.ApplicationRepository{Store: q},
+		SecretRepository:             repositories.SecretRepository{Store: q},
+		UserRepository:               repositories.UserRepository{Store: q},
+		RefreshTokenRepository:       repositories.RefreshTokenRepository{Store: q},
+		RevokedAccessTokenRepository: repositories.RevokedAccessTokenRepository{Store: q},
+	}
+}
@@ -0,0 +1,17 @@
+package token
+
+// Command is the RFC 6749 §4.1.3 / §6 / §4.4.2 token request. ClientID and
+// ClientSecret are filled from either the Authorization header
+// (client_secret_basic) or the form body (client_secret_post).
+type Command struct {
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	Scope        string
+	ClientID     string
+	ClientSecret string
+	IPAddress    string // injected server-side
+	UserAgent    string // injected server-side
+}
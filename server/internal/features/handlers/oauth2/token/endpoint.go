@@ -0,0 +1,65 @@
+package token
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/clientip"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	command, err := parseCommand(request)
+
+	if err != nil {
+		panic(err)
+	}
+
+	params := repositories.ParamsRs[Command, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: command,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if response.failure != nil {
+		panic(response.failure)
+	}
+
+	// RFC 6749 §5.1: token responses must never be cached.
+	writter.Header().Set("Cache-Control", "no-store")
+	writter.Header().Set("Pragma", "no-cache")
+
+	http_router.SendJson(writter, response, http.StatusOK)
+}
+
+// parseCommand reads the form-encoded token request (RFC 6749 §4.1.3).
+func parseCommand(request *http.Request) (Command, error) {
+	form, credentials, err := http_router.ParseOAuthForm(request)
+	if err != nil {
+		return Command{}, err
+	}
+
+	return Command{
+		GrantType:    form.Get("grant_type"),
+		Code:         form.Get("code"),
+		RedirectURI:  form.Get("redirect_uri"),
+		CodeVerifier: form.Get("code_verifier"),
+		RefreshToken: form.Get("refresh_token"),
+		Scope:        form.Get("scope"),
+		ClientID:     credentials.ClientID,
+		ClientSecret: credentials.ClientSecret,
+		IPAddress:    clientip.RealIP(request),
+		UserAgent:    request.UserAgent(),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package token
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	signincredential "github.com/gate-keeper/internal/features/handlers/authentication/sign-in-credential"
+	"github.com/google/uuid"
+)
+
+// handleAuthorizationCodeGrant redeems an authorization code (RFC 6749 §4.1.3)
+// through the same flow used by /v1/auth/sign-in.
+func handleAuthorizationCodeGrant(ctx context.Context, handler *Handler, command Command, application *entities.Application) (*Response, error) {
+	if command.Code == "" || command.RedirectURI == "" {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "code and redirect_uri are required")
+	}
+
+	code, err := uuid.Parse(command.Code)
+	if err != nil {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidGrant, "Authorization code is invalid")
+	}
+
+	response, err := handler.authorizationCode.Handler(ctx, signincredential.Command{
+		AuthorizationCode: code,
+		ClientID:          application.ID,
+		ClientSecret:      command.ClientSecret,
+		CodeVerifier:      command.CodeVerifier,
+		RedirectURI:       command.RedirectURI,
+	})
+
+	if err != nil {
+		return nil, toOAuthError(err)
+	}
+
+	return &Response{
+		AccessToken:  response.AccessToken,
+		TokenType:    response.TokenType,
+		ExpiresIn:    response.ExpiresIn,
+		RefreshToken: response.RefreshToken.String(),
+		IDToken:      response.IDToken,
+		Scope:        response.Scope,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package token
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+)
+
+// handleClientCredentialsGrant issues an access token to the application
+// itself (RFC 6749 §4.4). No refresh or ID token is returned since there is
+// no end user.
+func handleClientCredentialsGrant(ctx context.Context, handler *Handler, command Command, application *entities.Application) (*Response, error) {
+	credentials, err := handler.repository.GetApplicationClientCredentials(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials == nil {
+		return nil, errors.NewOAuthError(errors.OAuthUnauthorizedClient, "The client is not allowed to use the client_credentials grant")
+	}
+
+	scope, ok := narrowScope(strings.Join(credentials.Scopes, " "), command.Scope)
+	if !ok {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidScope, "Requested scope was not granted to the client")
+	}
+
+	roles := make([]string, 0, len(credentials.Roles))
+	for _, role := range credentials.Roles {
+		if !slices.Contains(roles, role.Name) {
+			roles = append(roles, role.Name)
+		}
+	}
+
+	permissions, err := handler.repository.GetApplicationClientPermissions(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := application_utils.CreateClientToken(application_utils.ClientClaims{
+		ApplicationID: application.ID,
+		TenantID:      application.TenantID,
+		Scope:         scope,
+		Roles:         roles,
+		Permissions:   permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// There is no end user, the application itself is the subject
+	details := "grant_type: client_credentials, scope: " + scope
+	auditLog := entities.NewTenantAuditLog(application.TenantID, nil, &application.ID,
+		constants.AuditEventTokenIssued, command.IPAddress, command.UserAgent, "success", &details)
+
+	if err := handler.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   900, // 15 minutes in seconds
+		Scope:       scope,
+	}, nil
+}
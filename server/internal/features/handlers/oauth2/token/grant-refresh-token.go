@@ -0,0 +1,216 @@
+package token
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/google/uuid"
+)
+
+// handleRefreshTokenGrant exchanges a refresh token for a new token pair
+// (RFC 6749 §6) and rotates it. Presenting a token that was already rotated
+// means it leaked: the whole family and its session are revoked (OAuth 2.0
+// Security BCP §4.14.2).
+func handleRefreshTokenGrant(ctx context.Context, handler *Handler, command Command, application *entities.Application) (*Response, error) {
+	if command.RefreshToken == "" {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "refresh_token is required")
+	}
+
+	refreshTokenID, err := uuid.Parse(command.RefreshToken)
+	if err != nil {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidGrant, "Refresh token is invalid")
+	}
+
+	refreshToken, err := handler.repository.GetRefreshTokenByIDForUpdate(ctx, refreshTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if refreshToken == nil || refreshToken.ApplicationID == nil || *refreshToken.ApplicationID != application.ID {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidGrant, "Refresh token is invalid")
+	}
+
+	if refreshToken.IsRevoked() {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidGrant, "Refresh token was revoked")
+	}
+
+	if refreshToken.IsUsed() {
+		if err := revokeRefreshTokenFamily(ctx, handler, refreshToken); err != nil {
+			return nil, err
+		}
+
+		details := "family_id: " + refreshToken.FamilyID.String()
+		auditLog := entities.NewAuditLog(refreshToken.UserID, application.ID,
+			constants.AuditEventRefreshTokenReused, command.IPAddress, command.UserAgent, "failure", &details)
+
+		if err := handler.repository.AddAuditLog(ctx, auditLog); err != nil {
+			return nil, err
+		}
+
+		// The revocation must survive, so the failure is reported after commit.
+		return &Response{failure: errors.NewOAuthError(errors.OAuthInvalidGrant, "Refresh token was already used")}, nil
+	}
+
+	if refreshToken.IsExpired() {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidGrant, "Refresh token expired")
+	}
+
+	grantedScope := "openid profile email"
+	if refreshToken.Scope != nil && *refreshToken.Scope != "" {
+		grantedScope = *refreshToken.Scope
+	}
+
+	scope, ok := narrowScope(grantedScope, command.Scope)
+	if !ok {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidScope, "Requested scope exceeds the scope originally granted")
+	}
+
+	if refreshToken.SessionID != nil {
+		session, err := handler.repository.GetUserSessionByID(ctx, *refreshToken.SessionID, refreshToken.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if session == nil || !session.IsActive() {
+			return nil, errors.NewOAuthError(errors.OAuthInvalidGrant, "Session is no longer active")
+		}
+
+		if err := handler.repository.UpdateUserSessionLastActive(ctx, session.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := handler.repository.GetUserByID(ctx, refreshToken.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil || !user.IsActive {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidGrant, "User is not active")
+	}
+
+	userProfile, err := handler.repository.GetUserProfileByID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userProfile == nil {
+		return nil, &errors.ErrUserProfileNotFound
+	}
+
+	expiresAt := time.Now().UTC().Add(time.Hour * 24 * time.Duration(application.RefreshTokenTTLDays))
+
+	nextRefreshToken, err := refreshToken.Rotate(expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.repository.MarkRefreshTokenUsed(ctx, refreshToken); err != nil {
+		return nil, err
+	}
+
+	if _, err := handler.repository.AddRefreshToken(ctx, nextRefreshToken); err != nil {
+		return nil, err
+	}
+
+	roles, err := application_utils.UserRoleNames(ctx, handler.repository, user.ID, application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := application_utils.UserPermissionNames(ctx, handler.repository, user.ID, application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	jwtClaims := application_utils.JWTClaims{
+		UserID:      user.ID,
+		Email:       user.Email,
+		FirstName:   userProfile.FirstName,
+		LastName:    userProfile.LastName,
+		DisplayName: userProfile.DisplayName,
+		TenantID:    user.TenantID,
+		ClientID:    application.ID,
+		Scope:       scope,
+		Roles:       roles,
+		Permissions: permissions,
+	}
+
+	if refreshToken.SessionID != nil {
+		jwtClaims.SessionID = *refreshToken.SessionID
+	}
+
+	accessToken, err := application_utils.CreateToken(jwtClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	var idToken string
+	if slices.Contains(strings.Fields(scope), "openid") {
+		idToken, err = application_utils.CreateIDToken(jwtClaims, nil, application.ID.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	details := "grant_type: refresh_token, scope: " + scope
+	auditLog := entities.NewAuditLog(user.ID, application.ID,
+		constants.AuditEventTokenIssued, command.IPAddress, command.UserAgent, "success", &details)
+
+	if err := handler.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    900, // 15 minutes in seconds
+		RefreshToken: nextRefreshToken.ID.String(),
+		IDToken:      idToken,
+		Scope:        scope,
+	}, nil
+}
+
+func revokeRefreshTokenFamily(ctx context.Context, handler *Handler, refreshToken *entities.RefreshToken) error {
+	slog.WarnContext(ctx, "Refresh token reuse detected, revoking token family",
+		"family_id", refreshToken.FamilyID,
+		"user_id", refreshToken.UserID,
+	)
+
+	if err := handler.repository.RevokeRefreshTokenFamily(ctx, refreshToken.FamilyID); err != nil {
+		return err
+	}
+
+	if refreshToken.SessionID != nil {
+		return handler.repository.RevokeUserSessionByID(ctx, *refreshToken.SessionID, refreshToken.UserID)
+	}
+
+	return nil
+}
+
+// narrowScope returns the scope for the new tokens. An empty request keeps the
+// granted scope; otherwise every requested value must have been granted
+// (RFC 6749 §6).
+func narrowScope(granted, requested string) (string, bool) {
+	if requested == "" {
+		return granted, true
+	}
+
+	grantedScopes := strings.Fields(granted)
+	requestedScopes := strings.Fields(requested)
+
+	for _, scope := range requestedScopes {
+		if !slices.Contains(grantedScopes, scope) {
+			return "", false
+		}
+	}
+
+	return strings.Join(requestedScopes, " "), true
+}
@@ -0,0 +1,78 @@
+package token
+
+import (
+	"context"
+	goerrors "errors"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	signincredential "github.com/gate-keeper/internal/features/handlers/authentication/sign-in-credential"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type grantHandler func(ctx context.Context, handler *Handler, command Command, application *entities.Application) (*Response, error)
+
+// grants maps each supported grant_type to its handler.
+var grants = map[string]grantHandler{
+	"authorization_code": handleAuthorizationCodeGrant,
+	"refresh_token":      handleRefreshTokenGrant,
+	"client_credentials": handleClientCredentialsGrant,
+}
+
+type Handler struct {
+	repository        IRepository
+	authorizationCode repositories.ServiceHandlerRs[signincredential.Command, *signincredential.Response]
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository:        NewRepository(q),
+		authorizationCode: signincredential.New(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, command Command) (*Response, error) {
+	if command.GrantType == "" {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidRequest, "grant_type is required")
+	}
+
+	grant, ok := grants[command.GrantType]
+	if !ok {
+		return nil, errors.NewOAuthError(errors.OAuthUnsupportedGrantType, "Unsupported grant_type: "+command.GrantType)
+	}
+
+	application, err := application_utils.AuthenticateClient(ctx, s.repository, command.ClientID, command.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return grant(ctx, s, command, application)
+}
+
+// toOAuthError translates domain errors raised while redeeming a grant into
+// their RFC 6749 §5.2 equivalent. Unknown errors are returned untouched.
+func toOAuthError(err error) error {
+	var customError *errors.CustomError
+	if !goerrors.As(err, &customError) {
+		return err
+	}
+
+	switch customError.Name {
+	case errors.ErrInvalidClientSecret.Name, errors.ErrClientSecretExpired.Name:
+		return errors.NewOAuthError(errors.OAuthInvalidClient, customError.Message)
+	case errors.ErrAuthorizationCodeNotFound.Name,
+		errors.ErrAuthorizationCodeExpired.Name,
+		errors.ErrAuthorizationCodeInvalidRedirectURI.Name,
+		errors.ErrAuthorizationCodeInvalidClientID.Name,
+		errors.ErrAuthorizationCodeInvalidPKCE.Name,
+		errors.ErrInvalidCodeChallenge.Name,
+		errors.ErrUserNotFound.Name:
+		return errors.NewOAuthError(errors.OAuthInvalidGrant, customError.Message)
+	case errors.ErrInvalidCodeChallengeMethod.Name:
+		return errors.NewOAuthError(errors.OAuthInvalidRequest, customError.Message)
+	}
+
+	return err
+}
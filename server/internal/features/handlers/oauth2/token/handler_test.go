@@ -0,0 +1,561 @@
+package token
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	signincredential "github.com/gate-keeper/internal/features/handlers/authentication/sign-in-credential"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// Mocks
+// ---------------------------------------------------------------------------
+
+type mockTokenRepo struct{ mock.Mock }
+
+func (m *mockTokenRepo) GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Application), args.Error(1)
+}
+
+func (m *mockTokenRepo) ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entities.ApplicationSecret), args.Error(1)
+}
+
+func (m *mockTokenRepo) GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.TenantUser), args.Error(1)
+}
+
+func (m *mockTokenRepo) GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserProfile), args.Error(1)
+}
+
+func (m *mockTokenRepo) GetRefreshTokenByIDForUpdate(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.RefreshToken), args.Error(1)
+}
+
+func (m *mockTokenRepo) AddRefreshToken(ctx context.Context, refreshToken *entities.RefreshToken) (*entities.RefreshToken, error) {
+	args := m.Called(ctx, refreshToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.RefreshToken), args.Error(1)
+}
+
+func (m *mockTokenRepo) MarkRefreshTokenUsed(ctx context.Context, refreshToken *entities.RefreshToken) error {
+	return m.Called(ctx, refreshToken).Error(0)
+}
+
+func (m *mockTokenRepo) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	return m.Called(ctx, familyID).Error(0)
+}
+
+func (m *mockTokenRepo) GetUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) (*entities.UserSession, error) {
+	args := m.Called(ctx, sessionID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserSession), args.Error(1)
+}
+
+func (m *mockTokenRepo) RevokeUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) error {
+	return m.Called(ctx, sessionID, userID).Error(0)
+}
+
+func (m *mockTokenRepo) UpdateUserSessionLastActive(ctx context.Context, sessionID uuid.UUID) error {
+	return m.Called(ctx, sessionID).Error(0)
+}
+
+func (m *mockTokenRepo) GetApplicationClientCredentials(ctx context.Context, applicationID uuid.UUID) (*entities.ApplicationClientCredentials, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ApplicationClientCredentials), args.Error(1)
+}
+
+func (m *mockTokenRepo) GetUserRolesFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]entities.ApplicationRole, error) {
+	args := m.Called(ctx, userID, applicationID)
+	return args.Get(0).([]entities.ApplicationRole), args.Error(1)
+}
+
+func (m *mockTokenRepo) GetUserPermissionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, userID, applicationID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockTokenRepo) GetApplicationClientPermissions(ctx context.Context, applicationID uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, applicationID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockTokenRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
+type mockAuthorizationCodeGrant struct{ mock.Mock }
+
+func (m *mockAuthorizationCodeGrant) Handler(ctx context.Context, command signincredential.Command) (*signincredential.Response, error) {
+	args := m.Called(ctx, command)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*signincredential.Response), args.Error(1)
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+func newTestHandler(repo *mockTokenRepo, grant *mockAuthorizationCodeGrant) *Handler {
+	return &Handler{repository: repo, authorizationCode: grant}
+}
+
+func registeredClient(repo *mockTokenRepo, secrets []entities.ApplicationSecret) *entities.Application {
+	application := &entities.Application{ID: uuid.New(), TenantID: uuid.New(), Name: "Client", IsActive: true}
+	repo.On("GetApplicationByID", mock.Anything, application.ID).Return(application, nil)
+	repo.On("ListSecretsFromApplication", mock.Anything, application.ID).Return(&secrets, nil)
+	return application
+}
+
+func assertOAuthError(t *testing.T, err error, code string) {
+	t.Helper()
+	var oauthErr *errors.OAuthError
+	require.ErrorAs(t, err, &oauthErr)
+	assert.Equal(t, code, oauthErr.Code)
+}
+
+// ---------------------------------------------------------------------------
+// Handler
+// ---------------------------------------------------------------------------
+
+func TestHandler_MissingGrantType(t *testing.T) {
+	handler := newTestHandler(new(mockTokenRepo), new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), Command{})
+	assertOAuthError(t, err, errors.OAuthInvalidRequest)
+}
+
+func TestHandler_UnsupportedGrantType(t *testing.T) {
+	handler := newTestHandler(new(mockTokenRepo), new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), Command{GrantType: "password"})
+	assertOAuthError(t, err, errors.OAuthUnsupportedGrantType)
+}
+
+func TestHandler_UnknownClient(t *testing.T) {
+	repo := new(mockTokenRepo)
+	clientID := uuid.New()
+	repo.On("GetApplicationByID", mock.Anything, clientID).Return(nil, nil)
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), Command{GrantType: "authorization_code", ClientID: clientID.String(), ClientSecret: "s"})
+	assertOAuthError(t, err, errors.OAuthInvalidClient)
+}
+
+func TestHandler_WrongClientSecret(t *testing.T) {
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "right"}})
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), Command{GrantType: "authorization_code", ClientID: application.ID.String(), ClientSecret: "wrong"})
+	assertOAuthError(t, err, errors.OAuthInvalidClient)
+}
+
+func TestHandler_ExpiredClientSecret(t *testing.T) {
+	expired := time.Now().UTC().Add(-time.Hour)
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret", ExpiresAt: &expired}})
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), Command{GrantType: "authorization_code", ClientID: application.ID.String(), ClientSecret: "secret"})
+	assertOAuthError(t, err, errors.OAuthInvalidClient)
+}
+
+func TestHandler_InactiveClient(t *testing.T) {
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	application.IsActive = false
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), Command{GrantType: "client_credentials", ClientID: application.ID.String(), ClientSecret: "secret"})
+	assertOAuthError(t, err, errors.OAuthInvalidClient)
+}
+
+func TestHandler_AuthorizationCodeGrant(t *testing.T) {
+	repo := new(mockTokenRepo)
+	grant := new(mockAuthorizationCodeGrant)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	code := uuid.New()
+	refreshToken := uuid.New()
+
+	grant.On("Handler", mock.Anything, signincredential.Command{
+		AuthorizationCode: code,
+		ClientID:          application.ID,
+		ClientSecret:      "secret",
+		CodeVerifier:      "verifier",
+		RedirectURI:       "https://app.example.com/callback",
+	}).Return(&signincredential.Response{
+		AccessToken:  "access",
+		IDToken:      "id",
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    900,
+		Scope:        "openid",
+	}, nil)
+
+	handler := newTestHandler(repo, grant)
+
+	response, err := handler.Handler(context.Background(), Command{
+		GrantType:    "authorization_code",
+		Code:         code.String(),
+		RedirectURI:  "https://app.example.com/callback",
+		CodeVerifier: "verifier",
+		ClientID:     application.ID.String(),
+		ClientSecret: "secret",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "access", response.AccessToken)
+	assert.Equal(t, "id", response.IDToken)
+	assert.Equal(t, refreshToken.String(), response.RefreshToken)
+	assert.Equal(t, "Bearer", response.TokenType)
+	assert.Equal(t, 900, response.ExpiresIn)
+	grant.AssertExpectations(t)
+}
+
+func TestHandler_AuthorizationCodeGrant_MapsDomainErrors(t *testing.T) {
+	repo := new(mockTokenRepo)
+	grant := new(mockAuthorizationCodeGrant)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	grant.On("Handler", mock.Anything, mock.Anything).Return(nil, &errors.ErrAuthorizationCodeExpired)
+
+	handler := newTestHandler(repo, grant)
+
+	_, err := handler.Handler(context.Background(), Command{
+		GrantType:    "authorization_code",
+		Code:         uuid.NewString(),
+		RedirectURI:  "https://app.example.com/callback",
+		ClientID:     application.ID.String(),
+		ClientSecret: "secret",
+	})
+	assertOAuthError(t, err, errors.OAuthInvalidGrant)
+}
+
+func TestHandler_AuthorizationCodeGrant_MalformedCode(t *testing.T) {
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), Command{
+		GrantType:    "authorization_code",
+		Code:         "not-a-code",
+		RedirectURI:  "https://app.example.com/callback",
+		ClientID:     application.ID.String(),
+		ClientSecret: "secret",
+	})
+	assertOAuthError(t, err, errors.OAuthInvalidGrant)
+}
+
+// ---------------------------------------------------------------------------
+// refresh_token grant
+// ---------------------------------------------------------------------------
+
+func issuedRefreshToken(t *testing.T, application *entities.Application, user *entities.TenantUser, scope string) *entities.RefreshToken {
+	t.Helper()
+	refreshToken, err := entities.CreateRefreshToken(user.ID, time.Now().UTC().Add(24*time.Hour))
+	require.NoError(t, err)
+	refreshToken.ApplicationID = &application.ID
+	refreshToken.Scope = &scope
+	return refreshToken
+}
+
+func refreshCommand(application *entities.Application, refreshToken *entities.RefreshToken) Command {
+	return Command{
+		GrantType:    "refresh_token",
+		RefreshToken: refreshToken.ID.String(),
+		ClientID:     application.ID.String(),
+		ClientSecret: "secret",
+	}
+}
+
+func TestHandler_RefreshTokenGrant_RotatesToken(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	application.RefreshTokenTTLDays = 30
+	user := &entities.TenantUser{ID: uuid.New(), TenantID: uuid.New(), Email: "user@example.com", IsActive: true}
+	refreshToken := issuedRefreshToken(t, application, user, "openid profile")
+
+	repo.On("GetRefreshTokenByIDForUpdate", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+	repo.On("GetUserByID", mock.Anything, user.ID).Return(user, nil)
+	repo.On("GetUserProfileByID", mock.Anything, user.ID).Return(&entities.UserProfile{UserID: user.ID}, nil)
+	repo.On("GetUserRolesFromApplication", mock.Anything, user.ID, application.ID).Return([]entities.ApplicationRole{{Name: "admin"}}, nil)
+	repo.On("GetUserPermissionsFromApplication", mock.Anything, user.ID, application.ID).Return([]string{"users:delete"}, nil)
+	repo.On("MarkRefreshTokenUsed", mock.Anything, refreshToken).Return(nil)
+	repo.On("AddRefreshToken", mock.Anything, mock.MatchedBy(func(next *entities.RefreshToken) bool {
+		return next.FamilyID == refreshToken.FamilyID && next.ID != refreshToken.ID
+	})).Return(&entities.RefreshToken{}, nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventTokenIssued && *auditLog.UserID == user.ID &&
+			*auditLog.Details == "grant_type: refresh_token, scope: openid profile"
+	})).Return(nil)
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	response, err := handler.Handler(context.Background(), refreshCommand(application, refreshToken))
+
+	require.NoError(t, err)
+	require.Nil(t, response.failure)
+	assert.NotEmpty(t, response.AccessToken)
+	assert.NotEmpty(t, response.IDToken)
+	assert.NotEqual(t, refreshToken.ID.String(), response.RefreshToken)
+	assert.Equal(t, "openid profile", response.Scope)
+	assert.True(t, refreshToken.IsUsed())
+	repo.AssertExpectations(t)
+
+	for _, token := range []string{response.AccessToken, response.IDToken} {
+		claims := jwt.MapClaims{}
+		_, _, err = jwt.NewParser().ParseUnverified(token, claims)
+		require.NoError(t, err)
+		assert.Equal(t, []any{"admin"}, claims["roles"])
+	}
+
+	accessClaims := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(response.AccessToken, accessClaims)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"users:delete"}, accessClaims["permissions"])
+}
+
+func TestHandler_RefreshTokenGrant_ReuseRevokesFamilyAndSession(t *testing.T) {
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	user := &entities.TenantUser{ID: uuid.New(), IsActive: true}
+	refreshToken := issuedRefreshToken(t, application, user, "openid")
+	sessionID := uuid.New()
+	usedAt := time.Now().UTC().Add(-time.Minute)
+	refreshToken.SessionID = &sessionID
+	refreshToken.UsedAt = &usedAt
+
+	repo.On("GetRefreshTokenByIDForUpdate", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+	repo.On("RevokeRefreshTokenFamily", mock.Anything, refreshToken.FamilyID).Return(nil)
+	repo.On("RevokeUserSessionByID", mock.Anything, sessionID, user.ID).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventRefreshTokenReused && auditLog.Result == "failure"
+	})).Return(nil)
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	// The handler must not fail, otherwise the revocation would be rolled back.
+	response, err := handler.Handler(context.Background(), refreshCommand(application, refreshToken))
+
+	require.NoError(t, err)
+	assertOAuthError(t, response.failure, errors.OAuthInvalidGrant)
+	assert.Empty(t, response.AccessToken)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "AddRefreshToken", mock.Anything, mock.Anything)
+}
+
+func TestHandler_RefreshTokenGrant_RejectsOtherClient(t *testing.T) {
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	other := &entities.Application{ID: uuid.New()}
+	refreshToken := issuedRefreshToken(t, other, &entities.TenantUser{ID: uuid.New()}, "openid")
+
+	repo.On("GetRefreshTokenByIDForUpdate", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), refreshCommand(application, refreshToken))
+	assertOAuthError(t, err, errors.OAuthInvalidGrant)
+}
+
+func TestHandler_RefreshTokenGrant_RejectsRevokedToken(t *testing.T) {
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	refreshToken := issuedRefreshToken(t, application, &entities.TenantUser{ID: uuid.New()}, "openid")
+	revokedAt := time.Now().UTC()
+	refreshToken.RevokedAt = &revokedAt
+
+	repo.On("GetRefreshTokenByIDForUpdate", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), refreshCommand(application, refreshToken))
+	assertOAuthError(t, err, errors.OAuthInvalidGrant)
+}
+
+func TestHandler_RefreshTokenGrant_RejectsWiderScope(t *testing.T) {
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	refreshToken := issuedRefreshToken(t, application, &entities.TenantUser{ID: uuid.New()}, "openid")
+
+	repo.On("GetRefreshTokenByIDForUpdate", mock.Anything, refreshToken.ID).Return(refreshToken, nil)
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	command := refreshCommand(application, refreshToken)
+	command.Scope = "openid email"
+
+	_, err := handler.Handler(context.Background(), command)
+	assertOAuthError(t, err, errors.OAuthInvalidScope)
+}
+
+// ---------------------------------------------------------------------------
+// client_credentials grant
+// ---------------------------------------------------------------------------
+
+func clientCredentialsCommand(application *entities.Application, scope string) Command {
+	return Command{
+		GrantType:    "client_credentials",
+		Scope:        scope,
+		ClientID:     application.ID.String(),
+		ClientSecret: "secret",
+	}
+}
+
+func TestHandler_ClientCredentialsGrant(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	repo.On("GetApplicationClientCredentials", mock.Anything, application.ID).Return(&entities.ApplicationClientCredentials{
+		ApplicationID: application.ID,
+		Scopes:        []string{"orders:read", "orders:write"},
+		Roles:         []entities.ApplicationRole{{ID: uuid.New(), Name: "billing"}},
+	}, nil)
+	repo.On("GetApplicationClientPermissions", mock.Anything, application.ID).Return([]string{"invoices:read"}, nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventTokenIssued && auditLog.UserID == nil &&
+			auditLog.TenantID == application.TenantID
+	})).Return(nil)
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	response, err := handler.Handler(context.Background(), clientCredentialsCommand(application, "orders:read"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "orders:read", response.Scope)
+	assert.Empty(t, response.RefreshToken)
+	assert.Empty(t, response.IDToken)
+
+	claims := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(response.AccessToken, claims)
+	require.NoError(t, err)
+	assert.Equal(t, application.ID.String(), claims["sub"])
+	assert.Equal(t, application.ID.String(), claims["client_id"])
+	assert.Equal(t, application.TenantID.String(), claims["org_id"])
+	assert.Equal(t, []any{"billing"}, claims["roles"])
+	assert.Equal(t, []any{"invoices:read"}, claims["permissions"])
+}
+
+func TestHandler_ClientCredentialsGrant_NotEnabled(t *testing.T) {
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	repo.On("GetApplicationClientCredentials", mock.Anything, application.ID).Return(nil, nil)
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), clientCredentialsCommand(application, ""))
+	assertOAuthError(t, err, errors.OAuthUnauthorizedClient)
+}
+
+func TestHandler_ClientCredentialsGrant_RejectsUngrantedScope(t *testing.T) {
+	repo := new(mockTokenRepo)
+	application := registeredClient(repo, []entities.ApplicationSecret{{Value: "secret"}})
+	repo.On("GetApplicationClientCredentials", mock.Anything, application.ID).Return(&entities.ApplicationClientCredentials{
+		ApplicationID: application.ID,
+		Scopes:        []string{"orders:read"},
+	}, nil)
+
+	handler := newTestHandler(repo, new(mockAuthorizationCodeGrant))
+
+	_, err := handler.Handler(context.Background(), clientCredentialsCommand(application, "openid"))
+	assertOAuthError(t, err, errors.OAuthInvalidScope)
+}
+
+// ---------------------------------------------------------------------------
+// Request parsing
+// ---------------------------------------------------------------------------
+
+func newTokenRequest(form url.Values) *http.Request {
+	request := httptest.NewRequest(http.MethodPost, "/oauth2/token", strings.NewReader(form.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return request
+}
+
+func TestParseCommand_ClientSecretBasic(t *testing.T) {
+	request := newTokenRequest(url.Values{"grant_type": {"authorization_code"}, "code": {"abc"}})
+	request.SetBasicAuth(url.QueryEscape("client id"), url.QueryEscape("s3cr3t:+/"))
+
+	command, err := parseCommand(request)
+
+	require.NoError(t, err)
+	assert.Equal(t, "authorization_code", command.GrantType)
+	assert.Equal(t, "client id", command.ClientID)
+	assert.Equal(t, "s3cr3t:+/", command.ClientSecret)
+}
+
+func TestParseCommand_ClientSecretPost(t *testing.T) {
+	request := newTokenRequest(url.Values{"grant_type": {"authorization_code"}, "client_id": {"id"}, "client_secret": {"secret"}})
+
+	command, err := parseCommand(request)
+
+	require.NoError(t, err)
+	assert.Equal(t, "id", command.ClientID)
+	assert.Equal(t, "secret", command.ClientSecret)
+}
+
+func TestParseCommand_RejectsMultipleAuthMethods(t *testing.T) {
+	request := newTokenRequest(url.Values{"grant_type": {"authorization_code"}, "client_secret": {"secret"}})
+	request.SetBasicAuth("id", "secret")
+
+	_, err := parseCommand(request)
+	assertOAuthError(t, err, errors.OAuthInvalidRequest)
+}
+
+func TestParseCommand_RejectsJSON(t *testing.T) {
+	request := httptest.NewRequest(http.MethodPost, "/oauth2/token", strings.NewReader(`{"grant_type":"authorization_code"}`))
+	request.Header.Set("Content-Type", "application/json")
+
+	_, err := parseCommand(request)
+	assertOAuthError(t, err, errors.OAuthInvalidRequest)
+}
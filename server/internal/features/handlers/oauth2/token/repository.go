@@ -0,0 +1,57 @@
+package token
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error)
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error)
+	GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error)
+	GetRefreshTokenByIDForUpdate(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error)
+	AddRefreshToken(ctx context.Context, refreshToken *entities.RefreshToken) (*entities.RefreshToken, error)
+	MarkRefreshTokenUsed(ctx context.Context, refreshToken *entities.RefreshToken) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+	GetUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) (*entities.UserSession, error)
+	RevokeUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) error
+	UpdateUserSessionLastActive(ctx context.Context, sessionID uuid.UUID) error
+	GetApplicationClientCredentials(ctx context.Context, applicationID uuid.UUID) (*entities.ApplicationClientCredentials, error)
+	GetUserRolesFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]entities.ApplicationRole, error)
+	GetUserPermissionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]string, error)
+	GetApplicationClientPermissions(ctx context.Context, applicationID uuid.UUID) ([]string, error)
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
+}
+
+type Repository struct {
+	repositories.ApplicationRepository
+	repositories.SecretRepository
+	repositories.UserRepository
+	repositories.UserProfileRepository
+	repositories.RefreshTokenRepository
+	repositories.UserSessionRepository
+	repositories.ClientCredentialsRepository
+	repositories.RoleRepository
+	repositories.PermissionRepository
+	repositories.AuditLogRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		ApplicationRepository:       repositories.ApplicationRepository{Store: q},
+		SecretRepository:            repositories.SecretRepository{Store: q},
+		UserRepository:              repositories.UserRepository{Store: q},
+		UserProfileRepository:       repositories.UserProfileRepository{Store: q},
+		RefreshTokenRepository:      repositories.RefreshTokenRepository{Store: q},
+		UserSessionRepository:       repositories.UserSessionRepository{Store: q},
+		ClientCredentialsRepository: repositories.ClientCredentialsRepository{Store: q},
+		RoleRepository:              repositories.RoleRepository{Store: q},
+		PermissionRepository:        repositories.PermissionRepository{Store: q},
+		AuditLogRepository:          repositories.AuditLogRepository{Store: q},
+	}
+}
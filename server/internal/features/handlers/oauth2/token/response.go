@@ -0,0 +1,15 @@
+package token
+
+// Response is the RFC 6749 §5.1 access token response.
+type Response struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+
+	// failure is an error reported only after the transaction commits, for
+	// grants whose side effects must persist even though the request fails.
+	failure error
+}
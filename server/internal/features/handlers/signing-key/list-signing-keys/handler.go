@@ -0,0 +1,51 @@
+package listsigningkeys
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
+	tenant, err := s.repository.GetTenantByID(ctx, query.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil || tenant.ID == uuid.Nil {
+		return nil, &errors.ErrTenantNotFound
+	}
+
+	keys, err := s.repository.ListSigningKeysByTenant(ctx, query.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]SigningKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		data = append(data, SigningKeyResponse{
+			ID:          key.ID,
+			KeyID:       key.KeyID,
+			Algorithm:   key.Algorithm,
+			Status:      key.Status,
+			CreatedAt:   key.CreatedAt,
+			ActivatedAt: key.ActivatedAt,
+			RetiredAt:   key.RetiredAt,
+			ExpiresAt:   key.ExpiresAt,
+		})
+	}
+
+	return &Response{Data: data}, nil
+}
@@ -0,0 +1,7 @@
+package listsigningkeys
+
+import "github.com/google/uuid"
+
+type Query struct {
+	TenantID uuid.UUID
+}
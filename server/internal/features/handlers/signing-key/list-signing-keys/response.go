@@ -0,0 +1,23 @@
+package listsigningkeys
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Response struct {
+	Data []SigningKeyResponse `json:"data"`
+}
+
+// SigningKeyResponse never exposes the private key material.
+type SigningKeyResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	KeyID       string     `json:"kid"`
+	Algorithm   string     `json:"algorithm"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	ActivatedAt *time.Time `json:"activatedAt"`
+	RetiredAt   *time.Time `json:"retiredAt"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+}
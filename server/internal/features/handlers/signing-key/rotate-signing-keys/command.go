@@ -0,0 +1,10 @@
+package rotatesigningkeys
+
+import "github.com/google/uuid"
+
+type Command struct {
+	TenantID uuid.UUID `json:"-"`
+	// RevokePrevious withdraws the outgoing key from JWKS immediately, which
+	// invalidates every token it signed. Use it when the key is compromised.
+	RevokePrevious bool `json:"revokePrevious"`
+}
@@ -0,0 +1,56 @@
+package rotatesigningkeys
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	"github.com/gate-keeper/internal/infra/signing"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	tenantIDString := chi.URLParam(request, "tenantID")
+	tenantIdUUID, err := uuid.Parse(tenantIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	var command Command
+
+	// The body is optional: an empty POST performs a graceful rotation.
+	if request.ContentLength != 0 {
+		if err := http_router.ParseBodyToSchema(&command, request); err != nil {
+			panic(err)
+		}
+	}
+
+	command.TenantID = tenantIdUUID
+
+	params := repositories.ParamsRs[Command, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: command,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// Pick the new key up on this node right away; peers reload on their next tick.
+	if err := signing.Reload(request.Context()); err != nil {
+		slog.ErrorContext(request.Context(), "Failed to reload signing keys", "error", err)
+	}
+
+	http_router.SendJson(writter, response, http.StatusCreated)
+}
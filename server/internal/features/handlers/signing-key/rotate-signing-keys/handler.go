@@ -0,0 +1,52 @@
+package rotatesigningkeys
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/gate-keeper/internal/infra/signing"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	repository IRepository
+	policy     signing.RotationPolicy
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+		policy:     signing.PolicyFromEnv(),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, command Command) (*Response, error) {
+	if !s.policy.Enabled {
+		return nil, &errors.ErrSigningKeyRotationDisabled
+	}
+
+	tenant, err := s.repository.GetTenantByID(ctx, command.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil || tenant.ID == uuid.Nil {
+		return nil, &errors.ErrTenantNotFound
+	}
+
+	active, err := signing.RotateTenantKeys(ctx, s.repository, command.TenantID, s.policy, signing.RotateOptions{
+		Force:          true,
+		RevokePrevious: command.RevokePrevious,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		ID:          active.ID,
+		KeyID:       active.KeyID,
+		Algorithm:   active.Algorithm,
+		ActivatedAt: active.ActivatedAt,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package rotatesigningkeys
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error)
+	AddSigningKey(ctx context.Context, key *entities.SigningKey) error
+	UpdateSigningKeyStatus(ctx context.Context, key *entities.SigningKey) error
+	ListSigningKeysByTenant(ctx context.Context, tenantID uuid.UUID) ([]*entities.SigningKey, error)
+}
+
+type Repository struct {
+	repositories.TenantRepository
+	repositories.SigningKeyRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		TenantRepository:     repositories.TenantRepository{Store: q},
+		SigningKeyRepository: repositories.SigningKeyRepository{Store: q},
+	}
+}
@@ -0,0 +1,14 @@
+package rotatesigningkeys
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Response struct {
+	ID          uuid.UUID  `json:"id"`
+	KeyID       string     `json:"kid"`
+	Algorithm   string     `json:"algorithm"`
+	ActivatedAt *time.Time `json:"activatedAt"`
+}
@@ -0,0 +1,12 @@
+package addtenantadmin
+
+import "github.com/google/uuid"
+
+type Command struct {
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+}
+
+type RequestBody struct {
+	UserID uuid.UUID `json:"userId" validate:"required"`
+}
@@ -0,0 +1,54 @@
+package addtenantadmin
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, request Command) (*Response, error) {
+	tenant, err := s.repository.GetTenantByID(ctx, request.TenantID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if tenant == nil {
+		return nil, &errors.ErrTenantNotFound
+	}
+
+	user, err := s.repository.GetUserByID(ctx, request.UserID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, &errors.ErrUserNotFound
+	}
+
+	admin := entities.NewTenantAdmin(tenant.ID, user.ID)
+
+	if err := s.repository.AddTenantAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		UserID:    admin.UserID,
+		Email:     user.Email,
+		CreatedAt: admin.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package addtenantadmin
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error)
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error)
+	AddTenantAdmin(ctx context.Context, admin *entities.TenantAdmin) error
+}
+
+type Repository struct {
+	repositories.TenantRepository
+	repositories.UserRepository
+	repositories.AdminRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		TenantRepository: repositories.TenantRepository{Store: q},
+		UserRepository:   repositories.UserRepository{Store: q},
+		AdminRepository:  repositories.AdminRepository{Store: q},
+	}
+}
@@ -0,0 +1,22 @@
+package listtenantadmins
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *[]Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, query Query) (*[]Response, error) {
+	return s.repository.ListTenantAdmins(ctx, query.TenantID)
+}
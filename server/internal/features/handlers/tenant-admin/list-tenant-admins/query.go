@@ -0,0 +1,7 @@
+package listtenantadmins
+
+import "github.com/google/uuid"
+
+type Query struct {
+	TenantID uuid.UUID `json:"tenantId" validate:"required,uuid"`
+}
@@ -0,0 +1,43 @@
+package listtenantadmins
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	ListTenantAdmins(ctx context.Context, tenantID uuid.UUID) (*[]Response, error)
+}
+
+type Repository struct {
+	repositories.AdminRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		AdminRepository: repositories.AdminRepository{Store: q},
+	}
+}
+
+func (r Repository) ListTenantAdmins(ctx context.Context, tenantID uuid.UUID) (*[]Response, error) {
+	admins, err := r.AdminRepository.Store.ListTenantAdmins(ctx, tenantID)
+
+	if err != nil && err != repositories.ErrNoRows {
+		return nil, err
+	}
+
+	result := make([]Response, 0, len(admins))
+
+	for _, admin := range admins {
+		result = append(result, Response{
+			UserID:    admin.UserID,
+			Email:     admin.Email,
+			CreatedAt: admin.CreatedAt.Time,
+		})
+	}
+
+	return &result, nil
+}
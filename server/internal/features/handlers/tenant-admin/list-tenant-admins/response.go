@@ -0,0 +1,13 @@
+package listtenantadmins
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Response struct {
+	UserID    uuid.UUID `json:"userId"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt"`
+}
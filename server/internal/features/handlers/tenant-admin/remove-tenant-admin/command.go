@@ -0,0 +1,8 @@
+package removetenantadmin
+
+import "github.com/google/uuid"
+
+type Command struct {
+	TenantID uuid.UUID `json:"tenantId" validate:"required,uuid"`
+	UserID   uuid.UUID `json:"userId" validate:"required,uuid"`
+}
@@ -0,0 +1,33 @@
+package removetenantadmin
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, request Command) error {
+	isTenantAdmin, err := s.repository.IsTenantAdmin(ctx, request.TenantID, request.UserID)
+
+	if err != nil {
+		return err
+	}
+
+	if !isTenantAdmin {
+		return &errors.ErrTenantAdminNotFound
+	}
+
+	return s.repository.RemoveTenantAdmin(ctx, request.TenantID, request.UserID)
+}
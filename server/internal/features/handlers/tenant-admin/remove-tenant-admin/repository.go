@@ -0,0 +1,24 @@
+package removetenantadmin
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	IsTenantAdmin(ctx context.Context, tenantID, userID uuid.UUID) (bool, error)
+	RemoveTenantAdmin(ctx context.Context, tenantID, userID uuid.UUID) error
+}
+
+type Repository struct {
+	repositories.AdminRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		AdminRepository: repositories.AdminRepository{Store: q},
+	}
+}
@@ -12,6 +12,9 @@ type Command struct {
 	IsEmailConfirmed      bool
 	TemporaryPasswordHash *string
 	Roles                 []uuid.UUID
+	AdminID               uuid.UUID
+	IPAddress             string
+	UserAgent             string
 }
 
 type RequestBody struct {
@@ -21,6 +24,6 @@ type RequestBody struct {
 	LastName              string      `json:"lastName" validate:"required,min=1,max=100"`
 	Email                 string      `json:"email" validate:"required,email"`
 	IsEmailConfirmed      bool        `json:"isEmailConfirmed" validate:"required"`
-	TemporaryPasswordHash *string     `json:"temporaryPasswordHash" validate:"min=8,max=100"`
+	TemporaryPasswordHash *string     `json:"temporaryPasswordHash" validate:"required"`
 	Roles                 []uuid.UUID `json:"roles" validate:"required"`
 }
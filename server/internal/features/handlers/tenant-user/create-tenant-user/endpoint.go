@@ -3,6 +3,7 @@ package createtenantuser
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/go-chi/chi"
@@ -38,6 +39,9 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 		IsEmailConfirmed:      requestBody.IsEmailConfirmed,
 		TemporaryPasswordHash: requestBody.TemporaryPasswordHash,
 		Roles:                 requestBody.Roles,
+		AdminID:               http_router.GetUserIDFromContext(request.Context()),
+		IPAddress:             clientip.RealIP(request),
+		UserAgent:             request.UserAgent(),
 	}
 
 	params := repositories.ParamsRs[Command, *Response, Handler]{
@@ -2,8 +2,10 @@ package createtenantuser
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
 	application_utils "github.com/gate-keeper/internal/features/utils"
@@ -28,10 +30,23 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 		return nil, err
 	}
 
-	if application == nil {
+	if application == nil || application.TenantID != request.TenantID {
 		return nil, &errors.ErrApplicationNotFound
 	}
 
+	applicationRolesList, err := s.repository.ListRolesFromApplication(ctx, request.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Only roles of the application may be assigned.
+	for _, roleID := range request.Roles {
+		if !slices.ContainsFunc(*applicationRolesList, func(role entities.ApplicationRole) bool { return role.ID == roleID }) {
+			return nil, &errors.ErrRoleNotFound
+		}
+	}
+
 	tenant, err := s.repository.GetTenantByID(ctx, application.TenantID)
 
 	if err != nil {
@@ -48,7 +63,11 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 		return nil, &errors.ErrUserAlreadyExists
 	}
 
-	hashedPassword, err := application_utils.HashPassword(*request.TemporaryPasswordHash, tenant.PasswordHashSecret)
+	if err := application_utils.ValidatePassword(application_utils.TenantPasswordPolicy(tenant, application), *request.TemporaryPasswordHash); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := application_utils.HashPassword(*request.TemporaryPasswordHash, application_utils.TenantPasswordHashPolicy(tenant))
 
 	if err != nil {
 		return nil, err
@@ -107,13 +126,21 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 
 	}
 
-	roles := make([]applicationRoles, len(request.Roles))
-	applicationRolesList, err := s.repository.ListRolesFromApplication(ctx, request.ApplicationID)
+	details, err := application_utils.AuditLogChanges(tenantUser.ID, nil,
+		application_utils.TenantUserAuditLogFields(tenantUser, tenantUserProfile, request.Roles))
 
 	if err != nil {
 		return nil, err
 	}
 
+	auditLog := entities.NewAuditLog(request.AdminID, application.ID,
+		constants.AuditEventUserCreated, request.IPAddress, request.UserAgent, "success", details)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
+	roles := make([]applicationRoles, len(request.Roles))
 	for i, roleID := range request.Roles {
 		for _, appRole := range *applicationRolesList {
 			if appRole.ID == roleID {
@@ -18,6 +18,7 @@ type IRepository interface {
 	AddUserRole(ctx context.Context, userRole *entities.UserRole) error
 	ListRolesFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationRole, error)
 	AddUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
@@ -27,6 +28,7 @@ type Repository struct {
 	repositories.UserProfileRepository
 	repositories.RoleRepository
 	repositories.UserCredentialsRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -37,5 +39,6 @@ func NewRepository(q *pgstore.Queries) Repository {
 		UserProfileRepository:     repositories.UserProfileRepository{Store: q},
 		RoleRepository:            repositories.RoleRepository{Store: q},
 		UserCredentialsRepository: repositories.UserCredentialsRepository{Store: q},
+		AuditLogRepository:        repositories.AuditLogRepository{Store: q},
 	}
 }
@@ -5,6 +5,9 @@ import (
 )
 
 type Command struct {
-	TenantID uuid.UUID
-	UserID   uuid.UUID
+	TenantID  uuid.UUID
+	UserID    uuid.UUID
+	AdminID   uuid.UUID
+	IPAddress string
+	UserAgent string
 }
@@ -3,6 +3,7 @@ package deletetenantuser
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/go-chi/chi"
@@ -30,8 +31,11 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	}
 
 	command := Command{
-		UserID:   userIdUUID,
-		TenantID: tenantIdUUID,
+		UserID:    userIdUUID,
+		TenantID:  tenantIdUUID,
+		AdminID:   http_router.GetUserIDFromContext(request.Context()),
+		IPAddress: clientip.RealIP(request),
+		UserAgent: request.UserAgent(),
 	}
 
 	params := repositories.Params[Command, Handler]{
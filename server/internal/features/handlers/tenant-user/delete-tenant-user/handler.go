@@ -3,6 +3,10 @@ package deletetenantuser
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -18,9 +22,28 @@ func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
 }
 
 func (s *Handler) Handler(ctx context.Context, request Command) error {
+	tenantUser, err := s.repository.GetUserByID(ctx, request.UserID)
+
+	if err != nil {
+		return err
+	}
+
+	if tenantUser == nil || tenantUser.TenantID != request.TenantID {
+		return &errors.ErrUserNotFound
+	}
+
 	if err := s.repository.DeleteTenantUser(ctx, request.TenantID, request.UserID); err != nil {
 		return err
 	}
 
-	return nil
+	details, err := application_utils.AuditLogChanges(tenantUser.ID, application_utils.TenantUserAuditLogFields(tenantUser, nil, nil), nil)
+
+	if err != nil {
+		return err
+	}
+
+	auditLog := entities.NewTenantAuditLog(request.TenantID, &request.AdminID, nil,
+		constants.AuditEventUserDeleted, request.IPAddress, request.UserAgent, "success", details)
+
+	return s.repository.AddAuditLog(ctx, auditLog)
 }
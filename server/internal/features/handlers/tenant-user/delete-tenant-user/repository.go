@@ -3,21 +3,26 @@ package deletetenantuser
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 	"github.com/google/uuid"
 )
 
 type IRepository interface {
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error)
 	DeleteTenantUser(ctx context.Context, tenantID, userID uuid.UUID) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.UserRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
-		UserRepository: repositories.UserRepository{Store: q},
+		UserRepository:     repositories.UserRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
 	}
 }
@@ -17,6 +17,9 @@ type Command struct {
 	Roles                 []uuid.UUID
 	IsActive              bool
 	Preferred2FAMethod    *string
+	AdminID               uuid.UUID
+	IPAddress             string
+	UserAgent             string
 }
 
 type RequestBody struct {
@@ -3,6 +3,7 @@ package edittenantuser
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/go-chi/chi"
@@ -48,6 +49,9 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 		Roles:                 requestBody.Roles,
 		IsActive:              requestBody.IsActive,
 		Preferred2FAMethod:    requestBody.Preferred2FAMethod,
+		AdminID:               http_router.GetUserIDFromContext(request.Context()),
+		IPAddress:             clientip.RealIP(request),
+		UserAgent:             request.UserAgent(),
 	}
 
 	params := repositories.ParamsRs[Command, *Response, Handler]{
@@ -2,6 +2,7 @@ package edittenantuser
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/gate-keeper/internal/domain/constants"
@@ -10,6 +11,7 @@ import (
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
 )
 
 type Handler struct {
@@ -29,10 +31,23 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 		return nil, err
 	}
 
-	if application == nil {
+	if application == nil || application.TenantID != request.TenantID {
 		return nil, &errors.ErrApplicationNotFound
 	}
 
+	applicationRolesList, err := s.repository.ListRolesFromApplication(ctx, request.ApplicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Only roles of the application may be assigned.
+	for _, roleID := range request.Roles {
+		if !slices.ContainsFunc(*applicationRolesList, func(role entities.ApplicationRole) bool { return role.ID == roleID }) {
+			return nil, &errors.ErrRoleNotFound
+		}
+	}
+
 	tenant, err := s.repository.GetTenantByID(ctx, application.TenantID)
 
 	if err != nil {
@@ -45,12 +60,35 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 		return nil, err
 	}
 
-	if tenantUser == nil {
+	if tenantUser == nil || tenantUser.TenantID != request.TenantID {
 		return nil, &errors.ErrUserNotFound
 	}
 
+	tenantUserProfile, err := s.repository.GetUserProfileByID(ctx, tenantUser.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	userRoles, err := s.repository.GetRolesByUserID(ctx, tenantUser.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	userRoleIDs := make([]uuid.UUID, len(userRoles))
+	for i, role := range userRoles {
+		userRoleIDs[i] = role.ID
+	}
+
+	before := application_utils.TenantUserAuditLogFields(tenantUser, tenantUserProfile, userRoleIDs)
+
 	if request.TemporaryPasswordHash != nil {
-		hashedPassword, err := application_utils.HashPassword(*request.TemporaryPasswordHash, tenant.PasswordHashSecret)
+		if err := application_utils.ValidatePassword(application_utils.TenantPasswordPolicy(tenant, application), *request.TemporaryPasswordHash); err != nil {
+			return nil, err
+		}
+
+		hashedPassword, err := application_utils.HashPassword(*request.TemporaryPasswordHash, application_utils.TenantPasswordHashPolicy(tenant))
 
 		if err != nil {
 			return nil, err
@@ -62,7 +100,7 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 			return nil, err
 		}
 
-		userCredentials.PasswordHash = hashedPassword
+		userCredentials.SetPassword(hashedPassword)
 		userCredentials.ShouldChangePass = true
 
 		if err = s.repository.UpdateUserCredentials(ctx, userCredentials); err != nil {
@@ -84,7 +122,7 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 	tenantUser.IsActive = request.IsActive
 	tenantUser.Preferred2FAMethod = request.Preferred2FAMethod
 
-	tenantUserProfile := entities.NewUserProfile(
+	tenantUserProfile = entities.NewUserProfile(
 		tenantUser.ID,
 		request.FirstName,
 		request.LastName,
@@ -102,12 +140,6 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 		return nil, err
 	}
 
-	userRoles, err := s.repository.GetRolesByUserID(ctx, tenantUser.ID)
-
-	if err != nil {
-		return nil, err
-	}
-
 	for _, role := range userRoles {
 		userRole := entities.UserRole{
 			UserID: tenantUser.ID,
@@ -127,13 +159,27 @@ func (s *Handler) Handler(ctx context.Context, request Command) (*Response, erro
 		_ = s.repository.AddUserRole(ctx, &userRole)
 	}
 
-	roles := make([]applicationRoles, len(request.Roles))
-	applicationRolesList, err := s.repository.ListRolesFromApplication(ctx, request.ApplicationID)
+	after := application_utils.TenantUserAuditLogFields(tenantUser, tenantUserProfile, request.Roles)
+
+	// Only that a temporary password was set is recorded, never the password
+	if request.TemporaryPasswordHash != nil {
+		after["temporaryPasswordSet"] = true
+	}
+
+	details, err := application_utils.AuditLogChanges(tenantUser.ID, before, after)
 
 	if err != nil {
 		return nil, err
 	}
 
+	auditLog := entities.NewAuditLog(request.AdminID, application.ID,
+		constants.AuditEventUserUpdated, request.IPAddress, request.UserAgent, "success", details)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
+	roles := make([]applicationRoles, len(request.Roles))
 	for i, roleID := range request.Roles {
 		for _, appRole := range *applicationRolesList {
 			if appRole.ID == roleID {
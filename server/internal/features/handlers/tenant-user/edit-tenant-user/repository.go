@@ -14,6 +14,7 @@ type IRepository interface {
 	GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error)
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*entities.TenantUser, error)
 	UpdateUser(ctx context.Context, user *entities.TenantUser) (*entities.TenantUser, error)
+	GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error)
 	EditUserProfile(ctx context.Context, updatedUser *entities.UserProfile) error
 	GetRolesByUserID(ctx context.Context, userID uuid.UUID) ([]entities.ApplicationRole, error)
 	RemoveUserRole(ctx context.Context, userRole *entities.UserRole) error
@@ -21,6 +22,7 @@ type IRepository interface {
 	ListRolesFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationRole, error)
 	UpdateUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
 	GetUserCredentialsByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserCredentials, error)
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
@@ -30,6 +32,7 @@ type Repository struct {
 	repositories.UserProfileRepository
 	repositories.RoleRepository
 	repositories.UserCredentialsRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
@@ -40,5 +43,6 @@ func NewRepository(q *pgstore.Queries) Repository {
 		UserProfileRepository:     repositories.UserProfileRepository{Store: q},
 		RoleRepository:            repositories.RoleRepository{Store: q},
 		UserCredentialsRepository: repositories.UserCredentialsRepository{Store: q},
+		AuditLogRepository:        repositories.AuditLogRepository{Store: q},
 	}
 }
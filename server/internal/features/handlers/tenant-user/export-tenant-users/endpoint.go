@@ -0,0 +1,63 @@
+package exporttenantusers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+// Http streams the tenant's users, profiles, role assignments and external
+// identities in the import format chosen with ?format=csv|jsonl (CSV by
+// default), so an export can be imported into another tenant as-is.
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	tenantIdUUID, err := uuid.Parse(chi.URLParam(request, "tenantID"))
+
+	if err != nil {
+		panic(err)
+	}
+
+	format := request.URL.Query().Get("format")
+	if format == "" {
+		format = constants.UserTransferFormatCSV
+	}
+
+	format, err = application_utils.ParseUserTransferFormat(format)
+
+	if err != nil {
+		panic(err)
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if format == constants.UserTransferFormatJSONL {
+		contentType = "application/x-ndjson"
+	}
+
+	writter.Header().Set("Content-Type", contentType)
+	writter.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="users-%s.%s"`, tenantIdUUID, format))
+
+	userWriter, err := application_utils.NewUserTransferWriter(format, writter)
+
+	if err != nil {
+		panic(err)
+	}
+
+	params := repositories.Params[Query, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: Query{TenantID: tenantIdUUID, Writer: userWriter},
+	}
+
+	if err := repositories.WithTransaction(request.Context(), params); err != nil {
+		panic(err)
+	}
+}
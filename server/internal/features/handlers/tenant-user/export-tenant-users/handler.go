@@ -0,0 +1,53 @@
+package exporttenantusers
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+// batchSize is how many users are read and written at a time.
+const batchSize = 500
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandler[Query] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler writes every user of the tenant in ID order, flushing after each
+// batch so the export streams instead of being built in memory. Password
+// hashes are never exported.
+func (s *Handler) Handler(ctx context.Context, query Query) error {
+	afterID := uuid.Nil
+
+	for {
+		users, err := s.repository.ListTenantUsersForExport(ctx, query.TenantID, afterID, batchSize)
+
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			if err := query.Writer.Write(user.Record); err != nil {
+				return err
+			}
+		}
+
+		if err := query.Writer.Flush(); err != nil {
+			return err
+		}
+
+		if len(users) < batchSize {
+			return nil
+		}
+
+		afterID = users[len(users)-1].ID
+	}
+}
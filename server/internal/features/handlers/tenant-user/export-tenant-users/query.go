@@ -0,0 +1,11 @@
+package exporttenantusers
+
+import (
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/google/uuid"
+)
+
+type Query struct {
+	TenantID uuid.UUID
+	Writer   application_utils.UserTransferWriter
+}
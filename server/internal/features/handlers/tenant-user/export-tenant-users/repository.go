@@ -0,0 +1,74 @@
+package exporttenantusers
+
+import (
+	"context"
+
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	ListTenantUsersForExport(ctx context.Context, tenantID, afterID uuid.UUID, limit int) ([]exportedUser, error)
+}
+
+type Repository struct {
+	repositories.UserRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		UserRepository: repositories.UserRepository{Store: q},
+	}
+}
+
+// exportedUser is a user as written to the export file, keyed by ID for paging.
+type exportedUser struct {
+	ID     uuid.UUID
+	Record application_utils.UserTransferRecord
+}
+
+func (r Repository) ListTenantUsersForExport(ctx context.Context, tenantID, afterID uuid.UUID, limit int) ([]exportedUser, error) {
+	users, err := r.UserRepository.Store.ListTenantUsersForExport(ctx, pgstore.ListTenantUsersForExportParams{
+		TenantID: tenantID,
+		AfterID:  afterID,
+		Limit:    int32(limit),
+	})
+
+	if err != nil && err != repositories.ErrNoRows {
+		return nil, err
+	}
+
+	result := make([]exportedUser, 0, len(users))
+
+	for _, user := range users {
+		isActive := user.IsActive
+
+		result = append(result, exportedUser{
+			ID: user.ID,
+			Record: application_utils.UserTransferRecord{
+				Email:              user.Email,
+				FirstName:          valueOrEmpty(user.FirstName),
+				LastName:           valueOrEmpty(user.LastName),
+				DisplayName:        valueOrEmpty(user.DisplayName),
+				PhoneNumber:        user.PhoneNumber,
+				Address:            user.Address,
+				PhotoURL:           user.PhotoUrl,
+				EmailConfirmed:     user.IsEmailConfirmed,
+				IsActive:           &isActive,
+				Roles:              user.Roles,
+				ExternalIdentities: user.ExternalIdentities,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,57 @@
+package gettenantuserimport
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
+	job, err := s.repository.GetUserImportJobByID(ctx, query.TenantID, query.ImportID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if job == nil {
+		return nil, &errors.ErrUserImportNotFound
+	}
+
+	rowErrors := make([]RowErrorResponse, 0, len(job.Errors))
+
+	for _, rowError := range job.Errors {
+		rowErrors = append(rowErrors, RowErrorResponse{
+			Row:   rowError.Row,
+			Email: rowError.Email,
+			Error: rowError.Error,
+		})
+	}
+
+	return &Response{
+		ID:             job.ID,
+		IdempotencyKey: job.IdempotencyKey,
+		Format:         job.Format,
+		DryRun:         job.DryRun,
+		Status:         job.Status,
+		TotalRows:      job.TotalRows,
+		ProcessedRows:  job.ProcessedRows,
+		ImportedRows:   job.ImportedRows,
+		SkippedRows:    job.SkippedRows,
+		FailedRows:     job.FailedRows,
+		Errors:         rowErrors,
+		CreatedAt:      job.CreatedAt,
+		CompletedAt:    job.CompletedAt,
+	}, nil
+}
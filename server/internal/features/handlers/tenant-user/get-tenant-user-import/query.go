@@ -0,0 +1,8 @@
+package gettenantuserimport
+
+import "github.com/google/uuid"
+
+type Query struct {
+	TenantID uuid.UUID
+	ImportID uuid.UUID
+}
@@ -0,0 +1,24 @@
+package gettenantuserimport
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetUserImportJobByID(ctx context.Context, tenantID, jobID uuid.UUID) (*entities.UserImportJob, error)
+}
+
+type Repository struct {
+	repositories.UserImportJobRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		UserImportJobRepository: repositories.UserImportJobRepository{Store: q},
+	}
+}
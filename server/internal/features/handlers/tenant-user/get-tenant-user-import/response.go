@@ -0,0 +1,29 @@
+package gettenantuserimport
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Response struct {
+	ID             uuid.UUID          `json:"id"`
+	IdempotencyKey string             `json:"idempotencyKey"`
+	Format         string             `json:"format"`
+	DryRun         bool               `json:"dryRun"`
+	Status         string             `json:"status"`
+	TotalRows      int                `json:"totalRows"`
+	ProcessedRows  int                `json:"processedRows"`
+	ImportedRows   int                `json:"importedRows"`
+	SkippedRows    int                `json:"skippedRows"`
+	FailedRows     int                `json:"failedRows"`
+	Errors         []RowErrorResponse `json:"errors"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	CompletedAt    *time.Time         `json:"completedAt"`
+}
+
+type RowErrorResponse struct {
+	Row   int    `json:"row"`
+	Email string `json:"email,omitempty"`
+	Error string `json:"error"`
+}
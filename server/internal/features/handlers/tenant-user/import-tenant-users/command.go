@@ -0,0 +1,14 @@
+package importtenantusers
+
+import (
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/google/uuid"
+)
+
+type Command struct {
+	TenantID       uuid.UUID
+	IdempotencyKey string // Idempotency-Key header; empty when the client sent none
+	Format         string
+	DryRun         bool
+	Rows           []application_utils.UserTransferRow
+}
@@ -0,0 +1,90 @@
+package importtenantusers
+
+import (
+	"context"
+	goerrors "errors"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// maxImportSize bounds the import file, which is held in memory while the
+// job runs.
+const maxImportSize = 32 << 20
+
+type Endpoint struct {
+	DbPool *pgxpool.Pool
+}
+
+// Http accepts a CSV or JSON Lines file of users and answers 202 with the
+// import job; GET .../users/imports/{importID} reports its progress. The
+// format is taken from ?format= or the Content-Type, ?dryRun=true only
+// validates the rows, and the Idempotency-Key header makes retries return the
+// original job.
+func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
+	tenantIDString := chi.URLParam(request, "tenantID")
+	tenantIdUUID, err := uuid.Parse(tenantIDString)
+
+	if err != nil {
+		panic(err)
+	}
+
+	format := request.URL.Query().Get("format")
+	if format == "" {
+		format, _, _ = mime.ParseMediaType(request.Header.Get("Content-Type"))
+	}
+
+	format, err = application_utils.ParseUserTransferFormat(format)
+
+	if err != nil {
+		panic(err)
+	}
+
+	dryRun, _ := strconv.ParseBool(request.URL.Query().Get("dryRun"))
+
+	rows, err := application_utils.ParseUserTransferRows(format, http.MaxBytesReader(writter, request.Body, maxImportSize))
+
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if goerrors.As(err, &maxBytesError) {
+			panic(&errors.ErrUserImportTooLarge)
+		}
+
+		panic(err)
+	}
+
+	command := Command{
+		TenantID:       tenantIdUUID,
+		IdempotencyKey: request.Header.Get("Idempotency-Key"),
+		Format:         format,
+		DryRun:         dryRun,
+		Rows:           rows,
+	}
+
+	params := repositories.ParamsRs[Command, *Response, Handler]{
+		DbPool:  c.DbPool,
+		New:     New,
+		Request: command,
+	}
+
+	response, err := repositories.WithTransactionRs(request.Context(), params)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// The job outlives the request, so it must not use the request context.
+	if response.job != nil {
+		go Importer{DbPool: c.DbPool}.Run(context.Background(), response.job, rows)
+	}
+
+	http_router.SendJson(writter, response, http.StatusAccepted)
+}
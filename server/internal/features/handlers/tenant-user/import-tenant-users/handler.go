@@ -0,0 +1,75 @@
+package importtenantusers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler registers the import job. The rows are processed in the background
+// by the Importer once the job is committed.
+func (s *Handler) Handler(ctx context.Context, request Command) (*Response, error) {
+	// Retried requests get the job created by the first one instead of
+	// importing the file twice.
+	if request.IdempotencyKey != "" {
+		existingJob, err := s.repository.GetUserImportJobByIdempotencyKey(ctx, request.TenantID, request.IdempotencyKey)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if existingJob != nil {
+			return newResponse(existingJob), nil
+		}
+	}
+
+	if len(request.Rows) == 0 {
+		return nil, &errors.ErrUserImportEmpty
+	}
+
+	job := entities.NewUserImportJob(request.TenantID, request.IdempotencyKey, request.Format, request.DryRun, len(request.Rows))
+
+	if job.IdempotencyKey == "" {
+		job.IdempotencyKey = job.ID.String()
+	}
+
+	added, err := s.repository.AddUserImportJob(ctx, job)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A concurrent request with the same key created the job first.
+	if !added {
+		existingJob, err := s.repository.GetUserImportJobByIdempotencyKey(ctx, request.TenantID, job.IdempotencyKey)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if existingJob == nil {
+			return nil, fmt.Errorf("user import job with idempotency key %q not found", job.IdempotencyKey)
+		}
+
+		return newResponse(existingJob), nil
+	}
+
+	response := newResponse(job)
+	response.job = job
+
+	return response, nil
+}
@@ -0,0 +1,328 @@
+package importtenantusers
+
+import (
+	"context"
+	goerrors "errors"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockImportRepo struct{ mock.Mock }
+
+func (m *mockImportRepo) AddUserImportJob(ctx context.Context, job *entities.UserImportJob) (bool, error) {
+	args := m.Called(ctx, job)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockImportRepo) UpdateUserImportJob(ctx context.Context, job *entities.UserImportJob) error {
+	return m.Called(ctx, job).Error(0)
+}
+
+func (m *mockImportRepo) TouchUserImportJob(ctx context.Context, jobID uuid.UUID, now time.Time) error {
+	return m.Called(ctx, jobID, now).Error(0)
+}
+
+func (m *mockImportRepo) GetUserImportJobByIdempotencyKey(ctx context.Context, tenantID uuid.UUID, idempotencyKey string) (*entities.UserImportJob, error) {
+	args := m.Called(ctx, tenantID, idempotencyKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserImportJob), args.Error(1)
+}
+
+func (m *mockImportRepo) GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Application), args.Error(1)
+}
+
+func (m *mockImportRepo) ListRolesFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationRole, error) {
+	args := m.Called(ctx, applicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entities.ApplicationRole), args.Error(1)
+}
+
+func (m *mockImportRepo) GetApplicationOauthProviderByName(ctx context.Context, applicationID uuid.UUID, name string) (*entities.ApplicationOAuthProvider, error) {
+	args := m.Called(ctx, applicationID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ApplicationOAuthProvider), args.Error(1)
+}
+
+func (m *mockImportRepo) IsUserExistsByEmail(ctx context.Context, email string, tenantID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, email, tenantID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockImportRepo) AddUser(ctx context.Context, user *entities.TenantUser) error {
+	return m.Called(ctx, user).Error(0)
+}
+
+func (m *mockImportRepo) AddUserProfile(ctx context.Context, userProfile *entities.UserProfile) error {
+	return m.Called(ctx, userProfile).Error(0)
+}
+
+func (m *mockImportRepo) AddUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error {
+	return m.Called(ctx, userCredentials).Error(0)
+}
+
+func (m *mockImportRepo) AddUserRole(ctx context.Context, userRole *entities.UserRole) error {
+	return m.Called(ctx, userRole).Error(0)
+}
+
+func (m *mockImportRepo) AddExternalIdentity(ctx context.Context, newExternalIdentity *entities.ExternalIdentity) error {
+	return m.Called(ctx, newExternalIdentity).Error(0)
+}
+
+// --- helpers ---
+
+const bcryptHash = "$2b$10$abcdefghijklmnopqrstuuJ3N3C7dY5y0q3bJ3Bv3Yqk2m4K6s7gW"
+
+func newImportApplication(tenantID uuid.UUID) *entities.Application {
+	id, _ := uuid.NewV7()
+	return &entities.Application{ID: id, TenantID: tenantID, Name: "Test App"}
+}
+
+func newImportRoles(applicationID uuid.UUID) *[]entities.ApplicationRole {
+	id, _ := uuid.NewV7()
+	return &[]entities.ApplicationRole{{ID: id, ApplicationID: applicationID, Name: "admin"}}
+}
+
+func newRow(row int, record application_utils.UserTransferRecord) application_utils.UserTransferRow {
+	return application_utils.UserTransferRow{Row: row, Record: record}
+}
+
+// --- Handler ---
+
+func TestHandler_ImportTenantUsers_CreatesPendingJob(t *testing.T) {
+	repo := new(mockImportRepo)
+	tenantID, _ := uuid.NewV7()
+
+	repo.On("GetUserImportJobByIdempotencyKey", mock.Anything, tenantID, "key-1").Return(nil, nil)
+	repo.On("AddUserImportJob", mock.Anything, mock.MatchedBy(func(job *entities.UserImportJob) bool {
+		return job.TenantID == tenantID && job.IdempotencyKey == "key-1" && job.TotalRows == 2 && job.Status == constants.UserImportStatusPending
+	})).Return(true, nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), Command{
+		TenantID:       tenantID,
+		IdempotencyKey: "key-1",
+		Format:         constants.UserTransferFormatCSV,
+		Rows:           []application_utils.UserTransferRow{{Row: 1}, {Row: 2}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, constants.UserImportStatusPending, response.Status)
+	assert.NotNil(t, response.job)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_ImportTenantUsers_ReturnsExistingJobForSameIdempotencyKey(t *testing.T) {
+	repo := new(mockImportRepo)
+	tenantID, _ := uuid.NewV7()
+	existingJob := entities.NewUserImportJob(tenantID, "key-1", constants.UserTransferFormatCSV, false, 5)
+	existingJob.Status = constants.UserImportStatusCompleted
+
+	repo.On("GetUserImportJobByIdempotencyKey", mock.Anything, tenantID, "key-1").Return(existingJob, nil)
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), Command{
+		TenantID:       tenantID,
+		IdempotencyKey: "key-1",
+		Format:         constants.UserTransferFormatCSV,
+		Rows:           []application_utils.UserTransferRow{{Row: 1}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, existingJob.ID, response.ID)
+	assert.Equal(t, constants.UserImportStatusCompleted, response.Status)
+	assert.Nil(t, response.job, "an existing job must not be processed again")
+	repo.AssertNotCalled(t, "AddUserImportJob", mock.Anything, mock.Anything)
+}
+
+func TestHandler_ImportTenantUsers_ReturnsJobOfConcurrentRequest(t *testing.T) {
+	repo := new(mockImportRepo)
+	tenantID, _ := uuid.NewV7()
+	concurrentJob := entities.NewUserImportJob(tenantID, "key-1", constants.UserTransferFormatCSV, false, 1)
+
+	repo.On("GetUserImportJobByIdempotencyKey", mock.Anything, tenantID, "key-1").Return(nil, nil).Once()
+	repo.On("AddUserImportJob", mock.Anything, mock.Anything).Return(false, nil)
+	repo.On("GetUserImportJobByIdempotencyKey", mock.Anything, tenantID, "key-1").Return(concurrentJob, nil).Once()
+
+	h := &Handler{repository: repo}
+	response, err := h.Handler(context.Background(), Command{
+		TenantID:       tenantID,
+		IdempotencyKey: "key-1",
+		Format:         constants.UserTransferFormatCSV,
+		Rows:           []application_utils.UserTransferRow{{Row: 1}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, concurrentJob.ID, response.ID)
+	assert.Nil(t, response.job, "the job of the other request must not be processed twice")
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_ImportTenantUsers_EmptyFile(t *testing.T) {
+	repo := new(mockImportRepo)
+	tenantID, _ := uuid.NewV7()
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{
+		TenantID: tenantID,
+		Format:   constants.UserTransferFormatJSONL,
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "ErrUserImportEmpty", err.Error())
+}
+
+// --- rowImporter ---
+
+func TestRowImporter_ImportsUserWithRolesIdentitiesAndForeignHash(t *testing.T) {
+	repo := new(mockImportRepo)
+	tenantID, _ := uuid.NewV7()
+	app := newImportApplication(tenantID)
+	roles := newImportRoles(app.ID)
+	providerID, _ := uuid.NewV7()
+	provider := &entities.ApplicationOAuthProvider{ID: providerID, ApplicationID: app.ID, Name: "github"}
+
+	repo.On("GetApplicationByID", mock.Anything, app.ID).Return(app, nil).Once()
+	repo.On("ListRolesFromApplication", mock.Anything, app.ID).Return(roles, nil).Once()
+	repo.On("GetApplicationOauthProviderByName", mock.Anything, app.ID, "github").Return(provider, nil)
+	repo.On("IsUserExistsByEmail", mock.Anything, "ada@example.com", tenantID).Return(false, nil)
+	repo.On("AddUser", mock.Anything, mock.MatchedBy(func(u *entities.TenantUser) bool {
+		return u.Email == "ada@example.com" && u.TenantID == tenantID && u.IsEmailConfirmed
+	})).Return(nil)
+	repo.On("AddUserProfile", mock.Anything, mock.MatchedBy(func(p *entities.UserProfile) bool {
+		return p.DisplayName == "Ada Lovelace"
+	})).Return(nil)
+	repo.On("AddUserCredentials", mock.Anything, mock.MatchedBy(func(c *entities.UserCredentials) bool {
+		return c.PasswordHash == bcryptHash && c.PasswordAlgorithm == constants.UserCredentialsPasswordAlgorithmBcrypt && !c.ShouldChangePass
+	})).Return(nil)
+	repo.On("AddUserRole", mock.Anything, mock.MatchedBy(func(r *entities.UserRole) bool {
+		return r.RoleID == (*roles)[0].ID
+	})).Return(nil)
+	repo.On("AddExternalIdentity", mock.Anything, mock.MatchedBy(func(e *entities.ExternalIdentity) bool {
+		return e.ApplicationOAuthProviderID == providerID && e.Provider == "github" && e.ProviderUserID == "42"
+	})).Return(nil)
+
+	importer := newRowImporter(tenantID, false)
+	result, err := importer.importRow(context.Background(), repo, newRow(1, application_utils.UserTransferRecord{
+		Email:              " Ada@Example.com ",
+		FirstName:          "Ada",
+		LastName:           "Lovelace",
+		EmailConfirmed:     true,
+		PasswordHash:       bcryptHash,
+		Roles:              []string{app.ID.String() + ":admin"},
+		ExternalIdentities: []string{app.ID.String() + ":github:42"},
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, rowImported, result.outcome)
+	repo.AssertExpectations(t)
+}
+
+func TestRowImporter_SkipsExistingUser(t *testing.T) {
+	repo := new(mockImportRepo)
+	tenantID, _ := uuid.NewV7()
+
+	repo.On("IsUserExistsByEmail", mock.Anything, "ada@example.com", tenantID).Return(true, nil)
+
+	importer := newRowImporter(tenantID, false)
+	result, err := importer.importRow(context.Background(), repo, newRow(1, application_utils.UserTransferRecord{Email: "ada@example.com"}))
+
+	require.NoError(t, err)
+	assert.Equal(t, rowSkipped, result.outcome)
+	repo.AssertNotCalled(t, "AddUser", mock.Anything, mock.Anything)
+}
+
+func TestRowImporter_DryRunWritesNothing(t *testing.T) {
+	repo := new(mockImportRepo)
+	tenantID, _ := uuid.NewV7()
+
+	repo.On("IsUserExistsByEmail", mock.Anything, "ada@example.com", tenantID).Return(false, nil)
+
+	importer := newRowImporter(tenantID, true)
+	result, err := importer.importRow(context.Background(), repo, newRow(1, application_utils.UserTransferRecord{Email: "ada@example.com"}))
+
+	require.NoError(t, err)
+	assert.Equal(t, rowImported, result.outcome)
+	repo.AssertNotCalled(t, "AddUser", mock.Anything, mock.Anything)
+}
+
+func TestRowImporter_ReportsInvalidRows(t *testing.T) {
+	tenantID, _ := uuid.NewV7()
+	otherTenantApp := newImportApplication(uuid.New())
+
+	cases := map[string]application_utils.UserTransferRow{
+		"parse error":        {Row: 1, Err: goerrors.New("invalid JSON")},
+		"invalid email":      newRow(1, application_utils.UserTransferRecord{Email: "not-an-email"}),
+		"unsupported hash":   newRow(1, application_utils.UserTransferRecord{Email: "ada@example.com", PasswordHash: "$md5$abc"}),
+		"hash cost too high": newRow(1, application_utils.UserTransferRecord{Email: "ada@example.com", PasswordHash: "$scrypt$ln=30,r=1024,p=1$c2FsdA$aGFzaA"}),
+		"malformed role":     newRow(1, application_utils.UserTransferRecord{Email: "ada@example.com", Roles: []string{"admin"}}),
+		"foreign app role":   newRow(1, application_utils.UserTransferRecord{Email: "ada@example.com", Roles: []string{otherTenantApp.ID.String() + ":admin"}}),
+		"malformed identity": newRow(1, application_utils.UserTransferRecord{Email: "ada@example.com", ExternalIdentities: []string{"github:42"}}),
+	}
+
+	for name, row := range cases {
+		repo := new(mockImportRepo)
+		repo.On("GetApplicationByID", mock.Anything, otherTenantApp.ID).Return(otherTenantApp, nil)
+
+		importer := newRowImporter(tenantID, false)
+		result, err := importer.importRow(context.Background(), repo, row)
+
+		require.NoError(t, err, name)
+		assert.Equal(t, rowFailed, result.outcome, name)
+		assert.NotEmpty(t, result.message, name)
+		repo.AssertNotCalled(t, "AddUser", mock.Anything, mock.Anything)
+	}
+}
+
+func TestRowImporter_RejectsDuplicateEmailInFile(t *testing.T) {
+	repo := new(mockImportRepo)
+	tenantID, _ := uuid.NewV7()
+
+	repo.On("IsUserExistsByEmail", mock.Anything, "ada@example.com", tenantID).Return(false, nil)
+
+	importer := newRowImporter(tenantID, true)
+	first, err := importer.importRow(context.Background(), repo, newRow(1, application_utils.UserTransferRecord{Email: "ada@example.com"}))
+	require.NoError(t, err)
+	second, err := importer.importRow(context.Background(), repo, newRow(2, application_utils.UserTransferRecord{Email: "ADA@example.com"}))
+	require.NoError(t, err)
+
+	assert.Equal(t, rowImported, first.outcome)
+	assert.Equal(t, rowFailed, second.outcome)
+}
+
+func TestRowImporter_UnknownRole(t *testing.T) {
+	repo := new(mockImportRepo)
+	tenantID, _ := uuid.NewV7()
+	app := newImportApplication(tenantID)
+
+	repo.On("GetApplicationByID", mock.Anything, app.ID).Return(app, nil)
+	repo.On("ListRolesFromApplication", mock.Anything, app.ID).Return(newImportRoles(app.ID), nil)
+
+	importer := newRowImporter(tenantID, false)
+	result, err := importer.importRow(context.Background(), repo, newRow(1, application_utils.UserTransferRecord{
+		Email: "ada@example.com",
+		Roles: []string{app.ID.String() + ":owner"},
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, rowFailed, result.outcome)
+	assert.Contains(t, result.message, "owner")
+}
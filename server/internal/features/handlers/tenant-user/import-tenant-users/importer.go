@@ -0,0 +1,458 @@
+package importtenantusers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// progressInterval is how many rows are processed between job progress updates.
+const progressInterval = 100
+
+// Importer processes an accepted import job in the background. Every row is
+// imported in its own transaction, so a failing row never undoes the others.
+type Importer struct {
+	DbPool *pgxpool.Pool
+}
+
+// Run processes every row of the job and records the outcome on it. Jobs are
+// not persisted with their rows, so a job whose heartbeat stops because its
+// instance went down is marked as failed when an instance starts up, and must
+// be submitted again; rows already imported are then skipped because their
+// e-mail exists.
+func (i Importer) Run(ctx context.Context, job *entities.UserImportJob, rows []application_utils.UserTransferRow) {
+	// Run has no caller to recover a panic, which would otherwise crash the
+	// server and leave the job running forever.
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(ctx, "User import panicked", "job_id", job.ID, "error", r)
+
+			job.Complete(constants.UserImportStatusFailed, time.Now().UTC())
+
+			if err := i.updateJob(ctx, job); err != nil {
+				slog.ErrorContext(ctx, "User import could not be marked as failed", "job_id", job.ID, "error", err)
+			}
+		}
+	}()
+
+	defer i.heartbeat(ctx, job.ID)()
+
+	job.Status = constants.UserImportStatusRunning
+
+	if err := i.updateJob(ctx, job); err != nil {
+		slog.ErrorContext(ctx, "User import could not be started", "job_id", job.ID, "error", err)
+		return
+	}
+
+	importer := newRowImporter(job.TenantID, job.DryRun)
+
+	for _, row := range rows {
+		var result rowResult
+
+		err := i.withRepository(ctx, func(repository IRepository) error {
+			var err error
+			result, err = importer.importRow(ctx, repository, row)
+			return err
+		})
+
+		if err != nil {
+			slog.ErrorContext(ctx, "User import row failed", "job_id", job.ID, "row", row.Row, "error", err)
+			result = rowResult{outcome: rowFailed, email: row.Record.Email, message: "the row could not be imported"}
+		}
+
+		switch result.outcome {
+		case rowImported:
+			job.ImportedRows++
+		case rowSkipped:
+			job.SkippedRows++
+		case rowFailed:
+			job.AddRowError(row.Row, result.email, result.message)
+		}
+
+		job.ProcessedRows++
+
+		if job.ProcessedRows%progressInterval == 0 {
+			if err := i.updateJob(ctx, job); err != nil {
+				slog.ErrorContext(ctx, "User import progress could not be saved", "job_id", job.ID, "error", err)
+			}
+		}
+	}
+
+	job.Complete(constants.UserImportStatusCompleted, time.Now().UTC())
+
+	if err := i.updateJob(ctx, job); err != nil {
+		slog.ErrorContext(ctx, "User import could not be completed", "job_id", job.ID, "error", err)
+	}
+}
+
+// heartbeat refreshes the job's heartbeat until the returned function is
+// called, so instances starting up don't mistake it for an interrupted job.
+func (i Importer) heartbeat(ctx context.Context, jobID uuid.UUID) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		ticker := time.NewTicker(constants.UserImportHeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := i.withRepository(ctx, func(repository IRepository) error {
+					return repository.TouchUserImportJob(ctx, jobID, time.Now().UTC())
+				})
+
+				if err != nil && ctx.Err() == nil {
+					slog.ErrorContext(ctx, "User import heartbeat could not be saved", "job_id", jobID, "error", err)
+				}
+			}
+		}
+	}()
+
+	return cancel
+}
+
+func (i Importer) updateJob(ctx context.Context, job *entities.UserImportJob) error {
+	return i.withRepository(ctx, func(repository IRepository) error {
+		return repository.UpdateUserImportJob(ctx, job)
+	})
+}
+
+// withRepository runs fn in a transaction, committing it when fn succeeds.
+func (i Importer) withRepository(ctx context.Context, fn func(repository IRepository) error) error {
+	conn, err := i.DbPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(NewRepository(pgstore.New(tx))); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+type rowOutcome int
+
+const (
+	rowImported rowOutcome = iota // imported, or would be imported on a dry run
+	rowSkipped                    // the e-mail already belongs to a user of the tenant
+	rowFailed
+)
+
+type rowResult struct {
+	outcome rowOutcome
+	email   string
+	message string
+}
+
+func failedRow(email, format string, args ...any) rowResult {
+	return rowResult{outcome: rowFailed, email: email, message: fmt.Sprintf(format, args...)}
+}
+
+// importApplication caches what rows may reference in an application of the
+// tenant.
+type importApplication struct {
+	id        uuid.UUID
+	roles     []entities.ApplicationRole
+	providers map[string]*entities.ApplicationOAuthProvider
+}
+
+// rowImporter validates and imports rows one at a time, keeping the state
+// that spans rows: e-mails already seen in the file and the applications
+// looked up so far.
+type rowImporter struct {
+	tenantID     uuid.UUID
+	dryRun       bool
+	seenEmails   map[string]bool
+	applications map[uuid.UUID]*importApplication // nil for applications outside the tenant
+}
+
+func newRowImporter(tenantID uuid.UUID, dryRun bool) *rowImporter {
+	return &rowImporter{
+		tenantID:     tenantID,
+		dryRun:       dryRun,
+		seenEmails:   map[string]bool{},
+		applications: map[uuid.UUID]*importApplication{},
+	}
+}
+
+type importExternalIdentity struct {
+	provider       *entities.ApplicationOAuthProvider
+	providerUserID string
+}
+
+// importRow validates the row and, unless this is a dry run, creates the user.
+// Validation problems are reported in the result; the error is only set when
+// the database fails.
+func (r *rowImporter) importRow(ctx context.Context, repository IRepository, row application_utils.UserTransferRow) (rowResult, error) {
+	record := row.Record
+	email := strings.ToLower(strings.TrimSpace(record.Email))
+
+	if row.Err != nil {
+		return failedRow(email, "%s", row.Err.Error()), nil
+	}
+
+	if message := validateRecord(email, record); message != "" {
+		return failedRow(email, "%s", message), nil
+	}
+
+	if r.seenEmails[email] {
+		return failedRow(email, "the e-mail appears more than once in the file"), nil
+	}
+	r.seenEmails[email] = true
+
+	passwordAlgorithm := ""
+	if record.PasswordHash != "" {
+		algorithm, ok := application_utils.DetectPasswordAlgorithm(record.PasswordHash)
+		if !ok {
+			return failedRow(email, "unsupported password hash format"), nil
+		}
+		if err := application_utils.ValidatePasswordHash(record.PasswordHash); err != nil {
+			return failedRow(email, "password hash is malformed or its cost parameters exceed the supported limits"), nil
+		}
+		passwordAlgorithm = algorithm
+	}
+
+	roleIDs := make([]uuid.UUID, 0, len(record.Roles))
+	for _, value := range record.Roles {
+		rawApplicationID, roleName, ok := strings.Cut(value, ":")
+		if !ok || roleName == "" {
+			return failedRow(email, "role %q must be <applicationId>:<roleName>", value), nil
+		}
+
+		application, message, err := r.application(ctx, repository, rawApplicationID)
+		if err != nil {
+			return rowResult{}, err
+		}
+
+		if application == nil {
+			return failedRow(email, "%s", message), nil
+		}
+
+		roleID := uuid.Nil
+		for _, role := range application.roles {
+			if role.Name == roleName {
+				roleID = role.ID
+				break
+			}
+		}
+
+		if roleID == uuid.Nil {
+			return failedRow(email, "role %q does not exist in application %s", roleName, rawApplicationID), nil
+		}
+
+		roleIDs = append(roleIDs, roleID)
+	}
+
+	externalIdentities := make([]importExternalIdentity, 0, len(record.ExternalIdentities))
+	for _, value := range record.ExternalIdentities {
+		parts := strings.SplitN(value, ":", 3)
+		if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+			return failedRow(email, "external identity %q must be <applicationId>:<provider>:<providerUserId>", value), nil
+		}
+
+		application, message, err := r.application(ctx, repository, parts[0])
+		if err != nil {
+			return rowResult{}, err
+		}
+
+		if application == nil {
+			return failedRow(email, "%s", message), nil
+		}
+
+		provider, err := r.provider(ctx, repository, application, parts[1])
+		if err != nil {
+			return rowResult{}, err
+		}
+
+		if provider == nil {
+			return failedRow(email, "provider %q is not configured in application %s", parts[1], parts[0]), nil
+		}
+
+		externalIdentities = append(externalIdentities, importExternalIdentity{provider: provider, providerUserID: parts[2]})
+	}
+
+	// Users that already exist are left untouched, which also makes importing
+	// the same file again harmless.
+	exists, err := repository.IsUserExistsByEmail(ctx, email, r.tenantID)
+	if err != nil {
+		return rowResult{}, err
+	}
+
+	if exists {
+		return rowResult{outcome: rowSkipped, email: email}, nil
+	}
+
+	if r.dryRun {
+		return rowResult{outcome: rowImported, email: email}, nil
+	}
+
+	user, err := entities.CreateTenantUser(email, r.tenantID, false)
+	if err != nil {
+		return rowResult{}, err
+	}
+
+	user.IsEmailConfirmed = record.EmailConfirmed
+	if record.IsActive != nil {
+		user.IsActive = *record.IsActive
+	}
+
+	displayName := strings.TrimSpace(record.DisplayName)
+	if displayName == "" {
+		displayName = strings.TrimSpace(record.FirstName + " " + record.LastName)
+	}
+
+	userProfile := entities.NewUserProfile(
+		user.ID,
+		strings.TrimSpace(record.FirstName),
+		strings.TrimSpace(record.LastName),
+		displayName,
+		record.PhoneNumber,
+		record.Address,
+		record.PhotoURL,
+	)
+
+	if err := repository.AddUser(ctx, user); err != nil {
+		return rowResult{}, err
+	}
+
+	if err := repository.AddUserProfile(ctx, userProfile); err != nil {
+		return rowResult{}, err
+	}
+
+	// Imported hashes are verified as-is and upgraded to Argon2id on the
+	// first successful login.
+	if record.PasswordHash != "" {
+		userCredentials := entities.NewUserCredentials(user.ID, record.PasswordHash, false)
+		userCredentials.PasswordAlgorithm = passwordAlgorithm
+
+		if err := repository.AddUserCredentials(ctx, userCredentials); err != nil {
+			return rowResult{}, err
+		}
+	}
+
+	for _, roleID := range roleIDs {
+		if err := repository.AddUserRole(ctx, &entities.UserRole{UserID: user.ID, RoleID: roleID, CreatedAt: time.Now()}); err != nil {
+			return rowResult{}, err
+		}
+	}
+
+	for _, identity := range externalIdentities {
+		externalIdentity := entities.CreateExternalIdentity(
+			user.ID,
+			email,
+			identity.provider.Name,
+			identity.providerUserID,
+			identity.provider.ID,
+		)
+
+		if err := repository.AddExternalIdentity(ctx, externalIdentity); err != nil {
+			return rowResult{}, err
+		}
+	}
+
+	return rowResult{outcome: rowImported, email: email}, nil
+}
+
+// application returns the cached application referenced by a row, or a
+// message when it is not an application of the tenant.
+func (r *rowImporter) application(ctx context.Context, repository IRepository, rawApplicationID string) (*importApplication, string, error) {
+	applicationID, err := uuid.Parse(rawApplicationID)
+	if err != nil {
+		return nil, fmt.Sprintf("%q is not a valid application ID", rawApplicationID), nil
+	}
+
+	cached, ok := r.applications[applicationID]
+	if !ok {
+		application, err := repository.GetApplicationByID(ctx, applicationID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if application != nil && application.TenantID == r.tenantID {
+			roles, err := repository.ListRolesFromApplication(ctx, applicationID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			cached = &importApplication{id: applicationID, providers: map[string]*entities.ApplicationOAuthProvider{}}
+			if roles != nil {
+				cached.roles = *roles
+			}
+		}
+
+		r.applications[applicationID] = cached
+	}
+
+	if cached == nil {
+		return nil, fmt.Sprintf("application %s does not exist in this tenant", rawApplicationID), nil
+	}
+
+	return cached, "", nil
+}
+
+func (r *rowImporter) provider(ctx context.Context, repository IRepository, application *importApplication, name string) (*entities.ApplicationOAuthProvider, error) {
+	if provider, ok := application.providers[name]; ok {
+		return provider, nil
+	}
+
+	provider, err := repository.GetApplicationOauthProviderByName(ctx, application.id, name)
+	if err != nil {
+		return nil, err
+	}
+
+	application.providers[name] = provider
+
+	return provider, nil
+}
+
+// validateRecord checks the fields of a row against the limits of the user
+// tables, returning a message describing the first problem found.
+func validateRecord(email string, record application_utils.UserTransferRecord) string {
+	if email == "" {
+		return "email is required"
+	}
+
+	if len(email) > 128 || !application_utils.EmailValidator(email) {
+		return "email is not a valid e-mail address"
+	}
+
+	fields := []struct {
+		name  string
+		value *string
+	}{
+		{"first_name", &record.FirstName},
+		{"last_name", &record.LastName},
+		{"display_name", &record.DisplayName},
+		{"phone_number", record.PhoneNumber},
+		{"address", record.Address},
+		{"photo_url", record.PhotoURL},
+		{"password_hash", &record.PasswordHash},
+	}
+
+	for _, field := range fields {
+		if field.value != nil && len(*field.value) > 255 {
+			return fmt.Sprintf("%s must be at most 255 characters", field.name)
+		}
+	}
+
+	return ""
+}
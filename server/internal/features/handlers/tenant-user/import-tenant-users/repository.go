@@ -0,0 +1,51 @@
+package importtenantusers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	AddUserImportJob(ctx context.Context, job *entities.UserImportJob) (bool, error)
+	UpdateUserImportJob(ctx context.Context, job *entities.UserImportJob) error
+	TouchUserImportJob(ctx context.Context, jobID uuid.UUID, now time.Time) error
+	GetUserImportJobByIdempotencyKey(ctx context.Context, tenantID uuid.UUID, idempotencyKey string) (*entities.UserImportJob, error)
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	ListRolesFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationRole, error)
+	GetApplicationOauthProviderByName(ctx context.Context, applicationID uuid.UUID, name string) (*entities.ApplicationOAuthProvider, error)
+	IsUserExistsByEmail(ctx context.Context, email string, tenantID uuid.UUID) (bool, error)
+	AddUser(ctx context.Context, user *entities.TenantUser) error
+	AddUserProfile(ctx context.Context, userProfile *entities.UserProfile) error
+	AddUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
+	AddUserRole(ctx context.Context, userRole *entities.UserRole) error
+	AddExternalIdentity(ctx context.Context, newExternalIdentity *entities.ExternalIdentity) error
+}
+
+type Repository struct {
+	repositories.UserImportJobRepository
+	repositories.ApplicationRepository
+	repositories.RoleRepository
+	repositories.OAuthProviderRepository
+	repositories.UserRepository
+	repositories.UserProfileRepository
+	repositories.UserCredentialsRepository
+	repositories.ExternalIdentityRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		UserImportJobRepository:    repositories.UserImportJobRepository{Store: q},
+		ApplicationRepository:      repositories.ApplicationRepository{Store: q},
+		RoleRepository:             repositories.RoleRepository{Store: q},
+		OAuthProviderRepository:    repositories.OAuthProviderRepository{Store: q},
+		UserRepository:             repositories.UserRepository{Store: q},
+		UserProfileRepository:      repositories.UserProfileRepository{Store: q},
+		UserCredentialsRepository:  repositories.UserCredentialsRepository{Store: q},
+		ExternalIdentityRepository: repositories.ExternalIdentityRepository{Store: q},
+	}
+}
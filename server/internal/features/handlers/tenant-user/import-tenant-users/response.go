@@ -0,0 +1,34 @@
+package importtenantusers
+
+import (
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+type Response struct {
+	ID             uuid.UUID `json:"id"`
+	IdempotencyKey string    `json:"idempotencyKey"`
+	Format         string    `json:"format"`
+	DryRun         bool      `json:"dryRun"`
+	Status         string    `json:"status"`
+	TotalRows      int       `json:"totalRows"`
+	CreatedAt      time.Time `json:"createdAt"`
+
+	// job is only set when the request created the job, so the endpoint
+	// knows to start processing it.
+	job *entities.UserImportJob
+}
+
+func newResponse(job *entities.UserImportJob) *Response {
+	return &Response{
+		ID:             job.ID,
+		IdempotencyKey: job.IdempotencyKey,
+		Format:         job.Format,
+		DryRun:         job.DryRun,
+		Status:         job.Status,
+		TotalRows:      job.TotalRows,
+		CreatedAt:      job.CreatedAt,
+	}
+}
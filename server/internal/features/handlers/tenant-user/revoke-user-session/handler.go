@@ -3,6 +3,7 @@ package revokeusersession
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/errors"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -18,9 +19,24 @@ func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
 }
 
 func (s *Handler) Handler(ctx context.Context, request Command) error {
-	if err := s.repository.RevokeRefreshTokenByID(ctx, request.SessionID); err != nil {
+	refreshToken, err := s.repository.GetRefreshTokenByID(ctx, request.SessionID)
+
+	if err != nil {
+		return err
+	}
+
+	if refreshToken == nil || refreshToken.UserID != request.UserID {
+		return &errors.ErrSessionNotFound
+	}
+
+	if err := s.repository.RevokeRefreshTokenByID(ctx, refreshToken.ID); err != nil {
 		return err
 	}
 
+	// Revoking the session also invalidates access tokens already issued for it.
+	if refreshToken.SessionID != nil {
+		return s.repository.RevokeUserSessionByID(ctx, *refreshToken.SessionID, refreshToken.UserID)
+	}
+
 	return nil
 }
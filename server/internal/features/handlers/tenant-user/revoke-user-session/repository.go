@@ -3,21 +3,27 @@ package revokeusersession
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/entities"
+
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 	"github.com/google/uuid"
 )
 
 type IRepository interface {
+	GetRefreshTokenByID(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error)
 	RevokeRefreshTokenByID(ctx context.Context, sessionID uuid.UUID) error
+	RevokeUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) error
 }
 
 type Repository struct {
 	repositories.RefreshTokenRepository
+	repositories.UserSessionRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		RefreshTokenRepository: repositories.RefreshTokenRepository{Store: q},
+		UserSessionRepository:  repositories.UserSessionRepository{Store: q},
 	}
 }
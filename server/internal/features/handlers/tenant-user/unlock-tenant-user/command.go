@@ -0,0 +1,13 @@
+package unlocktenantuser
+
+import (
+	"github.com/google/uuid"
+)
+
+type Command struct {
+	TenantID  uuid.UUID
+	UserID    uuid.UUID
+	AdminID   uuid.UUID
+	IPAddress string
+	UserAgent string
+}
@@ -0,0 +1,45 @@
+package unlocktenantuser
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+)
+
+type Handler struct {
+	repository IRepository
+}
+
+func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
+	return &Handler{
+		repository: NewRepository(q),
+	}
+}
+
+// Handler clears the user's failed logins and lockout backoff. Unlocking a
+// user without failed logins is a no-op.
+func (s *Handler) Handler(ctx context.Context, request Command) error {
+	lockout, err := s.repository.GetUserLockoutByUserID(ctx, request.UserID)
+
+	if err != nil {
+		return err
+	}
+
+	if lockout == nil {
+		return nil
+	}
+
+	if err := s.repository.RemoveUserLockout(ctx, request.UserID); err != nil {
+		return err
+	}
+
+	// The lockout remembers the application of the last failed login
+	details := "unlocked_by: " + request.AdminID.String()
+	auditLog := entities.NewAuditLog(request.UserID, lockout.ApplicationID,
+		constants.AuditEventAccountUnlocked, request.IPAddress, request.UserAgent, "success", &details)
+
+	return s.repository.AddAuditLog(ctx, auditLog)
+}
@@ -0,0 +1,74 @@
+package unlocktenantuser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockUnlockRepo struct {
+	mock.Mock
+}
+
+func (m *mockUnlockRepo) GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserLockout), args.Error(1)
+}
+
+func (m *mockUnlockRepo) RemoveUserLockout(ctx context.Context, userID uuid.UUID) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+func (m *mockUnlockRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
+func newCommand() Command {
+	tenantID, _ := uuid.NewV7()
+	userID, _ := uuid.NewV7()
+	adminID, _ := uuid.NewV7()
+
+	return Command{TenantID: tenantID, UserID: userID, AdminID: adminID, IPAddress: "127.0.0.1", UserAgent: "test"}
+}
+
+func TestHandler_UnlockTenantUser(t *testing.T) {
+	repo := new(mockUnlockRepo)
+	cmd := newCommand()
+	appID, _ := uuid.NewV7()
+	lockedUntil := time.Now().UTC().Add(time.Hour)
+
+	repo.On("GetUserLockoutByUserID", mock.Anything, cmd.UserID).Return(&entities.UserLockout{
+		UserID: cmd.UserID, ApplicationID: appID, LockoutCount: 2, LockedUntil: &lockedUntil,
+	}, nil)
+	repo.On("RemoveUserLockout", mock.Anything, cmd.UserID).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventAccountUnlocked &&
+			*auditLog.UserID == cmd.UserID && *auditLog.ApplicationID == appID &&
+			*auditLog.Details == "unlocked_by: "+cmd.AdminID.String()
+	})).Return(nil)
+
+	h := &Handler{repository: repo}
+	assert.NoError(t, h.Handler(context.Background(), cmd))
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_UnlockTenantUser_NotLocked(t *testing.T) {
+	repo := new(mockUnlockRepo)
+	cmd := newCommand()
+
+	repo.On("GetUserLockoutByUserID", mock.Anything, cmd.UserID).Return(nil, nil)
+
+	h := &Handler{repository: repo}
+	assert.NoError(t, h.Handler(context.Background(), cmd))
+	repo.AssertNotCalled(t, "RemoveUserLockout", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "AddAuditLog", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,28 @@
+package unlocktenantuser
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+)
+
+type IRepository interface {
+	GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error)
+	RemoveUserLockout(ctx context.Context, userID uuid.UUID) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
+}
+
+type Repository struct {
+	repositories.UserLockoutRepository
+	repositories.AuditLogRepository
+}
+
+func NewRepository(q *pgstore.Queries) Repository {
+	return Repository{
+		UserLockoutRepository: repositories.UserLockoutRepository{Store: q},
+		AuditLogRepository:    repositories.AuditLogRepository{Store: q},
+	}
+}
@@ -1,7 +1,29 @@
 package createtenant
 
+import "github.com/google/uuid"
+
 type Command struct {
-	Name               string  `json:"name" validate:"required"`
-	Description        *string `json:"description" validate:"omitempty"`
-	PasswordHashSecret string  `json:"passwordHashSecret" validate:"required,min=32,max=258"`
+	Name                      string    `json:"name" validate:"required"`
+	Description               *string   `json:"description" validate:"omitempty"`
+	PasswordHashSecret        string    `json:"passwordHashSecret" validate:"omitempty,min=32,max=258"`
+	PasswordHashMemory        *int      `json:"passwordHashMemory" validate:"omitempty,min=19456,max=262144"`
+	PasswordHashIterations    *int      `json:"passwordHashIterations" validate:"omitempty,min=1,max=10"`
+	PasswordHashParallelism   *int      `json:"passwordHashParallelism" validate:"omitempty,min=1,max=16"`
+	PasswordMinLength         *int      `json:"passwordMinLength" validate:"omitempty,min=8,max=128"`
+	PasswordRequireUppercase  *bool     `json:"passwordRequireUppercase"`
+	PasswordRequireLowercase  *bool     `json:"passwordRequireLowercase"`
+	PasswordRequireDigit      *bool     `json:"passwordRequireDigit"`
+	PasswordRequireSymbol     *bool     `json:"passwordRequireSymbol"`
+	PasswordMaxAgeDays        *int      `json:"passwordMaxAgeDays" validate:"omitempty,min=0,max=3650"`
+	PasswordHistoryCount      *int      `json:"passwordHistoryCount" validate:"omitempty,min=0,max=24"`
+	PasswordForbiddenWords    []string  `json:"passwordForbiddenWords" validate:"omitempty,max=100,dive,min=3,max=64"`
+	BreachedPasswordCheck     *bool     `json:"breachedPasswordCheck"`
+	BreachedPasswordThreshold *int      `json:"breachedPasswordThreshold" validate:"omitempty,min=1"`
+	LockoutThreshold          *int      `json:"lockoutThreshold" validate:"omitempty,min=0,max=100"`
+	LockoutDurationSeconds    *int      `json:"lockoutDurationSeconds" validate:"omitempty,min=1"`
+	LockoutMaxDurationSeconds *int      `json:"lockoutMaxDurationSeconds" validate:"omitempty,min=1"`
+	LockoutNotifyUser         *bool     `json:"lockoutNotifyUser"`
+	AdminID                   uuid.UUID `json:"-"` // injected server-side
+	IPAddress                 string    `json:"-"` // injected server-side
+	UserAgent                 string    `json:"-"` // injected server-side
 }
@@ -2,8 +2,13 @@ package createtenant
 
 import (
 	"context"
+	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/breach"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -21,10 +26,79 @@ func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Command, *Response] {
 func (s *Handler) Handler(ctx context.Context, command Command) (*Response, error) {
 	newTenant := entities.NewTenant(command.Name, command.Description, command.PasswordHashSecret)
 
+	if command.PasswordHashMemory != nil {
+		newTenant.PasswordHashMemory = *command.PasswordHashMemory
+	}
+	if command.PasswordHashIterations != nil {
+		newTenant.PasswordHashIterations = *command.PasswordHashIterations
+	}
+	if command.PasswordHashParallelism != nil {
+		newTenant.PasswordHashParallelism = *command.PasswordHashParallelism
+	}
+	if command.PasswordMinLength != nil {
+		newTenant.PasswordMinLength = *command.PasswordMinLength
+	}
+	if command.PasswordRequireUppercase != nil {
+		newTenant.PasswordRequireUppercase = *command.PasswordRequireUppercase
+	}
+	if command.PasswordRequireLowercase != nil {
+		newTenant.PasswordRequireLowercase = *command.PasswordRequireLowercase
+	}
+	if command.PasswordRequireDigit != nil {
+		newTenant.PasswordRequireDigit = *command.PasswordRequireDigit
+	}
+	if command.PasswordRequireSymbol != nil {
+		newTenant.PasswordRequireSymbol = *command.PasswordRequireSymbol
+	}
+	if command.PasswordMaxAgeDays != nil {
+		newTenant.PasswordMaxAgeDays = *command.PasswordMaxAgeDays
+	}
+	if command.PasswordHistoryCount != nil {
+		newTenant.PasswordHistoryCount = *command.PasswordHistoryCount
+	}
+	if command.PasswordForbiddenWords != nil {
+		newTenant.PasswordForbiddenWords = command.PasswordForbiddenWords
+	}
+	if command.BreachedPasswordCheck != nil {
+		if *command.BreachedPasswordCheck && !breach.Loaded() {
+			return nil, &errors.ErrBreachedPasswordCorpusNotLoaded
+		}
+
+		newTenant.BreachedPasswordCheck = *command.BreachedPasswordCheck
+	}
+	if command.BreachedPasswordThreshold != nil {
+		newTenant.BreachedPasswordThreshold = *command.BreachedPasswordThreshold
+	}
+	if command.LockoutThreshold != nil {
+		newTenant.LockoutThreshold = *command.LockoutThreshold
+	}
+	if command.LockoutDurationSeconds != nil {
+		newTenant.LockoutDuration = time.Duration(*command.LockoutDurationSeconds) * time.Second
+	}
+	if command.LockoutMaxDurationSeconds != nil {
+		newTenant.LockoutMaxDuration = time.Duration(*command.LockoutMaxDurationSeconds) * time.Second
+	}
+	if command.LockoutNotifyUser != nil {
+		newTenant.LockoutNotifyUser = *command.LockoutNotifyUser
+	}
+
 	if err := s.repository.AddTenant(ctx, newTenant); err != nil {
 		return nil, err
 	}
 
+	details, err := application_utils.AuditLogChanges(newTenant.ID, nil, application_utils.TenantAuditLogFields(newTenant))
+
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog := entities.NewTenantAuditLog(newTenant.ID, &command.AdminID, nil,
+		constants.AuditEventTenantCreated, command.IPAddress, command.UserAgent, "success", details)
+
+	if err := s.repository.AddAuditLog(ctx, auditLog); err != nil {
+		return nil, err
+	}
+
 	return &Response{
 		ID:          newTenant.ID,
 		Name:        newTenant.Name,
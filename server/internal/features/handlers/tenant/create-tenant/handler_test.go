@@ -3,9 +3,12 @@ package createtenant
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/gate-keeper/internal/domain/constants"
 	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -22,6 +25,10 @@ func (m *mockCreateOrgRepo) AddTenant(ctx context.Context, tenant *entities.Tena
 	return m.Called(ctx, tenant).Error(0)
 }
 
+func (m *mockCreateOrgRepo) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
+	return m.Called(ctx, auditLog).Error(0)
+}
+
 var _ IRepository = (*mockCreateOrgRepo)(nil)
 
 // ---------------------------------------------------------------------------
@@ -31,14 +38,24 @@ var _ IRepository = (*mockCreateOrgRepo)(nil)
 func TestHandler_CreateTenant_Success(t *testing.T) {
 	repo := new(mockCreateOrgRepo)
 	desc := "Test org description"
+	adminID, _ := uuid.NewV7()
 
 	repo.On("AddTenant", mock.Anything, mock.AnythingOfType("*entities.Tenant")).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *entities.AuditLog) bool {
+		return auditLog.EventType == constants.AuditEventTenantCreated &&
+			*auditLog.UserID == adminID &&
+			auditLog.ApplicationID == nil &&
+			strings.Contains(*auditLog.Details, `"name":{"before":null,"after":"My Tenant"}`) &&
+			!strings.Contains(*auditLog.Details, "this-is-a-32-char-secret-key")
+	})).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.AnythingOfType("*entities.AuditLog")).Return(nil)
 
 	h := &Handler{repository: repo}
 	resp, err := h.Handler(context.Background(), Command{
 		Name:               "My Tenant",
 		Description:        &desc,
 		PasswordHashSecret: "this-is-a-32-char-secret-key!!!1",
+		AdminID:            adminID,
 	})
 
 	require.NoError(t, err)
@@ -54,6 +71,7 @@ func TestHandler_CreateTenant_NilDescription(t *testing.T) {
 	repo := new(mockCreateOrgRepo)
 
 	repo.On("AddTenant", mock.Anything, mock.AnythingOfType("*entities.Tenant")).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.AnythingOfType("*entities.AuditLog")).Return(nil)
 
 	h := &Handler{repository: repo}
 	resp, err := h.Handler(context.Background(), Command{
@@ -85,3 +103,60 @@ func TestHandler_CreateTenant_RepositoryError(t *testing.T) {
 	assert.Equal(t, "database connection refused", err.Error())
 	repo.AssertExpectations(t)
 }
+
+func TestHandler_CreateTenant_PasswordPolicy(t *testing.T) {
+	repo := new(mockCreateOrgRepo)
+	minLength := 12
+	requireSymbol := true
+	historyCount := 5
+
+	repo.On("AddTenant", mock.Anything, mock.MatchedBy(func(tenant *entities.Tenant) bool {
+		return tenant.PasswordMinLength == 12 &&
+			tenant.PasswordRequireSymbol &&
+			!tenant.PasswordRequireDigit &&
+			tenant.PasswordHistoryCount == 5 &&
+			tenant.PasswordMaxAgeDays == 0 &&
+			assert.ObjectsAreEqual([]string{"acme"}, tenant.PasswordForbiddenWords)
+	})).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.AnythingOfType("*entities.AuditLog")).Return(nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{
+		Name:                   "Acme",
+		PasswordMinLength:      &minLength,
+		PasswordRequireSymbol:  &requireSymbol,
+		PasswordHistoryCount:   &historyCount,
+		PasswordForbiddenWords: []string{"acme"},
+	})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_CreateTenant_DefaultPasswordPolicy(t *testing.T) {
+	repo := new(mockCreateOrgRepo)
+
+	repo.On("AddTenant", mock.Anything, mock.MatchedBy(func(tenant *entities.Tenant) bool {
+		return tenant.PasswordMinLength == constants.DefaultPasswordMinLength &&
+			tenant.PasswordHistoryCount == 0 &&
+			tenant.PasswordForbiddenWords != nil
+	})).Return(nil)
+	repo.On("AddAuditLog", mock.Anything, mock.AnythingOfType("*entities.AuditLog")).Return(nil)
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{Name: "Defaults"})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestHandler_CreateTenant_BreachedPasswordCheckWithoutDataset(t *testing.T) {
+	repo := new(mockCreateOrgRepo)
+	enabled := true
+
+	h := &Handler{repository: repo}
+	_, err := h.Handler(context.Background(), Command{Name: "Acme", BreachedPasswordCheck: &enabled})
+
+	assert.ErrorIs(t, err, &errors.ErrBreachedPasswordCorpusNotLoaded)
+	repo.AssertNotCalled(t, "AddTenant", mock.Anything, mock.Anything)
+}
@@ -10,14 +10,17 @@ import (
 
 type IRepository interface {
 	AddTenant(ctx context.Context, application *entities.Tenant) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.TenantRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
-		TenantRepository: repositories.TenantRepository{Store: q},
+		TenantRepository:   repositories.TenantRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
 	}
 }
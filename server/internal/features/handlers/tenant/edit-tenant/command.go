@@ -3,14 +3,55 @@ package edittenant
 import "github.com/google/uuid"
 
 type Command struct {
-	ID                 uuid.UUID
-	Name               string
-	Description        *string
-	PasswordHashSecret *string
+	ID                        uuid.UUID
+	Name                      string
+	Description               *string
+	PasswordHashSecret        *string
+	PasswordHashMemory        *int
+	PasswordHashIterations    *int
+	PasswordHashParallelism   *int
+	PasswordMinLength         *int
+	PasswordRequireUppercase  *bool
+	PasswordRequireLowercase  *bool
+	PasswordRequireDigit      *bool
+	PasswordRequireSymbol     *bool
+	PasswordMaxAgeDays        *int
+	PasswordHistoryCount      *int
+	PasswordForbiddenWords    []string
+	BreachedPasswordCheck     *bool
+	BreachedPasswordThreshold *int
+	LockoutThreshold          *int
+	LockoutDurationSeconds    *int
+	LockoutMaxDurationSeconds *int
+	LockoutNotifyUser         *bool
+	AdminID                   uuid.UUID
+	IPAddress                 string
+	UserAgent                 string
 }
 
 type RequestBody struct {
-	Name               string  `json:"name" validate:"required"`
-	Description        *string `json:"description" validate:"omitempty"`
-	PasswordHashSecret *string `json:"passwordHashSecret" validate:"omitempty,min=32,max=258"`
+	Name        string  `json:"name" validate:"required"`
+	Description *string `json:"description" validate:"omitempty"`
+	// The previous secret is kept to verify and rehash passwords on login.
+	// Passwords hashed with the one before it no longer verify.
+	PasswordHashSecret      *string `json:"passwordHashSecret" validate:"omitempty,min=32,max=258"`
+	PasswordHashMemory      *int    `json:"passwordHashMemory" validate:"omitempty,min=19456,max=262144"`
+	PasswordHashIterations  *int    `json:"passwordHashIterations" validate:"omitempty,min=1,max=10"`
+	PasswordHashParallelism *int    `json:"passwordHashParallelism" validate:"omitempty,min=1,max=16"`
+	// Omitted password policy fields keep their current value.
+	PasswordMinLength         *int     `json:"passwordMinLength" validate:"omitempty,min=8,max=128"`
+	PasswordRequireUppercase  *bool    `json:"passwordRequireUppercase"`
+	PasswordRequireLowercase  *bool    `json:"passwordRequireLowercase"`
+	PasswordRequireDigit      *bool    `json:"passwordRequireDigit"`
+	PasswordRequireSymbol     *bool    `json:"passwordRequireSymbol"`
+	PasswordMaxAgeDays        *int     `json:"passwordMaxAgeDays" validate:"omitempty,min=0,max=3650"`
+	PasswordHistoryCount      *int     `json:"passwordHistoryCount" validate:"omitempty,min=0,max=24"`
+	PasswordForbiddenWords    []string `json:"passwordForbiddenWords" validate:"omitempty,max=100,dive,min=3,max=64"`
+	BreachedPasswordCheck     *bool    `json:"breachedPasswordCheck"`
+	BreachedPasswordThreshold *int     `json:"breachedPasswordThreshold" validate:"omitempty,min=1"`
+	// A lockout threshold of 0 disables account lockout.
+	LockoutThreshold          *int  `json:"lockoutThreshold" validate:"omitempty,min=0,max=100"`
+	LockoutDurationSeconds    *int  `json:"lockoutDurationSeconds" validate:"omitempty,min=1"`
+	LockoutMaxDurationSeconds *int  `json:"lockoutMaxDurationSeconds" validate:"omitempty,min=1"`
+	LockoutNotifyUser         *bool `json:"lockoutNotifyUser"`
 }
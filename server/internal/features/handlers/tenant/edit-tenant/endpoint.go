@@ -3,6 +3,7 @@ package edittenant
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/go-chi/chi"
@@ -29,9 +30,30 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	}
 
 	command := Command{
-		ID:          tenantIdUUID,
-		Name:        requestBody.Name,
-		Description: requestBody.Description,
+		ID:                        tenantIdUUID,
+		Name:                      requestBody.Name,
+		Description:               requestBody.Description,
+		PasswordHashSecret:        requestBody.PasswordHashSecret,
+		PasswordHashMemory:        requestBody.PasswordHashMemory,
+		PasswordHashIterations:    requestBody.PasswordHashIterations,
+		PasswordHashParallelism:   requestBody.PasswordHashParallelism,
+		PasswordMinLength:         requestBody.PasswordMinLength,
+		PasswordRequireUppercase:  requestBody.PasswordRequireUppercase,
+		PasswordRequireLowercase:  requestBody.PasswordRequireLowercase,
+		PasswordRequireDigit:      requestBody.PasswordRequireDigit,
+		PasswordRequireSymbol:     requestBody.PasswordRequireSymbol,
+		PasswordMaxAgeDays:        requestBody.PasswordMaxAgeDays,
+		PasswordHistoryCount:      requestBody.PasswordHistoryCount,
+		PasswordForbiddenWords:    requestBody.PasswordForbiddenWords,
+		BreachedPasswordCheck:     requestBody.BreachedPasswordCheck,
+		BreachedPasswordThreshold: requestBody.BreachedPasswordThreshold,
+		LockoutThreshold:          requestBody.LockoutThreshold,
+		LockoutDurationSeconds:    requestBody.LockoutDurationSeconds,
+		LockoutMaxDurationSeconds: requestBody.LockoutMaxDurationSeconds,
+		LockoutNotifyUser:         requestBody.LockoutNotifyUser,
+		AdminID:                   http_router.GetUserIDFromContext(request.Context()),
+		IPAddress:                 clientip.RealIP(request),
+		UserAgent:                 request.UserAgent(),
 	}
 
 	params := repositories.Params[Command, Handler]{
@@ -4,7 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
+	"github.com/gate-keeper/internal/infra/breach"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -30,18 +34,86 @@ func (s *Handler) Handler(ctx context.Context, command Command) error {
 		return &errors.ErrTenantNotFound
 	}
 
+	before := application_utils.TenantAuditLogFields(tenant)
 	utcNow := time.Now().UTC()
 
 	tenant.Name = command.Name
 	tenant.Description = command.Description
-	if command.PasswordHashSecret != nil {
+	// The replaced pepper keeps verifying passwords hashed with it until
+	// their users sign in and get rehashed with the new one.
+	if command.PasswordHashSecret != nil && *command.PasswordHashSecret != tenant.PasswordHashSecret {
+		tenant.PreviousPasswordHashSecret = tenant.PasswordHashSecret
 		tenant.PasswordHashSecret = *command.PasswordHashSecret
 	}
+	if command.PasswordHashMemory != nil {
+		tenant.PasswordHashMemory = *command.PasswordHashMemory
+	}
+	if command.PasswordHashIterations != nil {
+		tenant.PasswordHashIterations = *command.PasswordHashIterations
+	}
+	if command.PasswordHashParallelism != nil {
+		tenant.PasswordHashParallelism = *command.PasswordHashParallelism
+	}
+	if command.PasswordMinLength != nil {
+		tenant.PasswordMinLength = *command.PasswordMinLength
+	}
+	if command.PasswordRequireUppercase != nil {
+		tenant.PasswordRequireUppercase = *command.PasswordRequireUppercase
+	}
+	if command.PasswordRequireLowercase != nil {
+		tenant.PasswordRequireLowercase = *command.PasswordRequireLowercase
+	}
+	if command.PasswordRequireDigit != nil {
+		tenant.PasswordRequireDigit = *command.PasswordRequireDigit
+	}
+	if command.PasswordRequireSymbol != nil {
+		tenant.PasswordRequireSymbol = *command.PasswordRequireSymbol
+	}
+	if command.PasswordMaxAgeDays != nil {
+		tenant.PasswordMaxAgeDays = *command.PasswordMaxAgeDays
+	}
+	if command.PasswordHistoryCount != nil {
+		tenant.PasswordHistoryCount = *command.PasswordHistoryCount
+	}
+	if command.PasswordForbiddenWords != nil {
+		tenant.PasswordForbiddenWords = command.PasswordForbiddenWords
+	}
+	if command.BreachedPasswordCheck != nil {
+		if *command.BreachedPasswordCheck && !breach.Loaded() {
+			return &errors.ErrBreachedPasswordCorpusNotLoaded
+		}
+
+		tenant.BreachedPasswordCheck = *command.BreachedPasswordCheck
+	}
+	if command.BreachedPasswordThreshold != nil {
+		tenant.BreachedPasswordThreshold = *command.BreachedPasswordThreshold
+	}
+	if command.LockoutThreshold != nil {
+		tenant.LockoutThreshold = *command.LockoutThreshold
+	}
+	if command.LockoutDurationSeconds != nil {
+		tenant.LockoutDuration = time.Duration(*command.LockoutDurationSeconds) * time.Second
+	}
+	if command.LockoutMaxDurationSeconds != nil {
+		tenant.LockoutMaxDuration = time.Duration(*command.LockoutMaxDurationSeconds) * time.Second
+	}
+	if command.LockoutNotifyUser != nil {
+		tenant.LockoutNotifyUser = *command.LockoutNotifyUser
+	}
 	tenant.UpdatedAt = &utcNow
 
 	if err := s.repository.UpdateTenant(ctx, tenant); err != nil {
 		return err
 	}
 
-	return nil
+	details, err := application_utils.AuditLogChanges(tenant.ID, before, application_utils.TenantAuditLogFields(tenant))
+
+	if err != nil {
+		return err
+	}
+
+	auditLog := entities.NewTenantAuditLog(tenant.ID, &command.AdminID, nil,
+		constants.AuditEventTenantUpdated, command.IPAddress, command.UserAgent, "success", details)
+
+	return s.repository.AddAuditLog(ctx, auditLog)
 }
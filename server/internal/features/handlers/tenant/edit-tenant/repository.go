@@ -12,14 +12,17 @@ import (
 type IRepository interface {
 	UpdateTenant(ctx context.Context, application *entities.Tenant) error
 	GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error)
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.TenantRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
-		TenantRepository: repositories.TenantRepository{Store: q},
+		TenantRepository:   repositories.TenantRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
 	}
 }
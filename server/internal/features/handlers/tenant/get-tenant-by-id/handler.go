@@ -25,10 +25,27 @@ func (s *Handler) Handler(ctx context.Context, query Query) (*Response, error) {
 	}
 
 	return &Response{
-		ID:          tenant.ID,
-		Name:        tenant.Name,
-		CreatedAt:   tenant.CreatedAt,
-		UpdatedAt:   tenant.UpdatedAt,
-		Description: tenant.Description,
+		ID:                        tenant.ID,
+		Name:                      tenant.Name,
+		CreatedAt:                 tenant.CreatedAt,
+		UpdatedAt:                 tenant.UpdatedAt,
+		Description:               tenant.Description,
+		PasswordHashMemory:        tenant.PasswordHashMemory,
+		PasswordHashIterations:    tenant.PasswordHashIterations,
+		PasswordHashParallelism:   tenant.PasswordHashParallelism,
+		PasswordMinLength:         tenant.PasswordMinLength,
+		PasswordRequireUppercase:  tenant.PasswordRequireUppercase,
+		PasswordRequireLowercase:  tenant.PasswordRequireLowercase,
+		PasswordRequireDigit:      tenant.PasswordRequireDigit,
+		PasswordRequireSymbol:     tenant.PasswordRequireSymbol,
+		PasswordMaxAgeDays:        tenant.PasswordMaxAgeDays,
+		PasswordHistoryCount:      tenant.PasswordHistoryCount,
+		PasswordForbiddenWords:    tenant.PasswordForbiddenWords,
+		BreachedPasswordCheck:     tenant.BreachedPasswordCheck,
+		BreachedPasswordThreshold: tenant.BreachedPasswordThreshold,
+		LockoutThreshold:          tenant.LockoutThreshold,
+		LockoutDurationSeconds:    int(tenant.LockoutDuration.Seconds()),
+		LockoutMaxDurationSeconds: int(tenant.LockoutMaxDuration.Seconds()),
+		LockoutNotifyUser:         tenant.LockoutNotifyUser,
 	}, nil
 }
@@ -7,9 +7,26 @@ import (
 )
 
 type Response struct {
-	ID          uuid.UUID  `json:"id"`
-	Name        string     `json:"name"`
-	Description *string    `json:"description"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   *time.Time `json:"updated_at"`
+	ID                        uuid.UUID  `json:"id"`
+	Name                      string     `json:"name"`
+	Description               *string    `json:"description"`
+	PasswordHashMemory        int        `json:"password_hash_memory"`
+	PasswordHashIterations    int        `json:"password_hash_iterations"`
+	PasswordHashParallelism   int        `json:"password_hash_parallelism"`
+	PasswordMinLength         int        `json:"password_min_length"`
+	PasswordRequireUppercase  bool       `json:"password_require_uppercase"`
+	PasswordRequireLowercase  bool       `json:"password_require_lowercase"`
+	PasswordRequireDigit      bool       `json:"password_require_digit"`
+	PasswordRequireSymbol     bool       `json:"password_require_symbol"`
+	PasswordMaxAgeDays        int        `json:"password_max_age_days"`
+	PasswordHistoryCount      int        `json:"password_history_count"`
+	PasswordForbiddenWords    []string   `json:"password_forbidden_words"`
+	BreachedPasswordCheck     bool       `json:"breached_password_check"`
+	BreachedPasswordThreshold int        `json:"breached_password_threshold"`
+	LockoutThreshold          int        `json:"lockout_threshold"`
+	LockoutDurationSeconds    int        `json:"lockout_duration_seconds"`
+	LockoutMaxDurationSeconds int        `json:"lockout_max_duration_seconds"`
+	LockoutNotifyUser         bool       `json:"lockout_notify_user"`
+	CreatedAt                 time.Time  `json:"created_at"`
+	UpdatedAt                 *time.Time `json:"updated_at"`
 }
@@ -5,7 +5,6 @@ import (
 
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
-	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -14,9 +13,7 @@ type Endpoint struct {
 }
 
 func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
-	testId, _ := uuid.NewV7()
-
-	query := Query{UserID: testId}
+	query := Query{UserID: http_router.GetUserIDFromContext(request.Context())}
 
 	params := repositories.ParamsRs[Query, *[]Response, Handler]{
 		DbPool:  c.DbPool,
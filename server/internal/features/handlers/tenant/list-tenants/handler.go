@@ -2,9 +2,11 @@ package listtenants
 
 import (
 	"context"
+	"slices"
 
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
 )
 
 type Handler struct {
@@ -19,13 +21,34 @@ func New(q *pgstore.Queries) repositories.ServiceHandlerRs[Query, *[]Response] {
 
 func (s *Handler) Handler(ctx context.Context, query Query) (*[]Response, error) {
 	tenants := make([]Response, 0)
+	isPlatformAdmin, err := s.repository.IsPlatformAdmin(ctx, query.UserID)
+
+	if err != nil {
+		return nil, err
+	}
+
 	tenantsList, err := s.repository.ListTenants(ctx)
 
 	if err != nil {
 		return nil, err
 	}
 
+	// Tenant administrators only see the tenants they administer.
+	var adminTenantIDs []uuid.UUID
+
+	if !isPlatformAdmin {
+		adminTenantIDs, err = s.repository.ListAdminTenantIDs(ctx, query.UserID)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, tenant := range *tenantsList {
+		if !isPlatformAdmin && !slices.Contains(adminTenantIDs, tenant.ID) {
+			continue
+		}
+
 		tenants = append(tenants, Response{
 			ID:        tenant.ID,
 			Name:      tenant.Name,
@@ -27,6 +27,16 @@ func (m *mockListOrgsRepo) ListTenants(ctx context.Context) (*[]entities.Tenant,
 	return args.Get(0).(*[]entities.Tenant), args.Error(1)
 }
 
+func (m *mockListOrgsRepo) IsPlatformAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockListOrgsRepo) ListAdminTenantIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
 var _ IRepository = (*mockListOrgsRepo)(nil)
 
 // ---------------------------------------------------------------------------
@@ -41,6 +51,7 @@ func TestHandler_ListTenants_Success(t *testing.T) {
 		{ID: uuid.New(), Name: "Org 2", CreatedAt: time.Now().UTC()},
 	}
 
+	repo.On("IsPlatformAdmin", mock.Anything, mock.Anything).Return(true, nil)
 	repo.On("ListTenants", mock.Anything).Return(&orgs, nil)
 
 	h := &Handler{repository: repo}
@@ -58,6 +69,7 @@ func TestHandler_ListTenants_Empty(t *testing.T) {
 	repo := new(mockListOrgsRepo)
 
 	orgs := []entities.Tenant{}
+	repo.On("IsPlatformAdmin", mock.Anything, mock.Anything).Return(true, nil)
 	repo.On("ListTenants", mock.Anything).Return(&orgs, nil)
 
 	h := &Handler{repository: repo}
@@ -72,6 +84,7 @@ func TestHandler_ListTenants_Empty(t *testing.T) {
 func TestHandler_ListTenants_RepositoryError(t *testing.T) {
 	repo := new(mockListOrgsRepo)
 
+	repo.On("IsPlatformAdmin", mock.Anything, mock.Anything).Return(true, nil)
 	repo.On("ListTenants", mock.Anything).
 		Return((*[]entities.Tenant)(nil), fmt.Errorf("connection timeout"))
 
@@ -83,3 +96,25 @@ func TestHandler_ListTenants_RepositoryError(t *testing.T) {
 	assert.Equal(t, "connection timeout", err.Error())
 	repo.AssertExpectations(t)
 }
+
+func TestHandler_ListTenants_TenantAdminOnlySeesAdministeredTenants(t *testing.T) {
+	repo := new(mockListOrgsRepo)
+	userID := uuid.New()
+
+	orgs := []entities.Tenant{
+		{ID: uuid.New(), Name: "Org 1", CreatedAt: time.Now().UTC()},
+		{ID: uuid.New(), Name: "Org 2", CreatedAt: time.Now().UTC()},
+	}
+
+	repo.On("IsPlatformAdmin", mock.Anything, userID).Return(false, nil)
+	repo.On("ListTenants", mock.Anything).Return(&orgs, nil)
+	repo.On("ListAdminTenantIDs", mock.Anything, userID).Return([]uuid.UUID{orgs[1].ID}, nil)
+
+	h := &Handler{repository: repo}
+	resp, err := h.Handler(context.Background(), Query{UserID: userID})
+
+	require.NoError(t, err)
+	require.Len(t, *resp, 1)
+	assert.Equal(t, "Org 2", (*resp)[0].Name)
+	repo.AssertExpectations(t)
+}
@@ -6,18 +6,23 @@ import (
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
 )
 
 type IRepository interface {
 	ListTenants(ctx context.Context) (*[]entities.Tenant, error)
+	IsPlatformAdmin(ctx context.Context, userID uuid.UUID) (bool, error)
+	ListAdminTenantIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 }
 
 type Repository struct {
 	repositories.TenantRepository
+	repositories.AdminRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
 		TenantRepository: repositories.TenantRepository{Store: q},
+		AdminRepository:  repositories.AdminRepository{Store: q},
 	}
 }
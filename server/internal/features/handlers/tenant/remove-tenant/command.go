@@ -3,5 +3,8 @@ package removetenant
 import "github.com/google/uuid"
 
 type Command struct {
-	ID uuid.UUID `json:"id" validate:"required"`
+	ID        uuid.UUID `json:"id" validate:"required"`
+	AdminID   uuid.UUID
+	IPAddress string
+	UserAgent string
 }
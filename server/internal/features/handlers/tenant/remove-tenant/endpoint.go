@@ -3,6 +3,7 @@ package removetenant
 import (
 	"net/http"
 
+	"github.com/gate-keeper/internal/infra/clientip"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	http_router "github.com/gate-keeper/internal/presentation/http"
 	"github.com/go-chi/chi"
@@ -23,7 +24,10 @@ func (c *Endpoint) Http(writter http.ResponseWriter, request *http.Request) {
 	}
 
 	var command = Command{
-		ID: tenantIdUUID,
+		ID:        tenantIdUUID,
+		AdminID:   http_router.GetUserIDFromContext(request.Context()),
+		IPAddress: clientip.RealIP(request),
+		UserAgent: request.UserAgent(),
 	}
 
 	params := repositories.Params[Command, Handler]{
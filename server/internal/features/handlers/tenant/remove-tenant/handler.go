@@ -3,6 +3,10 @@ package removetenant
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	application_utils "github.com/gate-keeper/internal/features/utils"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 )
@@ -18,9 +22,29 @@ func New(q *pgstore.Queries) repositories.ServiceHandler[Command] {
 }
 
 func (s *Handler) Handler(ctx context.Context, command Command) error {
+	tenant, err := s.repository.GetTenantByID(ctx, command.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if tenant == nil {
+		return &errors.ErrTenantNotFound
+	}
+
 	if err := s.repository.RemoveTenant(ctx, command.ID); err != nil {
 		return err
 	}
 
-	return nil
+	details, err := application_utils.AuditLogChanges(tenant.ID, application_utils.TenantAuditLogFields(tenant), nil)
+
+	if err != nil {
+		return err
+	}
+
+	// The entry outlives the tenant, audit_log has no foreign key to it
+	auditLog := entities.NewTenantAuditLog(tenant.ID, &command.AdminID, nil,
+		constants.AuditEventTenantDeleted, command.IPAddress, command.UserAgent, "success", details)
+
+	return s.repository.AddAuditLog(ctx, auditLog)
 }
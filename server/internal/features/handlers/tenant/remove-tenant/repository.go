@@ -3,21 +3,26 @@ package removetenant
 import (
 	"context"
 
+	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/infra/database/repositories"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
 	"github.com/google/uuid"
 )
 
 type IRepository interface {
+	GetTenantByID(ctx context.Context, id uuid.UUID) (*entities.Tenant, error)
 	RemoveTenant(ctx context.Context, tenantID uuid.UUID) error
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 }
 
 type Repository struct {
 	repositories.TenantRepository
+	repositories.AuditLogRepository
 }
 
 func NewRepository(q *pgstore.Queries) Repository {
 	return Repository{
-		TenantRepository: repositories.TenantRepository{Store: q},
+		TenantRepository:   repositories.TenantRepository{Store: q},
+		AuditLogRepository: repositories.AuditLogRepository{Store: q},
 	}
 }
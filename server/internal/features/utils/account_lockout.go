@@ -0,0 +1,124 @@
+package application_utils
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	mailservice "github.com/gate-keeper/internal/infra/mail-service"
+	"github.com/google/uuid"
+)
+
+// TenantLockoutPolicy returns the account lockout policy configured on the
+// tenant.
+func TenantLockoutPolicy(tenant *entities.Tenant) services.LockoutPolicy {
+	return services.LockoutPolicy{
+		Threshold:   tenant.LockoutThreshold,
+		Duration:    tenant.LockoutDuration,
+		MaxDuration: tenant.LockoutMaxDuration,
+	}
+}
+
+// IAccountLockoutRepository is the subset of repository operations the
+// account lockout helpers need.
+type IAccountLockoutRepository interface {
+	UpsertUserLockout(ctx context.Context, lockout *entities.UserLockout) error
+	GetUserProfileByID(ctx context.Context, userID uuid.UUID) (*entities.UserProfile, error)
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
+}
+
+// PasswordAttempt identifies a wrong password typed for an account, wherever
+// it was typed: on login, on reauthentication or when changing the password.
+type PasswordAttempt struct {
+	User          *entities.TenantUser
+	Tenant        *entities.Tenant
+	ApplicationID uuid.UUID
+	IPAddress     string
+	UserAgent     string
+}
+
+// PasswordAttemptBlocked returns ErrAccountLocked while the account is locked
+// and ErrLoginThrottled until the delay after the last wrong password has
+// passed, along with the reason to audit. The password mustn't be checked
+// meanwhile, so guesses made then reveal nothing.
+func PasswordAttemptBlocked(policy services.LockoutPolicy, lockout *entities.UserLockout, now time.Time) (string, error) {
+	if lockout == nil || policy.Threshold <= 0 {
+		return "", nil
+	}
+
+	if lockout.IsLocked(now) {
+		return "account_locked", &errors.ErrAccountLocked
+	}
+
+	if now.Before(services.NextLoginAttemptAt(lockout)) {
+		return "throttled", &errors.ErrLoginThrottled
+	}
+
+	return "", nil
+}
+
+// RegisterFailedPassword counts a wrong password against the account and
+// locks it once the tenant's threshold is reached, auditing the lockout and
+// e-mailing the user when the tenant asks for it. It returns ErrAccountLocked
+// when this attempt locked the account and wrongPassword otherwise, wrapped
+// with repositories.CommitAndReturn so the count isn't rolled back with the
+// failed request.
+func RegisterFailedPassword(ctx context.Context, repository IAccountLockoutRepository, mailService mailservice.IMailService, attempt PasswordAttempt, lockout *entities.UserLockout, now time.Time, wrongPassword error) error {
+	policy := TenantLockoutPolicy(attempt.Tenant)
+
+	if policy.Threshold <= 0 {
+		return repositories.CommitAndReturn(wrongPassword)
+	}
+
+	if lockout == nil {
+		lockout = entities.NewUserLockout(attempt.User.ID, attempt.ApplicationID)
+	}
+
+	locked := services.RegisterFailedLogin(policy, lockout, attempt.ApplicationID, now)
+
+	if err := repository.UpsertUserLockout(ctx, lockout); err != nil {
+		return err
+	}
+
+	if !locked {
+		return repositories.CommitAndReturn(wrongPassword)
+	}
+
+	details := fmt.Sprintf("locked_until: %s, lockout_count: %d", lockout.LockedUntil.Format(time.RFC3339), lockout.LockoutCount)
+	auditLog := entities.NewAuditLog(attempt.User.ID, attempt.ApplicationID,
+		constants.AuditEventAccountLocked, attempt.IPAddress, attempt.UserAgent, "failure", &details)
+
+	if err := repository.AddAuditLog(ctx, auditLog); err != nil {
+		return err
+	}
+
+	if attempt.Tenant.LockoutNotifyUser {
+		userProfile, err := repository.GetUserProfileByID(ctx, attempt.User.ID)
+
+		if err != nil {
+			return err
+		}
+
+		userName := attempt.User.Email
+		if userProfile != nil {
+			userName = userProfile.FirstName
+		}
+
+		email := attempt.User.Email
+		lockedUntil := *lockout.LockedUntil
+
+		go func() {
+			if err := mailService.SendAccountLockedEmail(ctx, email, userName, lockedUntil); err != nil {
+				slog.ErrorContext(ctx, "Failed to send account locked e-mail", "error", err)
+			}
+		}()
+	}
+
+	return repositories.CommitAndReturn(&errors.ErrAccountLocked)
+}
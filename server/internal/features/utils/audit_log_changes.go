@@ -0,0 +1,145 @@
+package application_utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+// AuditLogFields is the audited state of an entity, keyed by the field name
+// written to the audit log. Secrets must never be part of it.
+type AuditLogFields map[string]any
+
+type auditLogChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+type auditLogChanges struct {
+	ID      uuid.UUID                 `json:"id"`
+	Changes map[string]auditLogChange `json:"changes"`
+}
+
+// AuditLogChanges describes an admin operation on the entity id for the
+// details of an audit entry as {"id": ..., "changes": {"field": {"before":
+// ..., "after": ...}}}, listing only the fields that differ. A nil before
+// records a creation and a nil after a deletion.
+func AuditLogChanges(id uuid.UUID, before, after AuditLogFields) (*string, error) {
+	changes := make(map[string]auditLogChange)
+
+	for field, value := range after {
+		changes[field] = auditLogChange{Before: before[field], After: value}
+	}
+
+	for field, value := range before {
+		if _, ok := after[field]; !ok {
+			changes[field] = auditLogChange{Before: value}
+		}
+	}
+
+	// Values are compared as they are written so pointers and the values
+	// they point to are equal
+	for field, change := range changes {
+		beforeJSON, err := json.Marshal(change.Before)
+		if err != nil {
+			return nil, err
+		}
+
+		afterJSON, err := json.Marshal(change.After)
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(beforeJSON, afterJSON) {
+			delete(changes, field)
+		}
+	}
+
+	details, err := json.Marshal(auditLogChanges{ID: id, Changes: changes})
+	if err != nil {
+		return nil, err
+	}
+
+	detailsString := string(details)
+	return &detailsString, nil
+}
+
+// TenantAuditLogFields leaves out the password hash secret.
+func TenantAuditLogFields(tenant *entities.Tenant) AuditLogFields {
+	return AuditLogFields{
+		"name":                      tenant.Name,
+		"description":               tenant.Description,
+		"isActive":                  tenant.IsActive,
+		"canSelfSignUp":             tenant.CanSelfSignUp,
+		"canSelfForgotPass":         tenant.CanSelfForgotPass,
+		"passwordHashMemory":        tenant.PasswordHashMemory,
+		"passwordHashIterations":    tenant.PasswordHashIterations,
+		"passwordHashParallelism":   tenant.PasswordHashParallelism,
+		"passwordMinLength":         tenant.PasswordMinLength,
+		"passwordRequireUppercase":  tenant.PasswordRequireUppercase,
+		"passwordRequireLowercase":  tenant.PasswordRequireLowercase,
+		"passwordRequireDigit":      tenant.PasswordRequireDigit,
+		"passwordRequireSymbol":     tenant.PasswordRequireSymbol,
+		"passwordMaxAgeDays":        tenant.PasswordMaxAgeDays,
+		"passwordHistoryCount":      tenant.PasswordHistoryCount,
+		"passwordForbiddenWords":    tenant.PasswordForbiddenWords,
+		"breachedPasswordCheck":     tenant.BreachedPasswordCheck,
+		"breachedPasswordThreshold": tenant.BreachedPasswordThreshold,
+		"lockoutThreshold":          tenant.LockoutThreshold,
+		"lockoutDurationSeconds":    int(tenant.LockoutDuration.Seconds()),
+		"lockoutMaxDurationSeconds": int(tenant.LockoutMaxDuration.Seconds()),
+		"lockoutNotifyUser":         tenant.LockoutNotifyUser,
+	}
+}
+
+func ApplicationAuditLogFields(application *entities.Application) AuditLogFields {
+	return AuditLogFields{
+		"name":                 application.Name,
+		"description":          application.Description,
+		"isActive":             application.IsActive,
+		"badges":               application.Badges,
+		"canSelfSignUp":        application.CanSelfSignUp,
+		"canSelfForgotPass":    application.CanSelfForgotPass,
+		"hasMfaEmail":          application.HasMfaEmail,
+		"hasMfaAuthApp":        application.HasMfaAuthApp,
+		"hasMfaPasskey":        application.HasMfaPasskey,
+		"requiresHighSecurity": application.RequiresHighSecurity,
+		"refreshTokenTtlDays":  application.RefreshTokenTTLDays,
+	}
+}
+
+// ApplicationSecretAuditLogFields leaves out the secret value.
+func ApplicationSecretAuditLogFields(secret *entities.ApplicationSecret) AuditLogFields {
+	return AuditLogFields{
+		"name":      secret.Name,
+		"expiresAt": secret.ExpiresAt,
+	}
+}
+
+// TenantUserAuditLogFields takes the profile and role ids of the user when
+// the caller has them. Roles are sorted so reordering them is no change.
+func TenantUserAuditLogFields(user *entities.TenantUser, profile *entities.UserProfile, roles []uuid.UUID) AuditLogFields {
+	fields := AuditLogFields{
+		"email":              user.Email,
+		"isActive":           user.IsActive,
+		"isEmailConfirmed":   user.IsEmailConfirmed,
+		"preferred2FAMethod": user.Preferred2FAMethod,
+	}
+
+	if profile != nil {
+		fields["displayName"] = profile.DisplayName
+		fields["firstName"] = profile.FirstName
+		fields["lastName"] = profile.LastName
+	}
+
+	if roles != nil {
+		sortedRoles := slices.Clone(roles)
+		slices.SortFunc(sortedRoles, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+		fields["roles"] = sortedRoles
+	}
+
+	return fields
+}
@@ -0,0 +1,35 @@
+package application_utils
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogChanges_Update(t *testing.T) {
+	id := uuid.MustParse("0190b9a0-0000-7000-8000-000000000001")
+	description := "Payments"
+	sameDescription := "Payments"
+
+	details, err := AuditLogChanges(id,
+		AuditLogFields{"name": "Billing", "description": &description, "isActive": true},
+		AuditLogFields{"name": "Invoicing", "description": &sameDescription, "isActive": true},
+	)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"0190b9a0-0000-7000-8000-000000000001","changes":{"name":{"before":"Billing","after":"Invoicing"}}}`, *details)
+}
+
+func TestAuditLogChanges_CreateAndDelete(t *testing.T) {
+	id := uuid.MustParse("0190b9a0-0000-7000-8000-000000000001")
+
+	created, err := AuditLogChanges(id, nil, AuditLogFields{"name": "Billing"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"0190b9a0-0000-7000-8000-000000000001","changes":{"name":{"before":null,"after":"Billing"}}}`, *created)
+
+	deleted, err := AuditLogChanges(id, AuditLogFields{"name": "Billing"}, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"0190b9a0-0000-7000-8000-000000000001","changes":{"name":{"before":"Billing","after":null}}}`, *deleted)
+}
@@ -0,0 +1,116 @@
+package application_utils
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAuditLogPageSize = 50
+	maxAuditLogPageSize     = 200
+)
+
+// ParseAuditLogFilter reads the audit log search filters from query
+// parameters: userId, applicationId, eventType, result, ipAddress, and from
+// and to as RFC 3339 timestamps. Missing parameters match every entry.
+func ParseAuditLogFilter(query url.Values) (entities.AuditLogFilter, error) {
+	var filter entities.AuditLogFilter
+	var err error
+
+	if filter.UserID, err = optionalUUID(query.Get("userId")); err != nil {
+		return filter, err
+	}
+
+	if filter.ApplicationID, err = optionalUUID(query.Get("applicationId")); err != nil {
+		return filter, err
+	}
+
+	if filter.From, err = optionalTime(query.Get("from")); err != nil {
+		return filter, err
+	}
+
+	if filter.To, err = optionalTime(query.Get("to")); err != nil {
+		return filter, err
+	}
+
+	filter.EventType = optionalString(strings.ToUpper(query.Get("eventType")))
+	filter.Result = optionalString(strings.ToLower(query.Get("result")))
+	filter.IPAddress = optionalString(query.Get("ipAddress"))
+
+	return filter, nil
+}
+
+// ParseAuditLogPage reads the cursor and limit query parameters of a paged
+// audit log search. The cursor is the nextCursor of the previous page; the
+// limit defaults to 50 and is capped at 200.
+func ParseAuditLogPage(query url.Values) (*uuid.UUID, int, error) {
+	cursor, err := optionalUUID(query.Get("cursor"))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	limit := defaultAuditLogPageSize
+	if l := query.Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 {
+			limit = min(parsed, maxAuditLogPageSize)
+		}
+	}
+
+	return cursor, limit, nil
+}
+
+// ParseAuditLogFormat maps a format name or media type to an audit log export format.
+func ParseAuditLogFormat(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", constants.AuditLogFormatCSV, "text/csv":
+		return constants.AuditLogFormatCSV, nil
+	case constants.AuditLogFormatNDJSON, "jsonl", "application/x-ndjson":
+		return constants.AuditLogFormatNDJSON, nil
+	}
+
+	return "", &errors.ErrAuditLogInvalidFormat
+}
+
+func optionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil, &errors.ErrAuditLogInvalidFilter
+	}
+
+	return &parsed, nil
+}
+
+// optionalTime parses an RFC 3339 timestamp into UTC, which audit entries are stored in.
+func optionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, &errors.ErrAuditLogInvalidFilter
+	}
+
+	parsed = parsed.UTC()
+	return &parsed, nil
+}
+
+func optionalString(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,45 @@
+package application_utils
+
+import (
+	"net/url"
+
+	"github.com/gate-keeper/internal/domain/errors"
+)
+
+// AuthorizationRedirect appends params to the client's redirect_uri, keeping
+// any query component it was registered with (RFC 6749 §3.1.2).
+func AuthorizationRedirect(redirectURI string, params url.Values) (string, error) {
+	redirectURL, err := url.Parse(redirectURI)
+
+	if err != nil {
+		return "", err
+	}
+
+	query := redirectURL.Query()
+
+	for key, values := range params {
+		for _, value := range values {
+			query.Set(key, value)
+		}
+	}
+
+	redirectURL.RawQuery = query.Encode()
+
+	return redirectURL.String(), nil
+}
+
+// AuthorizationErrorRedirect builds the RFC 6749 §4.1.2.1 error response sent
+// back to the client's redirect_uri.
+func AuthorizationErrorRedirect(redirectURI string, oauthErr *errors.OAuthError, state string) (string, error) {
+	params := url.Values{"error": {oauthErr.Code}}
+
+	if oauthErr.Description != "" {
+		params.Set("error_description", oauthErr.Description)
+	}
+
+	if state != "" {
+		params.Set("state", state)
+	}
+
+	return AuthorizationRedirect(redirectURI, params)
+}
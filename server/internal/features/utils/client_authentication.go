@@ -0,0 +1,76 @@
+package application_utils
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/google/uuid"
+)
+
+// AuthenticateClientSecret checks clientSecret against the application's
+// secrets in constant time, skipping secrets whose ExpiresAt has passed.
+// It returns the matching secret, or nil when none matches.
+func AuthenticateClientSecret(clientSecret string, secrets *[]entities.ApplicationSecret) *entities.ApplicationSecret {
+	if clientSecret == "" || secrets == nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	var match *entities.ApplicationSecret
+
+	// Compare against every secret so the timing does not reveal which one matched.
+	for i := range *secrets {
+		secret := &(*secrets)[i]
+
+		if subtle.ConstantTimeCompare([]byte(secret.Value), []byte(clientSecret)) != 1 {
+			continue
+		}
+
+		if secret.ExpiresAt != nil && secret.ExpiresAt.Before(now) {
+			continue
+		}
+
+		match = secret
+	}
+
+	return match
+}
+
+// ClientRepository is the persistence needed to authenticate a client.
+type ClientRepository interface {
+	GetApplicationByID(ctx context.Context, applicationID uuid.UUID) (*entities.Application, error)
+	ListSecretsFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationSecret, error)
+}
+
+// AuthenticateClient resolves the confidential client making an OAuth request
+// (RFC 6749 §2.3.1). Every failure is reported as invalid_client so callers
+// cannot tell an unknown client from a wrong secret.
+func AuthenticateClient(ctx context.Context, repository ClientRepository, clientID, clientSecret string) (*entities.Application, error) {
+	applicationID, err := uuid.Parse(clientID)
+	if err != nil {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidClient, "Client authentication failed")
+	}
+
+	application, err := repository.GetApplicationByID(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if application == nil || !application.IsActive {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidClient, "Client authentication failed")
+	}
+
+	secrets, err := repository.ListSecretsFromApplication(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if AuthenticateClientSecret(clientSecret, secrets) == nil {
+		return nil, errors.NewOAuthError(errors.OAuthInvalidClient, "Client authentication failed")
+	}
+
+	return application, nil
+}
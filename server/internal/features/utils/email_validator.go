@@ -2,6 +2,7 @@ package application_utils
 
 import (
 	"regexp"
+	"time"
 
 	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
@@ -24,7 +25,7 @@ func VerifyClientSecret(clientSecret string, secrets *[]entities.ApplicationSecr
 		if secret.Value == clientSecret {
 
 			if secret.ExpiresAt != nil {
-				if secret.ExpiresAt.Before(secret.CreatedAt) {
+				if secret.ExpiresAt.Before(time.Now().UTC()) {
 					return false, &errors.ErrClientSecretExpired
 				}
 			}
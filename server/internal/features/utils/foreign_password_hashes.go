@@ -0,0 +1,314 @@
+package application_utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/errors"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Foreign hashes are imported from other systems and verified as-is; they are
+// never produced here. The accepted formats are:
+//
+//	bcrypt           $2a$ / $2b$ / $2y$ modular crypt format
+//	scrypt           $scrypt$ln=<log2 N>,r=<r>,p=<p>$<salt>$<hash>
+//	pbkdf2-sha256    $pbkdf2-sha256$i=<iterations>$<salt>$<hash>
+//	firebase-scrypt  $firebase-scrypt$ln=<mem_cost>,r=<rounds>,k=<signer key>,s=<salt separator>$<salt>$<hash>
+//
+// Salts, hashes and Firebase project keys are base64 with or without padding.
+
+// DetectPasswordAlgorithm returns the algorithm the encoded hash was produced
+// with, or false if the format is not supported.
+func DetectPasswordAlgorithm(encodedHash string) (string, bool) {
+	switch {
+	case strings.HasPrefix(encodedHash, "$argon2id$"):
+		return constants.UserCredentialsPasswordAlgorithmArgon2id, true
+	case strings.HasPrefix(encodedHash, "$2a$"), strings.HasPrefix(encodedHash, "$2b$"), strings.HasPrefix(encodedHash, "$2y$"):
+		return constants.UserCredentialsPasswordAlgorithmBcrypt, true
+	case strings.HasPrefix(encodedHash, "$scrypt$"):
+		return constants.UserCredentialsPasswordAlgorithmScrypt, true
+	case strings.HasPrefix(encodedHash, "$pbkdf2-sha256$"):
+		return constants.UserCredentialsPasswordAlgorithmPbkdf2Sha256, true
+	case strings.HasPrefix(encodedHash, "$firebase-scrypt$"):
+		return constants.UserCredentialsPasswordAlgorithmFirebaseScrypt, true
+	}
+
+	return "", false
+}
+
+func compareForeignPassword(algorithm, encodedHash, password string) (bool, error) {
+	switch algorithm {
+	case constants.UserCredentialsPasswordAlgorithmBcrypt:
+		return compareBcrypt(encodedHash, password)
+	case constants.UserCredentialsPasswordAlgorithmScrypt:
+		return compareScrypt(encodedHash, password)
+	case constants.UserCredentialsPasswordAlgorithmPbkdf2Sha256:
+		return comparePbkdf2Sha256(encodedHash, password)
+	case constants.UserCredentialsPasswordAlgorithmFirebaseScrypt:
+		return compareFirebaseScrypt(encodedHash, password)
+	}
+
+	return false, &errors.ErrInvalidHash
+}
+
+// Upper bounds on the cost parameters of foreign hashes. Verifying a hash
+// costs as much as producing it, so hashes above them are rejected on import
+// and never verified.
+const (
+	maxBcryptCost        = 15
+	maxScryptLogN        = 20
+	maxScryptBlockSize   = 32
+	maxScryptParallelism = 16
+	maxScryptMemory      = 256 << 20 // bytes, 128 * N * r
+	maxPbkdf2Iterations  = 5_000_000
+)
+
+// ValidatePasswordHash checks that the encoded hash is in a supported format
+// and that its cost parameters are within the limits verification accepts.
+func ValidatePasswordHash(encodedHash string) error {
+	algorithm, ok := DetectPasswordAlgorithm(encodedHash)
+	if !ok {
+		return &errors.ErrInvalidHash
+	}
+
+	var err error
+	switch algorithm {
+	case constants.UserCredentialsPasswordAlgorithmArgon2id:
+		_, _, _, err = decodeArgon2idHash(encodedHash)
+	case constants.UserCredentialsPasswordAlgorithmBcrypt:
+		_, err = bcryptHash(encodedHash)
+	case constants.UserCredentialsPasswordAlgorithmScrypt:
+		_, err = parseScryptHash(encodedHash)
+	case constants.UserCredentialsPasswordAlgorithmPbkdf2Sha256:
+		_, err = parsePbkdf2Sha256Hash(encodedHash)
+	case constants.UserCredentialsPasswordAlgorithmFirebaseScrypt:
+		_, err = parseFirebaseScryptHash(encodedHash)
+	}
+
+	return err
+}
+
+// bcryptHash normalizes the prefix of the hash and checks its cost.
+func bcryptHash(encodedHash string) ([]byte, error) {
+	// Hashes from PHP use the $2y$ prefix, which is identical to $2b$.
+	if strings.HasPrefix(encodedHash, "$2y$") {
+		encodedHash = "$2b$" + strings.TrimPrefix(encodedHash, "$2y$")
+	}
+
+	cost, err := bcrypt.Cost([]byte(encodedHash))
+	if err != nil || cost > maxBcryptCost {
+		return nil, &errors.ErrInvalidHash
+	}
+
+	return []byte(encodedHash), nil
+}
+
+func compareBcrypt(encodedHash, password string) (bool, error) {
+	hash, err := bcryptHash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+
+	err = bcrypt.CompareHashAndPassword(hash, []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	if err != nil {
+		return false, &errors.ErrInvalidHash
+	}
+
+	return true, nil
+}
+
+// validScryptCost reports whether scrypt can be run with the parameters
+// within the CPU and memory limits.
+func validScryptCost(logN, r, p int) bool {
+	if logN < 1 || logN > maxScryptLogN || r < 1 || r > maxScryptBlockSize || p < 1 || p > maxScryptParallelism {
+		return false
+	}
+
+	return 128*r<<logN <= maxScryptMemory
+}
+
+type scryptHash struct {
+	logN, r, p int
+	salt, hash []byte
+}
+
+func parseScryptHash(encodedHash string) (*scryptHash, error) {
+	vals := strings.Split(encodedHash, "$")
+	if len(vals) != 5 {
+		return nil, &errors.ErrInvalidHash
+	}
+
+	h := &scryptHash{}
+	if _, err := fmt.Sscanf(vals[2], "ln=%d,r=%d,p=%d", &h.logN, &h.r, &h.p); err != nil || !validScryptCost(h.logN, h.r, h.p) {
+		return nil, &errors.ErrInvalidHash
+	}
+
+	var err error
+	h.salt, h.hash, err = decodeSaltAndHash(vals[3], vals[4])
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+func compareScrypt(encodedHash, password string) (bool, error) {
+	h, err := parseScryptHash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+
+	otherHash, err := scrypt.Key([]byte(password), h.salt, 1<<h.logN, h.r, h.p, len(h.hash))
+	if err != nil {
+		return false, &errors.ErrInvalidHash
+	}
+
+	return subtle.ConstantTimeCompare(h.hash, otherHash) == 1, nil
+}
+
+type pbkdf2Sha256Hash struct {
+	iterations int
+	salt, hash []byte
+}
+
+func parsePbkdf2Sha256Hash(encodedHash string) (*pbkdf2Sha256Hash, error) {
+	vals := strings.Split(encodedHash, "$")
+	if len(vals) != 5 {
+		return nil, &errors.ErrInvalidHash
+	}
+
+	h := &pbkdf2Sha256Hash{}
+	if _, err := fmt.Sscanf(vals[2], "i=%d", &h.iterations); err != nil || h.iterations < 1 || h.iterations > maxPbkdf2Iterations {
+		return nil, &errors.ErrInvalidHash
+	}
+
+	var err error
+	h.salt, h.hash, err = decodeSaltAndHash(vals[3], vals[4])
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+func comparePbkdf2Sha256(encodedHash, password string) (bool, error) {
+	h, err := parsePbkdf2Sha256Hash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+
+	otherHash, err := pbkdf2.Key(sha256.New, password, h.salt, h.iterations, len(h.hash))
+	if err != nil {
+		return false, &errors.ErrInvalidHash
+	}
+
+	return subtle.ConstantTimeCompare(h.hash, otherHash) == 1, nil
+}
+
+type firebaseScryptHash struct {
+	memCost, rounds          int
+	signerKey, saltSeparator []byte
+	salt, hash               []byte
+}
+
+func parseFirebaseScryptHash(encodedHash string) (*firebaseScryptHash, error) {
+	vals := strings.Split(encodedHash, "$")
+	if len(vals) != 5 {
+		return nil, &errors.ErrInvalidHash
+	}
+
+	h := &firebaseScryptHash{}
+	var b64SignerKey, b64SaltSeparator string
+	for _, param := range strings.Split(vals[2], ",") {
+		key, value, _ := strings.Cut(param, "=")
+		switch key {
+		case "ln":
+			fmt.Sscanf(value, "%d", &h.memCost)
+		case "r":
+			fmt.Sscanf(value, "%d", &h.rounds)
+		case "k":
+			b64SignerKey = value
+		case "s":
+			b64SaltSeparator = value
+		}
+	}
+
+	if !validScryptCost(h.memCost, h.rounds, 1) || b64SignerKey == "" {
+		return nil, &errors.ErrInvalidHash
+	}
+
+	var err error
+	h.signerKey, err = decodeBase64(b64SignerKey)
+	if err != nil {
+		return nil, &errors.ErrInvalidHash
+	}
+
+	h.saltSeparator, err = decodeBase64(b64SaltSeparator)
+	if err != nil {
+		return nil, &errors.ErrInvalidHash
+	}
+
+	h.salt, h.hash, err = decodeSaltAndHash(vals[3], vals[4])
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// compareFirebaseScrypt implements Firebase's modified scrypt: the scrypt key
+// of the password is used to AES-256-CTR encrypt the project's signer key, and
+// the ciphertext is the stored hash.
+func compareFirebaseScrypt(encodedHash, password string) (bool, error) {
+	h, err := parseFirebaseScryptHash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+
+	derivedKey, err := scrypt.Key([]byte(password), append(h.salt, h.saltSeparator...), 1<<h.memCost, h.rounds, 1, 32)
+	if err != nil {
+		return false, &errors.ErrInvalidHash
+	}
+
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return false, err
+	}
+
+	otherHash := make([]byte, len(h.signerKey))
+	cipher.NewCTR(block, make([]byte, aes.BlockSize)).XORKeyStream(otherHash, h.signerKey)
+
+	return subtle.ConstantTimeCompare(h.hash, otherHash) == 1, nil
+}
+
+func decodeSaltAndHash(b64Salt, b64Hash string) (salt, hash []byte, err error) {
+	salt, err = decodeBase64(b64Salt)
+	if err != nil {
+		return nil, nil, &errors.ErrInvalidHash
+	}
+
+	hash, err = decodeBase64(b64Hash)
+	if err != nil || len(hash) == 0 {
+		return nil, nil, &errors.ErrInvalidHash
+	}
+
+	return salt, hash, nil
+}
+
+// decodeBase64 accepts standard base64 with or without padding, since export
+// tools disagree on it.
+func decodeBase64(value string) ([]byte, error) {
+	return base64.RawStdEncoding.DecodeString(strings.TrimRight(value, "="))
+}
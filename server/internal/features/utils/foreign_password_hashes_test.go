@@ -0,0 +1,127 @@
+package application_utils
+
+import (
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Vectors come from RFC 7914 (scrypt, PBKDF2-HMAC-SHA256) and the reference
+// implementation of Firebase's modified scrypt.
+const (
+	scryptVector         = "$scrypt$ln=10,r=8,p=16$TmFDbA==$/bq+HJ00cgB4VucZDQHp/nxq18vII3gw53N2Y0s3MWIurzDZLiKjiG/xCSedmDDaxyevuUqD7m2DYMvfoswGQA=="
+	pbkdf2Sha256Vector   = "$pbkdf2-sha256$i=1$c2FsdA$VawEblbjCJ/sFpHCJUS2BflBhSFt3gRl5oudV8INrLxJypzM8Xm2RZkWZLOdd+8xfHG4RbHjC9UJESBB06GXgw"
+	firebaseScryptVector = "$firebase-scrypt$ln=14,r=8,k=jxspr8Ki0RYycVU8zykbdLGjFQ3McFUH0uiiTvC8pVMXAn210wjLNmdZJzxUECKbm0QsEmYUSDzZvpjeJ9WmXA==,s=Bw==" +
+		"$42xEC+ixf3L2lw==$lSrfV15cpx95/sZS2W9c9Kp6i/LVgQNDNC/qzrCnh1SAyZvqmZqAjTdn3aoItz+VHjoZilo78198JAdRuid5lQ=="
+)
+
+func TestDetectPasswordAlgorithm(t *testing.T) {
+	cases := map[string]string{
+		"$argon2id$v=19$m=65536,t=3,p=4$c2FsdA$aGFzaA":                 constants.UserCredentialsPasswordAlgorithmArgon2id,
+		"$2y$10$abcdefghijklmnopqrstuuJ3N3C7dY5y0q3bJ3Bv3Yqk2m4K6s7gW": constants.UserCredentialsPasswordAlgorithmBcrypt,
+		scryptVector:         constants.UserCredentialsPasswordAlgorithmScrypt,
+		pbkdf2Sha256Vector:   constants.UserCredentialsPasswordAlgorithmPbkdf2Sha256,
+		firebaseScryptVector: constants.UserCredentialsPasswordAlgorithmFirebaseScrypt,
+	}
+
+	for hash, expected := range cases {
+		algorithm, ok := DetectPasswordAlgorithm(hash)
+		assert.True(t, ok, hash)
+		assert.Equal(t, expected, algorithm)
+	}
+
+	_, ok := DetectPasswordAlgorithm("$md5$whatever")
+	assert.False(t, ok)
+}
+
+func TestComparePassword_Bcrypt(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("s3cret!"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	match, err := ComparePassword(string(hash), "s3cret!", "")
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = ComparePassword(string(hash), "wrong", "")
+	require.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestComparePassword_BcryptPhpPrefix(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("s3cret!"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	phpHash := "$2y$" + string(hash[4:])
+
+	match, err := ComparePassword(phpHash, "s3cret!", "")
+	require.NoError(t, err)
+	assert.True(t, match)
+}
+
+func TestComparePassword_Scrypt(t *testing.T) {
+	match, err := ComparePassword(scryptVector, "password", "")
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = ComparePassword(scryptVector, "wrong", "")
+	require.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestComparePassword_Pbkdf2Sha256(t *testing.T) {
+	match, err := ComparePassword(pbkdf2Sha256Vector, "passwd", "")
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = ComparePassword(pbkdf2Sha256Vector, "wrong", "")
+	require.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestComparePassword_FirebaseScrypt(t *testing.T) {
+	match, err := ComparePassword(firebaseScryptVector, "user1password", "")
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = ComparePassword(firebaseScryptVector, "wrong", "")
+	require.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestComparePassword_MalformedForeignHash(t *testing.T) {
+	_, err := ComparePassword("$scrypt$ln=x$salt$hash", "password", "")
+	assert.Error(t, err)
+
+	_, err = ComparePassword("$pbkdf2-sha256$i=0$c2FsdA$aGFzaA", "password", "")
+	assert.Error(t, err)
+}
+
+func TestValidatePasswordHash_RejectsExcessiveCost(t *testing.T) {
+	for _, hash := range []string{scryptVector, pbkdf2Sha256Vector, firebaseScryptVector} {
+		assert.NoError(t, ValidatePasswordHash(hash), hash)
+	}
+
+	excessive := []string{
+		"$scrypt$ln=30,r=8,p=1$c2FsdA$aGFzaA",
+		"$scrypt$ln=14,r=100000,p=1$c2FsdA$aGFzaA",
+		"$firebase-scrypt$ln=14,r=4096,k=a2V5,s=Bw$c2FsdA$aGFzaA",
+		"$pbkdf2-sha256$i=1000000000$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=4194304,t=3,p=4$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=65536,t=1000,p=4$c2FsdA$aGFzaA",
+		"$2b$31$abcdefghijklmnopqrstuuJ3N3C7dY5y0q3bJ3Bv3Yqk2m4K6s7gW",
+	}
+
+	for _, hash := range excessive {
+		assert.Error(t, ValidatePasswordHash(hash), hash)
+
+		_, err := ComparePassword(hash, "password", "")
+		assert.Error(t, err, hash)
+	}
+}
+
+func TestPasswordNeedsRehash_ForeignHash(t *testing.T) {
+	assert.True(t, PasswordNeedsRehash(pbkdf2Sha256Vector, testPolicy))
+}
@@ -1,9 +1,14 @@
 package application_utils
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"time"
 
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/revocation"
+	"github.com/gate-keeper/internal/infra/signing"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
@@ -15,6 +20,38 @@ type JWTClaims struct {
 	DisplayName string
 	Email       string
 	TenantID    uuid.UUID
+	// ClientID and Scope are stamped on access tokens issued through an OAuth
+	// grant so resource servers and introspection can tell who they were issued to.
+	ClientID uuid.UUID
+	Scope    string
+	// Roles are the names of the user's roles in the application the token
+	// is issued to.
+	Roles []string
+	// Permissions are the names of the permissions granted by those roles.
+	Permissions []string
+	// SessionID is the user session the token was issued for, stamped as the
+	// sid claim so revoking the session invalidates its access tokens.
+	SessionID uuid.UUID
+}
+
+// ClientClaims describes an application acting on its own behalf
+// (client_credentials grant), with no end user involved.
+type ClientClaims struct {
+	ApplicationID uuid.UUID
+	TenantID      uuid.UUID
+	Scope         string
+	Roles         []string
+	Permissions   []string
+}
+
+// RolesClaim returns the name of the claim carrying role names, "roles"
+// unless overridden with JWT_ROLES_CLAIM.
+func RolesClaim() string {
+	if claim := os.Getenv("JWT_ROLES_CLAIM"); claim != "" {
+		return claim
+	}
+
+	return "roles"
 }
 
 // CreateToken creates an OAuth2 access token (JWT) with OIDC-compatible claims
@@ -27,8 +64,40 @@ func CreateIDToken(claims JWTClaims, nonce *string, audience string) (string, er
 	return createIDTokenWithOptions(claims, nonce, audience)
 }
 
+// CreateClientToken creates an access token whose subject is the application
+// itself, following the JWT access token profile (RFC 9068).
+func CreateClientToken(claims ClientClaims) (string, error) {
+	issuer := os.Getenv("ISSUER_URL")
+	if issuer == "" {
+		issuer = "https://proxymity.tech/guard"
+	}
+
+	now := time.Now()
+
+	mappedClaims := jwt.MapClaims{
+		"sub":       claims.ApplicationID.String(),
+		"client_id": claims.ApplicationID.String(),
+		"org_id":    claims.TenantID.String(),
+		// JWT registered claims
+		"aud": "https://proxymity.tech/guard",
+		"exp": now.Add(time.Minute * 15).Unix(),
+		"iat": now.Unix(),
+		"nbf": now.Unix(),
+		"iss": issuer,
+		"jti": uuid.New().String(),
+	}
+
+	if claims.Scope != "" {
+		mappedClaims["scope"] = claims.Scope
+	}
+
+	mappedClaims[RolesClaim()] = roleNames(claims.Roles)
+	mappedClaims["permissions"] = roleNames(claims.Permissions)
+
+	return signClaims(claims.TenantID, mappedClaims)
+}
+
 func createTokenWithOptions(claims JWTClaims, nonce *string, audience interface{}) (string, error) {
-	key := []byte(os.Getenv("JWT_SECRET"))
 	issuer := os.Getenv("ISSUER_URL")
 	if issuer == "" {
 		issuer = "https://proxymity.tech/guard"
@@ -58,12 +127,26 @@ func createTokenWithOptions(claims JWTClaims, nonce *string, audience interface{
 		mappedClaims["nonce"] = *nonce
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, mappedClaims)
-	return token.SignedString(key)
+	// Roles and permissions are scoped to an application, so only tokens
+	// issued to one carry them.
+	if claims.ClientID != uuid.Nil {
+		mappedClaims["client_id"] = claims.ClientID.String()
+		mappedClaims[RolesClaim()] = roleNames(claims.Roles)
+		mappedClaims["permissions"] = roleNames(claims.Permissions)
+	}
+
+	if claims.Scope != "" {
+		mappedClaims["scope"] = claims.Scope
+	}
+
+	if claims.SessionID != uuid.Nil {
+		mappedClaims["sid"] = claims.SessionID.String()
+	}
+
+	return signClaims(claims.TenantID, mappedClaims)
 }
 
 func createIDTokenWithOptions(claims JWTClaims, nonce *string, audience string) (string, error) {
-	key := []byte(os.Getenv("JWT_SECRET"))
 	issuer := os.Getenv("ISSUER_URL")
 	if issuer == "" {
 		issuer = "https://proxymity.tech/guard"
@@ -92,16 +175,57 @@ func createIDTokenWithOptions(claims JWTClaims, nonce *string, audience string)
 		mappedClaims["nonce"] = *nonce
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, mappedClaims)
-	return token.SignedString(key)
+	// OIDC Front-Channel Logout 1.0 §3 session identifier
+	if claims.SessionID != uuid.Nil {
+		mappedClaims["sid"] = claims.SessionID.String()
+	}
+
+	mappedClaims[RolesClaim()] = roleNames(claims.Roles)
+
+	return signClaims(claims.TenantID, mappedClaims)
 }
 
-func ValidateToken(jwtToken string) (bool, string, error) {
-	key := []byte(os.Getenv("JWT_SECRET"))
+// roleNames keeps the roles and permissions claims arrays even when nothing is assigned.
+func roleNames(roles []string) []string {
+	if roles == nil {
+		return []string{}
+	}
 
-	token, err := jwt.Parse(jwtToken, func(token *jwt.Token) (interface{}, error) {
-		return key, nil
-	})
+	return roles
+}
+
+// signClaims signs the claims with the tenant's active signing key and stamps its kid header.
+func signClaims(tenantID uuid.UUID, mappedClaims jwt.MapClaims) (string, error) {
+	key, err := signing.Current().SigningKey(tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(key.SigningMethod(), mappedClaims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.PrivateKey)
+}
+
+// keyFunc selects the verification key by the token's kid header and rejects
+// tokens whose alg header does not match the algorithm bound to that key.
+func keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := signing.Current().Lookup(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("unexpected signing method %q", token.Method.Alg())
+	}
+
+	return key.VerificationKey(), nil
+}
+
+func ValidateToken(jwtToken string) (bool, string, error) {
+	token, err := jwt.Parse(jwtToken, keyFunc)
 
 	if err != nil {
 		return false, "", err
@@ -116,37 +240,57 @@ func ValidateToken(jwtToken string) (bool, string, error) {
 	return token.Valid, claims["sub"].(string), nil
 }
 
-// ValidateTokenWithLeeway validates a JWT allowing recently-expired tokens (up to `leeway` past expiry).
-// This is used by the token refresh endpoint so clients can obtain a new token even if the current one
-// just expired.
-func ValidateTokenWithLeeway(jwtToken string, leeway time.Duration) (bool, string, error) {
-	key := []byte(os.Getenv("JWT_SECRET"))
+// RefreshLeeway is how long after expiring an access token can still be
+// exchanged for a new one at the refresh endpoint.
+const RefreshLeeway = 30 * time.Minute
 
+// ParseTokenClaimsWithLeeway verifies a JWT allowing recently-expired tokens (up to `leeway` past expiry)
+// and returns its raw claims. This is used by the token refresh endpoint so clients can obtain a new
+// token even if the current one just expired.
+func ParseTokenClaimsWithLeeway(jwtToken string, leeway time.Duration) (jwt.MapClaims, error) {
 	parser := jwt.NewParser(jwt.WithLeeway(leeway))
 
-	token, err := parser.Parse(jwtToken, func(token *jwt.Token) (interface{}, error) {
-		return key, nil
-	})
+	token, err := parser.Parse(jwtToken, keyFunc)
 
 	if err != nil {
-		return false, "", err
+		return nil, err
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 
-	if !ok {
-		return false, "", err
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid token claims")
 	}
 
-	return token.Valid, claims["sub"].(string), nil
+	return claims, nil
 }
 
-func DecodeToken(jwtToken string) (*JWTClaims, error) {
-	key := []byte(os.Getenv("JWT_SECRET"))
+// ParseTokenClaims verifies a token and returns its raw claims.
+func ParseTokenClaims(jwtToken string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(jwtToken, keyFunc)
+
+	if err != nil {
+		return nil, err
+	}
 
-	token, err := jwt.Parse(jwtToken, func(token *jwt.Token) (interface{}, error) {
-		return key, nil
-	})
+	claims, ok := token.Claims.(jwt.MapClaims)
+
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	return claims, nil
+}
+
+// ParseIDTokenHint verifies the signature of an ID token presented as a hint
+// and returns its claims. Expired tokens are accepted, as OIDC RP-Initiated
+// Logout expects clients to send the last ID token they received, but the
+// token must carry an issue time that is not in the future. Callers bound its
+// age with IDTokenHintIssuedWithin.
+func ParseIDTokenHint(jwtToken string) (jwt.MapClaims, error) {
+	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
+
+	token, err := parser.Parse(jwtToken, keyFunc)
 
 	if err != nil {
 		return nil, err
@@ -154,16 +298,158 @@ func DecodeToken(jwtToken string) (*JWTClaims, error) {
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 
-	if !ok {
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	issuedAt, err := claims.GetIssuedAt()
+
+	if err != nil || issuedAt == nil || issuedAt.After(time.Now()) {
+		return nil, fmt.Errorf("invalid token issue time")
+	}
+
+	return claims, nil
+}
+
+// IDTokenHintIssuedWithin reports whether an ID token hint was issued no more
+// than maxAge ago. Hints older than any session they could belong to are
+// refused, so a leaked token can't be replayed to log its user out forever.
+func IDTokenHintIssuedWithin(claims jwt.MapClaims, maxAge time.Duration) bool {
+	issuedAt, err := claims.GetIssuedAt()
+
+	if err != nil || issuedAt == nil {
+		return false
+	}
+
+	return time.Since(issuedAt.Time) <= maxAge
+}
+
+// ValidateAccessToken verifies a bearer token and rejects it when its jti was
+// revoked through the revocation endpoint before it expired, or when the
+// session named by its sid claim was revoked.
+func ValidateAccessToken(ctx context.Context, jwtToken string) (jwt.MapClaims, error) {
+	claims, err := ParseTokenClaims(jwtToken)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rejectRevokedToken(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	sessionRevoked, err := revocation.IsSessionRevoked(ctx, SessionIDFromClaims(claims))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sessionRevoked {
+		return nil, fmt.Errorf("session has been revoked")
+	}
+
+	return claims, nil
+}
+
+// ValidateRefreshableAccessToken verifies a bearer token presented at the
+// refresh endpoint, accepting tokens expired up to RefreshLeeway ago, and
+// rejects it when its jti was revoked. The session is checked by the refresh
+// handler.
+func ValidateRefreshableAccessToken(ctx context.Context, jwtToken string) (jwt.MapClaims, error) {
+	claims, err := ParseTokenClaimsWithLeeway(jwtToken, RefreshLeeway)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rejectRevokedToken(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+func rejectRevokedToken(ctx context.Context, claims jwt.MapClaims) error {
+	jti, _ := claims["jti"].(string)
+	revoked, err := revocation.IsRevoked(ctx, jti)
+
+	if err != nil {
+		return err
+	}
+
+	if revoked {
+		return fmt.Errorf("token has been revoked")
+	}
+
+	return nil
+}
+
+// SessionIDFromClaims reads the sid claim, returning uuid.Nil for tokens
+// issued without a session.
+func SessionIDFromClaims(claims jwt.MapClaims) uuid.UUID {
+	sid, _ := claims["sid"].(string)
+
+	sessionID, err := uuid.Parse(sid)
+	if err != nil {
+		return uuid.Nil
+	}
+
+	return sessionID
+}
+
+// ClientIDFromClaims reads the client_id claim, returning uuid.Nil for tokens
+// issued without one.
+func ClientIDFromClaims(claims jwt.MapClaims) uuid.UUID {
+	value, _ := claims["client_id"].(string)
+
+	clientID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil
+	}
+
+	return clientID
+}
+
+func DecodeToken(jwtToken string) (*JWTClaims, error) {
+	token, err := jwt.Parse(jwtToken, keyFunc)
+
+	if err != nil {
 		return nil, err
 	}
 
+	claims, ok := token.Claims.(jwt.MapClaims)
+
+	if !ok {
+		return nil, &errors.ErrTokenWithoutUser
+	}
+
+	// Tokens issued to an application (client_credentials) have the
+	// application itself as subject.
+	subject, _ := claims["sub"].(string)
+	userID, err := uuid.Parse(subject)
+
+	if err != nil || userID == ClientIDFromClaims(claims) {
+		return nil, &errors.ErrTokenWithoutUser
+	}
+
+	orgID, _ := claims["org_id"].(string)
+	tenantID, err := uuid.Parse(orgID)
+
+	if err != nil {
+		return nil, &errors.ErrTokenWithoutUser
+	}
+
+	firstName, _ := claims["given_name"].(string)
+	lastName, _ := claims["family_name"].(string)
+	displayName, _ := claims["name"].(string)
+	email, _ := claims["email"].(string)
+
 	return &JWTClaims{
-		UserID:      uuid.MustParse(claims["sub"].(string)),
-		FirstName:   claims["given_name"].(string),
-		LastName:    claims["family_name"].(string),
-		DisplayName: claims["name"].(string),
-		Email:       claims["email"].(string),
-		TenantID:    uuid.MustParse(claims["org_id"].(string)),
+		UserID:      userID,
+		FirstName:   firstName,
+		LastName:    lastName,
+		DisplayName: displayName,
+		Email:       email,
+		TenantID:    tenantID,
 	}, nil
 }
@@ -0,0 +1,240 @@
+package application_utils
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/revocation"
+	"github.com/gate-keeper/internal/infra/signing"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClaims() JWTClaims {
+	return JWTClaims{
+		UserID:      uuid.New(),
+		FirstName:   "Ada",
+		LastName:    "Lovelace",
+		DisplayName: "ada",
+		Email:       "ada@example.com",
+		TenantID:    uuid.New(),
+	}
+}
+
+func useKeySet(t *testing.T, set signing.KeyProvider) {
+	signing.SetProvider(set)
+	t.Cleanup(func() { signing.SetProvider(nil) })
+}
+
+// fakeDenylist revokes the tokens and sessions it was given.
+type fakeDenylist struct {
+	revokedTokens   map[string]bool
+	revokedSessions map[uuid.UUID]bool
+}
+
+func (d fakeDenylist) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return d.revokedTokens[jti], nil
+}
+
+func (d fakeDenylist) IsSessionRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	return d.revokedSessions[sessionID], nil
+}
+
+func useDenylist(t *testing.T, d revocation.Denylist) {
+	revocation.SetDenylist(d)
+	t.Cleanup(func() { revocation.SetDenylist(nil) })
+}
+
+func TestCreateToken_StampsKidAndValidates(t *testing.T) {
+	for _, alg := range []string{signing.AlgorithmRS256, signing.AlgorithmES256, signing.AlgorithmEdDSA} {
+		key, err := signing.GenerateKey(alg)
+		require.NoError(t, err)
+		useKeySet(t, signing.NewKeySet(key))
+
+		claims := newTestClaims()
+		token, err := CreateToken(claims)
+		require.NoError(t, err)
+
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, key.ID, parsed.Header["kid"], alg)
+		assert.Equal(t, alg, parsed.Header["alg"], alg)
+
+		valid, sub, err := ValidateToken(token)
+		require.NoError(t, err, alg)
+		assert.True(t, valid)
+		assert.Equal(t, claims.UserID.String(), sub)
+
+		decoded, err := DecodeToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, claims.Email, decoded.Email)
+	}
+}
+
+func TestDecodeToken_RejectsClientToken(t *testing.T) {
+	key, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(key))
+
+	token, err := CreateClientToken(ClientClaims{ApplicationID: uuid.New(), TenantID: uuid.New(), Scope: "invoices:read"})
+	require.NoError(t, err)
+
+	_, err = DecodeToken(token)
+
+	assert.ErrorIs(t, err, &errors.ErrTokenWithoutUser)
+}
+
+func TestValidateToken_RetiredKeyStillVerifies(t *testing.T) {
+	oldKey, err := signing.GenerateKey(signing.AlgorithmRS256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(oldKey))
+
+	token, err := CreateToken(newTestClaims())
+	require.NoError(t, err)
+
+	newKey, err := signing.GenerateKey(signing.AlgorithmRS256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(newKey, oldKey))
+
+	valid, _, err := ValidateToken(token)
+	require.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestValidateToken_UnknownKidRejected(t *testing.T) {
+	key, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(key))
+
+	token, err := CreateToken(newTestClaims())
+	require.NoError(t, err)
+
+	other, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(other))
+
+	_, _, err = ValidateToken(token)
+	require.Error(t, err)
+}
+
+func TestValidateToken_RejectsAlgorithmConfusion(t *testing.T) {
+	key, err := signing.GenerateKey(signing.AlgorithmRS256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(key))
+
+	// Forge an HS256 token that claims the RSA key's kid.
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": uuid.NewString()})
+	forged.Header["kid"] = key.ID
+	token, err := forged.SignedString([]byte("attacker-controlled"))
+	require.NoError(t, err)
+
+	_, _, err = ValidateToken(token)
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "unexpected signing method"))
+}
+
+func TestCreateToken_StampsSessionID(t *testing.T) {
+	key, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(key))
+
+	claims := newTestClaims()
+	claims.SessionID = uuid.New()
+
+	token, err := CreateToken(claims)
+	require.NoError(t, err)
+
+	idToken, err := CreateIDToken(claims, nil, "client")
+	require.NoError(t, err)
+
+	for _, issued := range []string{token, idToken} {
+		parsed, err := ParseTokenClaims(issued)
+		require.NoError(t, err)
+		assert.Equal(t, claims.SessionID, SessionIDFromClaims(parsed))
+	}
+
+	withoutSession, err := CreateToken(newTestClaims())
+	require.NoError(t, err)
+
+	parsed, err := ParseTokenClaims(withoutSession)
+	require.NoError(t, err)
+	assert.NotContains(t, parsed, "sid")
+}
+
+func TestValidateAccessToken_RejectsRevokedSession(t *testing.T) {
+	key, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(key))
+
+	active := newTestClaims()
+	active.SessionID = uuid.New()
+	revoked := newTestClaims()
+	revoked.SessionID = uuid.New()
+
+	useDenylist(t, fakeDenylist{revokedSessions: map[uuid.UUID]bool{revoked.SessionID: true}})
+
+	activeToken, err := CreateToken(active)
+	require.NoError(t, err)
+	_, err = ValidateAccessToken(context.Background(), activeToken)
+	require.NoError(t, err)
+
+	revokedToken, err := CreateToken(revoked)
+	require.NoError(t, err)
+	_, err = ValidateAccessToken(context.Background(), revokedToken)
+	require.Error(t, err)
+	assert.Equal(t, "session has been revoked", err.Error())
+}
+
+func TestValidateRefreshableAccessToken_RejectsRevokedToken(t *testing.T) {
+	key, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(key))
+
+	activeToken, err := CreateToken(newTestClaims())
+	require.NoError(t, err)
+	revokedToken, err := CreateToken(newTestClaims())
+	require.NoError(t, err)
+
+	revokedClaims, err := ParseTokenClaims(revokedToken)
+	require.NoError(t, err)
+	useDenylist(t, fakeDenylist{revokedTokens: map[string]bool{revokedClaims["jti"].(string): true}})
+
+	_, err = ValidateRefreshableAccessToken(context.Background(), activeToken)
+	require.NoError(t, err)
+
+	_, err = ValidateRefreshableAccessToken(context.Background(), revokedToken)
+	require.Error(t, err)
+	assert.Equal(t, "token has been revoked", err.Error())
+}
+
+func TestParseIDTokenHint_RequiresIssueTimeInThePast(t *testing.T) {
+	key, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	useKeySet(t, signing.NewKeySet(key))
+
+	tenantID := uuid.New()
+	issuedAt := time.Now().Add(-48 * time.Hour)
+
+	stale, err := signClaims(tenantID, jwt.MapClaims{"sub": uuid.NewString(), "iat": issuedAt.Unix()})
+	require.NoError(t, err)
+
+	claims, err := ParseIDTokenHint(stale)
+	require.NoError(t, err)
+	assert.True(t, IDTokenHintIssuedWithin(claims, 72*time.Hour))
+	assert.False(t, IDTokenHintIssuedWithin(claims, 24*time.Hour))
+
+	future, err := signClaims(tenantID, jwt.MapClaims{"sub": uuid.NewString(), "iat": time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	_, err = ParseIDTokenHint(future)
+	require.Error(t, err)
+
+	withoutIssueTime, err := signClaims(tenantID, jwt.MapClaims{"sub": uuid.NewString()})
+	require.NoError(t, err)
+	_, err = ParseIDTokenHint(withoutIssueTime)
+	require.Error(t, err)
+}
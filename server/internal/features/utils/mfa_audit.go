@@ -0,0 +1,42 @@
+package application_utils
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+// IMfaAuditRepository is the subset of repository operations the MFA audit helpers need.
+type IMfaAuditRepository interface {
+	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
+}
+
+// AuditMfaChallengePassed records a verified second factor along with the
+// login it completes.
+func AuditMfaChallengePassed(ctx context.Context, repository IMfaAuditRepository, userID, applicationID uuid.UUID, method, ipAddress, userAgent string) error {
+	details := "method: " + method
+	challengeLog := entities.NewAuditLog(userID, applicationID,
+		constants.AuditEventMfaChallengeSucceeded, ipAddress, userAgent, "success", &details)
+
+	if err := repository.AddAuditLog(ctx, challengeLog); err != nil {
+		return err
+	}
+
+	loginDetails := "mfa_method: " + method
+	loginLog := entities.NewAuditLog(userID, applicationID,
+		constants.AuditEventLoginSucceeded, ipAddress, userAgent, "success", &loginDetails)
+
+	return repository.AddAuditLog(ctx, loginLog)
+}
+
+// AuditMfaChallengeFailed records a rejected second factor. Callers commit it
+// with repositories.CommitAndReturn, since the failed login rolls back.
+func AuditMfaChallengeFailed(ctx context.Context, repository IMfaAuditRepository, userID, applicationID uuid.UUID, method, reason, ipAddress, userAgent string) error {
+	details := "method: " + method + ", reason: " + reason
+	auditLog := entities.NewAuditLog(userID, applicationID,
+		constants.AuditEventMfaChallengeFailed, ipAddress, userAgent, "failure", &details)
+
+	return repository.AddAuditLog(ctx, auditLog)
+}
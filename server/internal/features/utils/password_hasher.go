@@ -1,32 +1,88 @@
 package application_utils
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/base64"
 	"fmt"
+	"log/slog"
 	"strings"
 
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
 	"github.com/gate-keeper/internal/domain/errors"
 	"golang.org/x/crypto/argon2"
 )
 
-// HashPassword hashes the given password using argon2
-func HashPassword(password, passwordHashSecret string) (string, error) {
-	saltString := passwordHashSecret
-	salt := []byte(saltString)
-	memory := uint32(64 * 1024) // 64MB
-	threads := uint8(4)
-	keyLen := uint32(32)
-	iterations := uint32(3)
+const (
+	passwordSaltLength = 16
+	passwordKeyLength  = 32
+)
+
+// PasswordHashPolicy is the Argon2id configuration a tenant hashes passwords
+// with. Pepper is optional; when set, passwords are keyed with it before
+// hashing and the resulting hash records which pepper was used.
+// PreviousPepper is the one it replaced, still accepted when verifying so
+// users keep signing in and get rehashed with the current one.
+type PasswordHashPolicy struct {
+	Memory         uint32 // KiB
+	Iterations     uint32
+	Parallelism    uint8
+	Pepper         string
+	PreviousPepper string
+}
+
+// Peppers returns the peppers a password may have been hashed with.
+func (p PasswordHashPolicy) Peppers() []string {
+	return []string{p.Pepper, p.PreviousPepper}
+}
+
+// TenantPasswordHashPolicy returns the password hashing policy configured on
+// the tenant, falling back to the defaults for unset cost parameters.
+func TenantPasswordHashPolicy(tenant *entities.Tenant) PasswordHashPolicy {
+	policy := PasswordHashPolicy{
+		Memory:         constants.DefaultPasswordHashMemory,
+		Iterations:     constants.DefaultPasswordHashIterations,
+		Parallelism:    constants.DefaultPasswordHashParallelism,
+		Pepper:         tenant.PasswordHashSecret,
+		PreviousPepper: tenant.PreviousPasswordHashSecret,
+	}
+
+	if tenant.PasswordHashMemory > 0 {
+		policy.Memory = uint32(tenant.PasswordHashMemory)
+	}
+	if tenant.PasswordHashIterations > 0 {
+		policy.Iterations = uint32(tenant.PasswordHashIterations)
+	}
+	if tenant.PasswordHashParallelism > 0 {
+		policy.Parallelism = uint8(tenant.PasswordHashParallelism)
+	}
+
+	return policy
+}
+
+// HashPassword hashes the given password using argon2id with a random salt
+func HashPassword(password string, policy PasswordHashPolicy) (string, error) {
+	salt := make([]byte, passwordSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
 
-	hash := argon2.IDKey([]byte(password), []byte(salt), iterations, memory, threads, keyLen)
+	hash := argon2.IDKey(pepperPassword(password, policy.Pepper), salt, policy.Iterations, policy.Memory, policy.Parallelism, passwordKeyLength)
 
 	// Base64 encode the salt and hashed password.
 	b64Salt := base64.RawStdEncoding.EncodeToString(salt)
 	b64Hash := base64.RawStdEncoding.EncodeToString(hash)
 
+	parameters := fmt.Sprintf("m=%d,t=%d,p=%d", policy.Memory, policy.Iterations, policy.Parallelism)
+	if policy.Pepper != "" {
+		parameters += ",keyid=" + pepperID(policy.Pepper)
+	}
+
 	// Return a string using the standard encoded hash representation.
-	encodedHash := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, memory, iterations, threads, b64Salt, b64Hash)
+	encodedHash := fmt.Sprintf("$argon2id$v=%d$%s$%s$%s", argon2.Version, parameters, b64Salt, b64Hash)
 
 	return encodedHash, nil
 }
@@ -35,20 +91,45 @@ type params struct {
 	memory      uint32
 	iterations  uint32
 	parallelism uint8
+	keyID       string
 	saltLength  uint32
 	keyLength   uint32
 }
 
-func ComparePassword(encodedHash, password string) (match bool, err error) {
+// ComparePassword reports whether password matches the encoded hash. Keyed
+// hashes are verified with the pepper whose keyid they carry, among the given
+// ones. Hashes without a keyid were produced before peppering and are verified
+// as-is, as are hashes imported from other systems.
+func ComparePassword(encodedHash, password string, peppers ...string) (match bool, err error) {
+	algorithm, ok := DetectPasswordAlgorithm(encodedHash)
+	if !ok {
+		return false, &errors.ErrInvalidHash
+	}
+
+	if algorithm != constants.UserCredentialsPasswordAlgorithmArgon2id {
+		return compareForeignPassword(algorithm, encodedHash, password)
+	}
+
 	// Extract the parameters, salt and derived key from the encoded password
 	// hash.
-	p, salt, hash, err := decodeHash(encodedHash)
+	p, salt, hash, err := decodeArgon2idHash(encodedHash)
 	if err != nil {
 		return false, err
 	}
 
+	input := []byte(password)
+	if p.keyID != "" {
+		pepper, ok := findPepper(p.keyID, peppers)
+		if !ok {
+			slog.Warn("Password hash was produced with a different pepper", "key_id", p.keyID)
+			return false, nil
+		}
+
+		input = pepperPassword(password, pepper)
+	}
+
 	// Derive the key from the other password using the same parameters.
-	otherHash := argon2.IDKey([]byte(password), salt, p.iterations, p.memory, p.parallelism, p.keyLength)
+	otherHash := argon2.IDKey(input, salt, p.iterations, p.memory, p.parallelism, p.keyLength)
 
 	// Check that the contents of the hashed passwords are identical. Note
 	// that we are using the subtle.ConstantTimeCompare() function for this
@@ -59,9 +140,34 @@ func ComparePassword(encodedHash, password string) (match bool, err error) {
 	return false, nil
 }
 
+// PasswordNeedsRehash reports whether the encoded hash is weaker than the
+// policy: not Argon2id, lower cost parameters, a short or shared salt, or a
+// pepper other than the current one.
+func PasswordNeedsRehash(encodedHash string, policy PasswordHashPolicy) bool {
+	p, _, _, err := decodeHash(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	if p.memory < policy.Memory || p.iterations < policy.Iterations || p.parallelism < policy.Parallelism {
+		return true
+	}
+
+	if p.saltLength < passwordSaltLength || p.keyLength < passwordKeyLength {
+		return true
+	}
+
+	expectedKeyID := ""
+	if policy.Pepper != "" {
+		expectedKeyID = pepperID(policy.Pepper)
+	}
+
+	return p.keyID != expectedKeyID
+}
+
 func decodeHash(encodedHash string) (p *params, salt, hash []byte, err error) {
 	vals := strings.Split(encodedHash, "$")
-	if len(vals) != 6 {
+	if len(vals) != 6 || vals[1] != "argon2id" {
 		return nil, nil, nil, &errors.ErrInvalidHash
 	}
 
@@ -75,10 +181,12 @@ func decodeHash(encodedHash string) (p *params, salt, hash []byte, err error) {
 	}
 
 	p = &params{}
-	_, err = fmt.Sscanf(vals[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism)
+	costs, keyID, _ := strings.Cut(vals[3], ",keyid=")
+	_, err = fmt.Sscanf(costs, "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism)
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	p.keyID = keyID
 
 	salt, err = base64.RawStdEncoding.Strict().DecodeString(vals[4])
 	if err != nil {
@@ -94,3 +202,50 @@ func decodeHash(encodedHash string) (p *params, salt, hash []byte, err error) {
 
 	return p, salt, hash, nil
 }
+
+// decodeArgon2idHash decodes the hash and rejects cost parameters above the
+// limits, as hashes may have been imported from other systems.
+func decodeArgon2idHash(encodedHash string) (p *params, salt, hash []byte, err error) {
+	p, salt, hash, err = decodeHash(encodedHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if p.memory > constants.MaxPasswordHashMemory || p.iterations > constants.MaxPasswordHashIterations || p.parallelism > constants.MaxPasswordHashParallelism {
+		return nil, nil, nil, &errors.ErrInvalidHash
+	}
+
+	return p, salt, hash, nil
+}
+
+// pepperPassword keys the password with the tenant's pepper, so hashes taken
+// without the tenant row, e.g. from a dump of the credentials table, can't be
+// brute-forced on their own. The pepper is stored in the same database, so it
+// doesn't help once the whole database leaks.
+func pepperPassword(password, pepper string) []byte {
+	if pepper == "" {
+		return []byte(password)
+	}
+
+	mac := hmac.New(sha256.New, []byte(pepper))
+	mac.Write([]byte(password))
+	return mac.Sum(nil)
+}
+
+// findPepper returns the pepper with the given keyid.
+func findPepper(keyID string, peppers []string) (string, bool) {
+	for _, pepper := range peppers {
+		if pepper != "" && pepperID(pepper) == keyID {
+			return pepper, true
+		}
+	}
+
+	return "", false
+}
+
+// pepperID is a short fingerprint of the pepper, stored as the PHC keyid
+// parameter so rotations can be detected.
+func pepperID(pepper string) string {
+	sum := sha256.Sum256([]byte(pepper))
+	return base64.RawStdEncoding.EncodeToString(sum[:6])
+}
@@ -0,0 +1,119 @@
+package application_utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPolicy keeps costs low so the suite stays fast.
+var testPolicy = PasswordHashPolicy{
+	Memory:      8 * 1024,
+	Iterations:  1,
+	Parallelism: 1,
+	Pepper:      "test-pepper-key",
+}
+
+func TestHashPassword_SamePasswordProducesDifferentHashes(t *testing.T) {
+	h1, err := HashPassword("s3cret!", testPolicy)
+	require.NoError(t, err)
+	h2, err := HashPassword("s3cret!", testPolicy)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, h1, h2)
+}
+
+func TestComparePassword_MatchesOnlyTheHashedPassword(t *testing.T) {
+	hash, err := HashPassword("s3cret!", testPolicy)
+	require.NoError(t, err)
+
+	match, err := ComparePassword(hash, "s3cret!", testPolicy.Pepper)
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = ComparePassword(hash, "wrong", testPolicy.Pepper)
+	require.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestComparePassword_RejectsDifferentPepper(t *testing.T) {
+	hash, err := HashPassword("s3cret!", testPolicy)
+	require.NoError(t, err)
+
+	match, err := ComparePassword(hash, "s3cret!", "another-pepper-key")
+	require.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestComparePassword_VerifiesHashWithPreviousPepper(t *testing.T) {
+	hash, err := HashPassword("s3cret!", testPolicy)
+	require.NoError(t, err)
+
+	rotated := testPolicy
+	rotated.Pepper = "another-pepper-key"
+	rotated.PreviousPepper = testPolicy.Pepper
+
+	match, err := ComparePassword(hash, "s3cret!", rotated.Peppers()...)
+	require.NoError(t, err)
+	assert.True(t, match)
+	assert.True(t, PasswordNeedsRehash(hash, rotated))
+}
+
+func TestComparePassword_VerifiesUnpepperedHash(t *testing.T) {
+	policy := testPolicy
+	policy.Pepper = ""
+
+	hash, err := HashPassword("s3cret!", policy)
+	require.NoError(t, err)
+
+	match, err := ComparePassword(hash, "s3cret!", testPolicy.Pepper)
+	require.NoError(t, err)
+	assert.True(t, match)
+}
+
+func TestComparePassword_InvalidHash(t *testing.T) {
+	_, err := ComparePassword("not-a-hash", "s3cret!", "")
+	assert.Error(t, err)
+}
+
+func TestComparePassword_RejectsMemoryAboveTenantLimit(t *testing.T) {
+	hash, err := HashPassword("s3cret!", testPolicy)
+	require.NoError(t, err)
+
+	oversized := strings.Replace(hash, "m=8192", "m=262145", 1)
+	require.NotEqual(t, hash, oversized)
+
+	_, err = ComparePassword(oversized, "s3cret!", testPolicy.Pepper)
+	assert.Error(t, err)
+}
+
+func TestPasswordNeedsRehash(t *testing.T) {
+	hash, err := HashPassword("s3cret!", testPolicy)
+	require.NoError(t, err)
+
+	assert.False(t, PasswordNeedsRehash(hash, testPolicy))
+
+	stronger := testPolicy
+	stronger.Memory *= 2
+	assert.True(t, PasswordNeedsRehash(hash, stronger))
+
+	stronger = testPolicy
+	stronger.Iterations++
+	assert.True(t, PasswordNeedsRehash(hash, stronger))
+
+	rotated := testPolicy
+	rotated.Pepper = "another-pepper-key"
+	assert.True(t, PasswordNeedsRehash(hash, rotated))
+}
+
+func TestPasswordNeedsRehash_SharedSaltHash(t *testing.T) {
+	// Produced before per-user salts, with the tenant secret as the salt.
+	legacyHash := "$argon2id$v=19$m=65536,t=3,p=4$dGVzdC1wZXBwZXIta2V5$EslYWrSE7T42JwvE1MtfI0tO5JjcxXvSe7DyVVQE+/s"
+
+	match, err := ComparePassword(legacyHash, "testpassword", testPolicy.Pepper)
+	require.NoError(t, err)
+	assert.True(t, match)
+	assert.True(t, PasswordNeedsRehash(legacyHash, testPolicy))
+}
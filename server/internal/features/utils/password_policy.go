@@ -0,0 +1,154 @@
+package application_utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
+	"github.com/gate-keeper/internal/infra/breach"
+	"github.com/google/uuid"
+)
+
+// PasswordHistoryRepository is the subset of repository operations
+// ValidatePasswordChange and RecordPasswordHistory need.
+type PasswordHistoryRepository interface {
+	AddPasswordHistory(ctx context.Context, passwordHistory *entities.PasswordHistory) error
+	ListPasswordHistoryByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]entities.PasswordHistory, error)
+	RemoveOldPasswordHistory(ctx context.Context, userID uuid.UUID, keep int) error
+}
+
+// TenantPasswordPolicy returns the password policy configured on the tenant.
+// The words of the tenant and application names are always forbidden.
+func TenantPasswordPolicy(tenant *entities.Tenant, application *entities.Application) services.PasswordPolicy {
+	policy := services.PasswordPolicy{
+		MinLength:        constants.DefaultPasswordMinLength,
+		RequireUppercase: tenant.PasswordRequireUppercase,
+		RequireLowercase: tenant.PasswordRequireLowercase,
+		RequireDigit:     tenant.PasswordRequireDigit,
+		RequireSymbol:    tenant.PasswordRequireSymbol,
+		MaxAgeDays:       tenant.PasswordMaxAgeDays,
+		HistoryCount:     tenant.PasswordHistoryCount,
+		ForbiddenWords:   append([]string{}, tenant.PasswordForbiddenWords...),
+
+		BreachedPasswordCheck:     tenant.BreachedPasswordCheck,
+		BreachedPasswordThreshold: tenant.BreachedPasswordThreshold,
+	}
+
+	if tenant.PasswordMinLength > 0 {
+		policy.MinLength = tenant.PasswordMinLength
+	}
+
+	names := []string{tenant.Name}
+	if application != nil {
+		names = append(names, application.Name)
+	}
+
+	policy.ForbiddenWords = append(policy.ForbiddenWords, services.NameForbiddenWords(names...)...)
+
+	return policy
+}
+
+// ValidatePassword returns a *errors.PasswordPolicyError listing every rule
+// of the policy the password breaks, or errors.ErrPasswordBreached when it
+// complies but appears in the breach dataset.
+func ValidatePassword(policy services.PasswordPolicy, password string) error {
+	if violations := services.ValidatePassword(policy, password); len(violations) > 0 {
+		return errors.NewPasswordPolicyError(violations)
+	}
+
+	return checkBreachedPassword(policy, password)
+}
+
+// ValidatePasswordChange is ValidatePassword for users that already have
+// credentials: the new password must also differ from the current one and
+// the previous ones kept by the history policy.
+func ValidatePasswordChange(ctx context.Context, repository PasswordHistoryRepository, policy services.PasswordPolicy, peppers []string, userCredentials *entities.UserCredentials, password string) error {
+	violations := services.ValidatePassword(policy, password)
+
+	if policy.HistoryCount > 0 {
+		reused, err := isPasswordReused(ctx, repository, policy, peppers, userCredentials, password)
+
+		if err != nil {
+			return err
+		}
+
+		if reused {
+			violations = append(violations, errors.PasswordPolicyViolation{
+				Code:    constants.PasswordViolationRecentlyReused,
+				Message: fmt.Sprintf("must not be one of the last %d passwords", policy.HistoryCount),
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return errors.NewPasswordPolicyError(violations)
+	}
+
+	return checkBreachedPassword(policy, password)
+}
+
+// checkBreachedPassword looks the password up in the breach dataset when the
+// policy asks for it. It runs last so a weak password is reported with the
+// rules it breaks rather than as breached.
+func checkBreachedPassword(policy services.PasswordPolicy, password string) error {
+	if !policy.BreachedPasswordCheck {
+		return nil
+	}
+
+	breached, err := breach.IsBreached(password, policy.BreachedPasswordThreshold)
+
+	if err != nil {
+		return err
+	}
+
+	if breached {
+		return &errors.ErrPasswordBreached
+	}
+
+	return nil
+}
+
+func isPasswordReused(ctx context.Context, repository PasswordHistoryRepository, policy services.PasswordPolicy, peppers []string, userCredentials *entities.UserCredentials, password string) (bool, error) {
+	hashes := []string{userCredentials.PasswordHash}
+
+	if policy.HistoryCount > 1 {
+		history, err := repository.ListPasswordHistoryByUserID(ctx, userCredentials.UserID, policy.HistoryCount-1)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, entry := range history {
+			hashes = append(hashes, entry.PasswordHash)
+		}
+	}
+
+	for _, hash := range hashes {
+		// Hashes made with a pepper the tenant no longer uses can't be
+		// compared and never match.
+		if matches, err := ComparePassword(hash, password, peppers...); err == nil && matches {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// RecordPasswordHistory keeps the hash about to be replaced so it can't be
+// set again, and drops the entries the history policy no longer covers. It
+// must be called before the new hash is stored on userCredentials.
+func RecordPasswordHistory(ctx context.Context, repository PasswordHistoryRepository, policy services.PasswordPolicy, userCredentials *entities.UserCredentials) error {
+	// The current password counts towards HistoryCount.
+	keep := max(policy.HistoryCount-1, 0)
+
+	if keep > 0 {
+		if err := repository.AddPasswordHistory(ctx, entities.NewPasswordHistory(userCredentials.UserID, userCredentials.PasswordHash)); err != nil {
+			return err
+		}
+	}
+
+	return repository.RemoveOldPasswordHistory(ctx, userCredentials.UserID, keep)
+}
@@ -0,0 +1,35 @@
+package application_utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+)
+
+// PasswordRehashRepository is the subset of repository operations RehashPasswordIfNeeded needs.
+type PasswordRehashRepository interface {
+	UpdateUserCredentials(ctx context.Context, userCredentials *entities.UserCredentials) error
+}
+
+// RehashPasswordIfNeeded replaces the stored hash with one produced by the
+// current policy when it is weaker, upgrading imported hashes to Argon2id. It
+// must only be called once password has been verified against the stored hash.
+func RehashPasswordIfNeeded(ctx context.Context, repository PasswordRehashRepository, userCredentials *entities.UserCredentials, password string, policy PasswordHashPolicy) error {
+	if !PasswordNeedsRehash(userCredentials.PasswordHash, policy) {
+		return nil
+	}
+
+	hashedPassword, err := HashPassword(password, policy)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	userCredentials.PasswordHash = hashedPassword
+	userCredentials.PasswordAlgorithm = constants.UserCredentialsPasswordAlgorithmArgon2id
+	userCredentials.UpdatedAt = &now
+
+	return repository.UpdateUserCredentials(ctx, userCredentials)
+}
@@ -0,0 +1,36 @@
+package application_utils
+
+import (
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
+	"github.com/google/uuid"
+)
+
+// NewRedirectURIs validates and deduplicates the URIs an application registers
+// for the given kind.
+func NewRedirectURIs(applicationID uuid.UUID, kind string, uris []string) ([]entities.ApplicationRedirectURI, error) {
+	redirectURIs := make([]entities.ApplicationRedirectURI, 0, len(uris))
+	seen := make(map[string]bool, len(uris))
+
+	for _, uri := range uris {
+		if !services.ValidateRedirectURI(uri) {
+			return nil, &errors.ErrInvalidRedirectURI
+		}
+
+		if seen[uri] {
+			continue
+		}
+
+		seen[uri] = true
+
+		redirectURI, err := entities.NewApplicationRedirectURI(applicationID, uri, kind)
+		if err != nil {
+			return nil, err
+		}
+
+		redirectURIs = append(redirectURIs, *redirectURI)
+	}
+
+	return redirectURIs, nil
+}
@@ -0,0 +1,27 @@
+package application_utils
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// UserPermissionRepository is the subset of repository operations UserPermissionNames needs.
+type UserPermissionRepository interface {
+	GetUserPermissionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]string, error)
+}
+
+// UserPermissionNames returns the names of the permissions granted to the user
+// through their roles in the application, as emitted in the permissions claim.
+func UserPermissionNames(ctx context.Context, repository UserPermissionRepository, userID, applicationID uuid.UUID) ([]string, error) {
+	permissions, err := repository.GetUserPermissionsFromApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if permissions == nil {
+		return []string{}, nil
+	}
+
+	return permissions, nil
+}
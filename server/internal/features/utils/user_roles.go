@@ -0,0 +1,29 @@
+package application_utils
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+// UserRoleRepository is the subset of repository operations UserRoleNames needs.
+type UserRoleRepository interface {
+	GetUserRolesFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]entities.ApplicationRole, error)
+}
+
+// UserRoleNames returns the names of the user's roles in the application, as
+// emitted in the roles claim. Roles of other applications are never included.
+func UserRoleNames(ctx context.Context, repository UserRoleRepository, userID, applicationID uuid.UUID) ([]string, error) {
+	roles, err := repository.GetUserRolesFromApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+
+	return names, nil
+}
@@ -0,0 +1,311 @@
+package application_utils
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/errors"
+)
+
+// Users are imported and exported as CSV or JSON Lines with the same fields.
+// CSV files start with a header naming the columns below, in any order; JSON
+// Lines objects use the camelCase keys. List values are separated by ";" in
+// CSV and are string arrays in JSON Lines:
+//
+//	email                required
+//	first_name           firstName
+//	last_name            lastName
+//	display_name         displayName
+//	phone_number         phoneNumber
+//	address              address
+//	photo_url            photoUrl
+//	email_confirmed      emailConfirmed      true/false, defaults to false
+//	is_active            isActive            true/false, defaults to true
+//	password_hash        passwordHash        Argon2id or a supported foreign hash
+//	roles                roles               <applicationId>:<roleName>
+//	external_identities  externalIdentities  <applicationId>:<provider>:<providerUserId>
+var userTransferColumns = []string{
+	"email",
+	"first_name",
+	"last_name",
+	"display_name",
+	"phone_number",
+	"address",
+	"photo_url",
+	"email_confirmed",
+	"is_active",
+	"password_hash",
+	"roles",
+	"external_identities",
+}
+
+const userTransferListSeparator = ";"
+
+// UserTransferRecord is a user as it appears in an import or export file.
+type UserTransferRecord struct {
+	Email              string   `json:"email"`
+	FirstName          string   `json:"firstName"`
+	LastName           string   `json:"lastName"`
+	DisplayName        string   `json:"displayName"`
+	PhoneNumber        *string  `json:"phoneNumber,omitempty"`
+	Address            *string  `json:"address,omitempty"`
+	PhotoURL           *string  `json:"photoUrl,omitempty"`
+	EmailConfirmed     bool     `json:"emailConfirmed"`
+	IsActive           *bool    `json:"isActive,omitempty"`
+	PasswordHash       string   `json:"passwordHash,omitempty"`
+	Roles              []string `json:"roles"`
+	ExternalIdentities []string `json:"externalIdentities"`
+}
+
+// UserTransferRow is a parsed row of an import file. Err is set when the row
+// could not be parsed; the other rows are still usable.
+type UserTransferRow struct {
+	Row    int // 1-based, not counting the CSV header
+	Record UserTransferRecord
+	Err    error
+}
+
+// ParseUserTransferFormat maps a format name or media type to a transfer format.
+func ParseUserTransferFormat(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case constants.UserTransferFormatCSV, "text/csv":
+		return constants.UserTransferFormatCSV, nil
+	case constants.UserTransferFormatJSONL, "ndjson", "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return constants.UserTransferFormatJSONL, nil
+	}
+
+	return "", &errors.ErrUserImportInvalidFormat
+}
+
+// ParseUserTransferRows reads every row of an import file. Malformed rows are
+// reported on the row itself; an error is only returned when the file as a
+// whole cannot be read.
+func ParseUserTransferRows(format string, reader io.Reader) ([]UserTransferRow, error) {
+	switch format {
+	case constants.UserTransferFormatCSV:
+		return parseUserTransferCSV(reader)
+	case constants.UserTransferFormatJSONL:
+		return parseUserTransferJSONL(reader)
+	}
+
+	return nil, &errors.ErrUserImportInvalidFormat
+}
+
+func parseUserTransferCSV(reader io.Reader) ([]UserTransferRow, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, &errors.ErrUserImportEmpty
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !slices.Contains(userTransferColumns, name) {
+			return nil, &errors.ErrUserImportInvalidHeader
+		}
+		columns[name] = i
+	}
+
+	if _, ok := columns["email"]; !ok {
+		return nil, &errors.ErrUserImportInvalidHeader
+	}
+
+	rows := []UserTransferRow{}
+	for {
+		fields, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		row := UserTransferRow{Row: len(rows) + 1}
+		if err != nil {
+			if _, ok := err.(*csv.ParseError); !ok {
+				return nil, err
+			}
+
+			row.Err = err
+			rows = append(rows, row)
+			continue
+		}
+
+		row.Record, row.Err = userTransferRecordFromCSV(columns, fields)
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func userTransferRecordFromCSV(columns map[string]int, fields []string) (UserTransferRecord, error) {
+	get := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(fields) {
+			return ""
+		}
+		return strings.TrimSpace(fields[i])
+	}
+	optional := func(name string) *string {
+		if value := get(name); value != "" {
+			return &value
+		}
+		return nil
+	}
+	list := func(name string) []string {
+		values := []string{}
+		for _, value := range strings.Split(get(name), userTransferListSeparator) {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		return values
+	}
+
+	record := UserTransferRecord{
+		Email:              get("email"),
+		FirstName:          get("first_name"),
+		LastName:           get("last_name"),
+		DisplayName:        get("display_name"),
+		PhoneNumber:        optional("phone_number"),
+		Address:            optional("address"),
+		PhotoURL:           optional("photo_url"),
+		PasswordHash:       get("password_hash"),
+		Roles:              list("roles"),
+		ExternalIdentities: list("external_identities"),
+	}
+
+	if value := get("email_confirmed"); value != "" {
+		emailConfirmed, err := strconv.ParseBool(value)
+		if err != nil {
+			return record, goerrors.New("email_confirmed must be true or false")
+		}
+		record.EmailConfirmed = emailConfirmed
+	}
+
+	if value := get("is_active"); value != "" {
+		isActive, err := strconv.ParseBool(value)
+		if err != nil {
+			return record, goerrors.New("is_active must be true or false")
+		}
+		record.IsActive = &isActive
+	}
+
+	return record, nil
+}
+
+func parseUserTransferJSONL(reader io.Reader) ([]UserTransferRow, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	rows := []UserTransferRow{}
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		row := UserTransferRow{Row: len(rows) + 1}
+		decoder := json.NewDecoder(strings.NewReader(line))
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(&row.Record); err != nil {
+			row.Err = fmt.Errorf("invalid JSON: %w", err)
+		}
+
+		rows = append(rows, row)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// UserTransferWriter writes users in an export format.
+type UserTransferWriter interface {
+	Write(record UserTransferRecord) error
+	Flush() error
+}
+
+// NewUserTransferWriter returns a writer for the format. CSV output starts
+// with the header row.
+func NewUserTransferWriter(format string, writer io.Writer) (UserTransferWriter, error) {
+	switch format {
+	case constants.UserTransferFormatCSV:
+		csvWriter := csv.NewWriter(writer)
+		if err := csvWriter.Write(userTransferColumns); err != nil {
+			return nil, err
+		}
+		return &csvUserTransferWriter{writer: csvWriter}, nil
+	case constants.UserTransferFormatJSONL:
+		buffered := bufio.NewWriter(writer)
+		return &jsonlUserTransferWriter{writer: buffered, encoder: json.NewEncoder(buffered)}, nil
+	}
+
+	return nil, &errors.ErrUserImportInvalidFormat
+}
+
+type csvUserTransferWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvUserTransferWriter) Write(record UserTransferRecord) error {
+	isActive := true
+	if record.IsActive != nil {
+		isActive = *record.IsActive
+	}
+
+	return w.writer.Write([]string{
+		record.Email,
+		record.FirstName,
+		record.LastName,
+		record.DisplayName,
+		valueOrEmpty(record.PhoneNumber),
+		valueOrEmpty(record.Address),
+		valueOrEmpty(record.PhotoURL),
+		strconv.FormatBool(record.EmailConfirmed),
+		strconv.FormatBool(isActive),
+		record.PasswordHash,
+		strings.Join(record.Roles, userTransferListSeparator),
+		strings.Join(record.ExternalIdentities, userTransferListSeparator),
+	})
+}
+
+func (w *csvUserTransferWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonlUserTransferWriter struct {
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (w *jsonlUserTransferWriter) Write(record UserTransferRecord) error {
+	return w.encoder.Encode(record)
+}
+
+func (w *jsonlUserTransferWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
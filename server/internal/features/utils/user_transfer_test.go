@@ -0,0 +1,109 @@
+package application_utils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUserTransferFormat(t *testing.T) {
+	cases := map[string]string{
+		"csv":                  constants.UserTransferFormatCSV,
+		"text/csv":             constants.UserTransferFormatCSV,
+		"jsonl":                constants.UserTransferFormatJSONL,
+		"application/x-ndjson": constants.UserTransferFormatJSONL,
+	}
+
+	for value, expected := range cases {
+		format, err := ParseUserTransferFormat(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, format)
+	}
+
+	_, err := ParseUserTransferFormat("application/json")
+	assert.Error(t, err)
+}
+
+func TestParseUserTransferRows_CSV(t *testing.T) {
+	file := "email,first_name,email_confirmed,roles,external_identities\n" +
+		"ada@example.com,Ada,true,app:admin;app:viewer,app:github:42\n" +
+		"grace@example.com,Grace,maybe,,\n"
+
+	rows, err := ParseUserTransferRows(constants.UserTransferFormatCSV, strings.NewReader(file))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, 1, rows[0].Row)
+	assert.NoError(t, rows[0].Err)
+	assert.Equal(t, "ada@example.com", rows[0].Record.Email)
+	assert.Equal(t, "Ada", rows[0].Record.FirstName)
+	assert.True(t, rows[0].Record.EmailConfirmed)
+	assert.Nil(t, rows[0].Record.IsActive)
+	assert.Equal(t, []string{"app:admin", "app:viewer"}, rows[0].Record.Roles)
+	assert.Equal(t, []string{"app:github:42"}, rows[0].Record.ExternalIdentities)
+
+	assert.Equal(t, 2, rows[1].Row)
+	assert.Error(t, rows[1].Err)
+}
+
+func TestParseUserTransferRows_CSVInvalidHeader(t *testing.T) {
+	_, err := ParseUserTransferRows(constants.UserTransferFormatCSV, strings.NewReader("first_name\nAda\n"))
+	assert.Error(t, err)
+
+	_, err = ParseUserTransferRows(constants.UserTransferFormatCSV, strings.NewReader("email,password\nada@example.com,x\n"))
+	assert.Error(t, err)
+}
+
+func TestParseUserTransferRows_JSONL(t *testing.T) {
+	file := `{"email":"ada@example.com","isActive":false,"roles":["app:admin"]}` + "\n\n" +
+		`{"email":"grace@example.com","unknown":true}` + "\n" +
+		`not json` + "\n"
+
+	rows, err := ParseUserTransferRows(constants.UserTransferFormatJSONL, strings.NewReader(file))
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	assert.NoError(t, rows[0].Err)
+	require.NotNil(t, rows[0].Record.IsActive)
+	assert.False(t, *rows[0].Record.IsActive)
+	assert.Equal(t, []string{"app:admin"}, rows[0].Record.Roles)
+
+	assert.Error(t, rows[1].Err)
+	assert.Error(t, rows[2].Err)
+	assert.Equal(t, 3, rows[2].Row)
+}
+
+func TestUserTransferWriter_RoundTrip(t *testing.T) {
+	phone := "+1 555 0100"
+	isActive := false
+	record := UserTransferRecord{
+		Email:              "ada@example.com",
+		FirstName:          "Ada",
+		LastName:           "Lovelace",
+		DisplayName:        "Ada Lovelace",
+		PhoneNumber:        &phone,
+		EmailConfirmed:     true,
+		IsActive:           &isActive,
+		Roles:              []string{"app:admin", "app:viewer"},
+		ExternalIdentities: []string{"app:github:42"},
+	}
+
+	for _, format := range []string{constants.UserTransferFormatCSV, constants.UserTransferFormatJSONL} {
+		var buffer bytes.Buffer
+
+		writer, err := NewUserTransferWriter(format, &buffer)
+		require.NoError(t, err)
+		require.NoError(t, writer.Write(record))
+		require.NoError(t, writer.Flush())
+
+		rows, err := ParseUserTransferRows(format, &buffer)
+		require.NoError(t, err, format)
+		require.Len(t, rows, 1, format)
+		require.NoError(t, rows[0].Err, format)
+		assert.Equal(t, record, rows[0].Record, format)
+	}
+}
@@ -0,0 +1,81 @@
+package auditlog
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/signing"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrUnknownCheckpointKey is returned by VerifyCheckpoint when the key that
+// signed the checkpoint is no longer known, which says nothing about whether
+// the checkpoint was forged.
+var ErrUnknownCheckpointKey = errors.New("auditlog: unknown signing key")
+
+// SignCheckpoint signs the checkpoint with the current signing key of its
+// tenant. The signature is a compact JWS whose "kid" header names the key, so
+// an archived checkpoint can be checked against the tenant's JWKS. With an
+// HS256 key the signature can only be checked by the server, as the shared
+// secret is never published.
+func SignCheckpoint(checkpoint *entities.AuditLogCheckpoint) error {
+	key, err := signing.Current().SigningKey(checkpoint.TenantID)
+	if err != nil {
+		return err
+	}
+
+	token := jwt.NewWithClaims(key.SigningMethod(), checkpointClaims(checkpoint))
+	token.Header["kid"] = key.ID
+
+	signature, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	checkpoint.KeyID = key.ID
+	checkpoint.Signature = signature
+
+	return nil
+}
+
+// VerifyCheckpoint checks the checkpoint's signature and that the signed
+// claims match the checkpoint. It fails with ErrUnknownCheckpointKey when the
+// signing key is no longer known, e.g. once a rotated key has been purged.
+func VerifyCheckpoint(checkpoint *entities.AuditLogCheckpoint) error {
+	key, ok := signing.Current().Lookup(checkpoint.KeyID)
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownCheckpointKey, checkpoint.KeyID)
+	}
+
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{key.Algorithm}), jwt.WithJSONNumber())
+	claims := jwt.MapClaims{}
+	_, err := parser.ParseWithClaims(checkpoint.Signature, claims, func(token *jwt.Token) (any, error) {
+		if token.Header["kid"] != key.ID {
+			return nil, fmt.Errorf("auditlog: checkpoint is not signed with key %q", key.ID)
+		}
+
+		return key.VerificationKey(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for name, value := range checkpointClaims(checkpoint) {
+		if fmt.Sprint(claims[name]) != fmt.Sprint(value) {
+			return fmt.Errorf("auditlog: checkpoint claim %q doesn't match its signature", name)
+		}
+	}
+
+	return nil
+}
+
+func checkpointClaims(checkpoint *entities.AuditLogCheckpoint) jwt.MapClaims {
+	return jwt.MapClaims{
+		"tenant_id":      checkpoint.TenantID.String(),
+		"chain_sequence": checkpoint.ChainSequence,
+		"audit_log_id":   checkpoint.AuditLogID.String(),
+		"hash":           checkpoint.Hash,
+		"iat":            checkpoint.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,57 @@
+package auditlog
+
+import (
+	"testing"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/signing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func useKey(t *testing.T, algorithm string) *signing.Key {
+	key, err := signing.GenerateKey(algorithm)
+	require.NoError(t, err)
+
+	signing.SetProvider(signing.NewKeySet(key))
+	t.Cleanup(func() { signing.SetProvider(nil) })
+
+	return key
+}
+
+func newTestCheckpoint() *entities.AuditLogCheckpoint {
+	return entities.NewAuditLogCheckpoint(uuid.New(), 1234567, uuid.New(), entities.AuditLogGenesisHash)
+}
+
+func TestSignCheckpoint_VerifiesWithSigningKey(t *testing.T) {
+	for _, alg := range []string{signing.AlgorithmRS256, signing.AlgorithmES256, signing.AlgorithmEdDSA} {
+		key := useKey(t, alg)
+		checkpoint := newTestCheckpoint()
+
+		require.NoError(t, SignCheckpoint(checkpoint))
+
+		assert.Equal(t, key.ID, checkpoint.KeyID)
+		assert.NoError(t, VerifyCheckpoint(checkpoint), alg)
+	}
+}
+
+func TestVerifyCheckpoint_RejectsEditedCheckpoint(t *testing.T) {
+	useKey(t, signing.AlgorithmES256)
+	checkpoint := newTestCheckpoint()
+	require.NoError(t, SignCheckpoint(checkpoint))
+
+	checkpoint.Hash = "ff" + checkpoint.Hash[2:]
+
+	assert.Error(t, VerifyCheckpoint(checkpoint))
+}
+
+func TestVerifyCheckpoint_RejectsUnknownKey(t *testing.T) {
+	useKey(t, signing.AlgorithmES256)
+	checkpoint := newTestCheckpoint()
+	require.NoError(t, SignCheckpoint(checkpoint))
+
+	useKey(t, signing.AlgorithmES256)
+
+	assert.ErrorIs(t, VerifyCheckpoint(checkpoint), ErrUnknownCheckpointKey)
+}
@@ -0,0 +1,111 @@
+package auditlog
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CheckpointIntervalFromEnv reads AUDIT_LOG_CHECKPOINT_INTERVAL, how often
+// chain heads are checkpointed (default 1h). Zero disables checkpoints.
+func CheckpointIntervalFromEnv() time.Duration {
+	const fallback = time.Hour
+
+	value := os.Getenv("AUDIT_LOG_CHECKPOINT_INTERVAL")
+	if value == "" {
+		return fallback
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < 0 {
+		slog.Warn("invalid duration, using default", "variable", "AUDIT_LOG_CHECKPOINT_INTERVAL", "value", value, "default", fallback)
+		return fallback
+	}
+
+	return interval
+}
+
+// CheckpointScheduler periodically signs the head of every tenant's audit log
+// chain that moved since its last checkpoint.
+type CheckpointScheduler struct {
+	pool *pgxpool.Pool
+	tick time.Duration
+}
+
+func NewCheckpointScheduler(pool *pgxpool.Pool, tick time.Duration) *CheckpointScheduler {
+	return &CheckpointScheduler{pool: pool, tick: tick}
+}
+
+// Start runs one pass synchronously, then keeps running in the background
+// until ctx is cancelled.
+func (s *CheckpointScheduler) Start(ctx context.Context) error {
+	if err := s.RunOnce(ctx); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.tick)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.RunOnce(ctx); err != nil {
+					slog.ErrorContext(ctx, "Audit log checkpoint failed", "error", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// RunOnce checkpoints every chain head that moved since the last pass.
+func (s *CheckpointScheduler) RunOnce(ctx context.Context) error {
+	conn, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	queries := pgstore.New(tx)
+	auditLogRepository := repositories.AuditLogRepository{Store: queries}
+	checkpointRepository := repositories.AuditLogCheckpointRepository{Store: queries}
+
+	heads, err := auditLogRepository.ListAuditLogChainHeadsToCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, head := range heads {
+		if head.AuditLogID == nil {
+			continue
+		}
+
+		checkpoint := entities.NewAuditLogCheckpoint(head.TenantID, head.ChainSequence, *head.AuditLogID, head.Hash)
+
+		if err := SignCheckpoint(checkpoint); err != nil {
+			return err
+		}
+
+		if err := checkpointRepository.AddAuditLogCheckpoint(ctx, checkpoint); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
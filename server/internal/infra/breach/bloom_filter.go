@@ -0,0 +1,123 @@
+package breach
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+)
+
+// bloomFilterMagic starts every bloom filter file. It is followed by the
+// number of bits (uint64) and of hash functions (uint32), big-endian, and
+// then by the bit array.
+var bloomFilterMagic = [8]byte{'G', 'K', 'B', 'L', 'O', 'O', 'M', '1'}
+
+// BloomFilter is a compact, membership-only breach dataset. It can't tell
+// how often a password was breached, so the breach threshold is applied when
+// the filter is built instead of when it is queried. A small share of
+// passwords that were never breached (the false positive rate) is rejected.
+type BloomFilter struct {
+	bits   []byte
+	size   uint64 // number of bits
+	hashes uint32 // number of hash functions
+}
+
+// NewBloomFilter sizes a filter for the expected number of entries and
+// false positive rate.
+func NewBloomFilter(entries uint64, falsePositiveRate float64) *BloomFilter {
+	entries = max(entries, 1)
+
+	size := uint64(math.Ceil(-float64(entries) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	size = max(size, 8)
+	hashes := uint32(max(math.Round(float64(size)/float64(entries)*math.Ln2), 1))
+
+	return &BloomFilter{
+		bits:   make([]byte, (size+7)/8),
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+// Add records a SHA-1 digest in the filter.
+func (b *BloomFilter) Add(digest [sha1.Size]byte) {
+	h1, h2 := bloomHashes(digest)
+
+	for i := uint64(0); i < uint64(b.hashes); i++ {
+		bit := (h1 + i*h2) % b.size
+		b.bits[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+func (b *BloomFilter) IsBreached(digest [sha1.Size]byte, _ int) (bool, error) {
+	h1, h2 := bloomHashes(digest)
+
+	for i := uint64(0); i < uint64(b.hashes); i++ {
+		bit := (h1 + i*h2) % b.size
+		if b.bits[bit/8]&(1<<(bit%8)) == 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// bloomHashes derives the two hashes of the Kirsch-Mitzenmacher scheme from
+// the digest, which is already uniformly distributed.
+func bloomHashes(digest [sha1.Size]byte) (uint64, uint64) {
+	return binary.BigEndian.Uint64(digest[0:8]), binary.BigEndian.Uint64(digest[8:16]) | 1
+}
+
+// WriteBloomFilter serializes the filter in the format LoadBloomFilter reads.
+func WriteBloomFilter(w io.Writer, b *BloomFilter) error {
+	header := make([]byte, 0, len(bloomFilterMagic)+12)
+	header = append(header, bloomFilterMagic[:]...)
+	header = binary.BigEndian.AppendUint64(header, b.size)
+	header = binary.BigEndian.AppendUint32(header, b.hashes)
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	_, err := w.Write(b.bits)
+	return err
+}
+
+// LoadBloomFilter reads a filter written by WriteBloomFilter.
+func LoadBloomFilter(r io.Reader) (*BloomFilter, error) {
+	header := make([]byte, len(bloomFilterMagic)+12)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("breach: reading bloom filter header: %w", err)
+	}
+
+	if [8]byte(header[:8]) != bloomFilterMagic {
+		return nil, fmt.Errorf("breach: not a bloom filter file")
+	}
+
+	size := binary.BigEndian.Uint64(header[8:16])
+	hashes := binary.BigEndian.Uint32(header[16:20])
+
+	if size == 0 || hashes == 0 {
+		return nil, fmt.Errorf("breach: invalid bloom filter parameters")
+	}
+
+	bits := make([]byte, (size+7)/8)
+	if _, err := io.ReadFull(r, bits); err != nil {
+		return nil, fmt.Errorf("breach: reading bloom filter bits: %w", err)
+	}
+
+	return &BloomFilter{bits: bits, size: size, hashes: hashes}, nil
+}
+
+// LoadBloomFilterFile reads a bloom filter from disk into memory.
+func LoadBloomFilterFile(path string) (*BloomFilter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("breach: opening bloom filter: %w", err)
+	}
+	defer file.Close()
+
+	return LoadBloomFilter(bufio.NewReader(file))
+}
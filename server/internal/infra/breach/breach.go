@@ -0,0 +1,72 @@
+package breach
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Corpus answers whether a password appears in a breach dataset. Passwords
+// are looked up by their SHA-1 digest, the key used by Have I Been Pwned.
+type Corpus interface {
+	// IsBreached reports whether the digest was seen in at least threshold
+	// breaches. Corpora that only record membership ignore the threshold.
+	IsBreached(digest [sha1.Size]byte, threshold int) (bool, error)
+}
+
+var (
+	corpusMu sync.RWMutex
+	corpus   Corpus
+)
+
+// SetCorpus installs the process-wide Corpus.
+func SetCorpus(c Corpus) {
+	corpusMu.Lock()
+	defer corpusMu.Unlock()
+	corpus = c
+}
+
+// Loaded reports whether a Corpus was installed.
+func Loaded() bool {
+	corpusMu.RLock()
+	defer corpusMu.RUnlock()
+	return corpus != nil
+}
+
+// IsBreached consults the process-wide Corpus. When none was installed no
+// password is considered breached.
+func IsBreached(password string, threshold int) (bool, error) {
+	corpusMu.RLock()
+	c := corpus
+	corpusMu.RUnlock()
+
+	if c == nil {
+		return false, nil
+	}
+
+	return c.IsBreached(sha1.Sum([]byte(password)), max(threshold, 1))
+}
+
+// LoadFromEnv opens the breach dataset configured in the environment:
+//
+//   - BREACHED_PASSWORDS_RANGE_DIR:  directory of HIBP range files, one per
+//     5-character SHA-1 prefix, as written by the PwnedPasswordsDownloader.
+//   - BREACHED_PASSWORDS_BLOOM_FILE: bloom filter built with WriteBloomFilter.
+//
+// It returns nil when neither is set.
+func LoadFromEnv() (Corpus, error) {
+	rangeDir := os.Getenv("BREACHED_PASSWORDS_RANGE_DIR")
+	bloomFile := os.Getenv("BREACHED_PASSWORDS_BLOOM_FILE")
+
+	switch {
+	case rangeDir != "" && bloomFile != "":
+		return nil, fmt.Errorf("breach: set either BREACHED_PASSWORDS_RANGE_DIR or BREACHED_PASSWORDS_BLOOM_FILE, not both")
+	case rangeDir != "":
+		return OpenRangeDirectory(rangeDir)
+	case bloomFile != "":
+		return LoadBloomFilterFile(bloomFile)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,147 @@
+package breach_test
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gate-keeper/internal/infra/breach"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeRangeFiles writes a range dataset holding the given passwords and
+// breach counts, plus the empty "00000" file used to recognise the directory.
+func writeRangeFiles(t *testing.T, passwords map[string]int) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	files := map[string][]string{"00000": nil}
+
+	for password, count := range passwords {
+		digest := sha1.Sum([]byte(password))
+		hash := strings.ToUpper(hex.EncodeToString(digest[:]))
+		files[hash[:5]] = append(files[hash[:5]], fmt.Sprintf("%s:%d", hash[5:], count))
+	}
+
+	for prefix, lines := range files {
+		content := strings.Join(lines, "\r\n")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, prefix+".txt"), []byte(content), 0o600))
+	}
+
+	return dir
+}
+
+func TestRangeDirectory_IsBreached(t *testing.T) {
+	dir := writeRangeFiles(t, map[string]int{"password": 52256179, "rarely-used": 2})
+
+	corpus, err := breach.OpenRangeDirectory(dir)
+	require.NoError(t, err)
+
+	breached, err := corpus.IsBreached(sha1.Sum([]byte("password")), 1)
+	require.NoError(t, err)
+	assert.True(t, breached)
+
+	breached, err = corpus.IsBreached(sha1.Sum([]byte("rarely-used")), 1)
+	require.NoError(t, err)
+	assert.True(t, breached)
+
+	breached, err = corpus.IsBreached(sha1.Sum([]byte("rarely-used")), 3)
+	require.NoError(t, err)
+	assert.False(t, breached, "count below the threshold")
+}
+
+func TestRangeDirectory_MissingRangeFileIsAnError(t *testing.T) {
+	dir := writeRangeFiles(t, map[string]int{})
+
+	corpus, err := breach.OpenRangeDirectory(dir)
+	require.NoError(t, err)
+
+	_, err = corpus.IsBreached(sha1.Sum([]byte("not-in-any-file")), 1)
+	assert.Error(t, err)
+}
+
+func TestOpenRangeDirectory_RejectsOtherDirectories(t *testing.T) {
+	_, err := breach.OpenRangeDirectory(t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestBloomFilter_RoundTrip(t *testing.T) {
+	filter := breach.NewBloomFilter(100, 0.001)
+	for i := range 100 {
+		filter.Add(sha1.Sum([]byte(fmt.Sprintf("breached-%d", i))))
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, breach.WriteBloomFilter(&buf, filter))
+
+	loaded, err := breach.LoadBloomFilter(&buf)
+	require.NoError(t, err)
+
+	for i := range 100 {
+		breached, err := loaded.IsBreached(sha1.Sum([]byte(fmt.Sprintf("breached-%d", i))), 1)
+		require.NoError(t, err)
+		assert.True(t, breached)
+	}
+
+	breached, err := loaded.IsBreached(sha1.Sum([]byte("correct horse battery staple")), 1)
+	require.NoError(t, err)
+	assert.False(t, breached)
+}
+
+func TestLoadBloomFilter_RejectsOtherFiles(t *testing.T) {
+	_, err := breach.LoadBloomFilter(strings.NewReader("not a bloom filter at all"))
+	assert.Error(t, err)
+}
+
+func TestIsBreached_WithoutCorpus(t *testing.T) {
+	breach.SetCorpus(nil)
+
+	breached, err := breach.IsBreached("password", 1)
+	require.NoError(t, err)
+	assert.False(t, breached)
+	assert.False(t, breach.Loaded())
+}
+
+func TestIsBreached_UsesInstalledCorpus(t *testing.T) {
+	corpus, err := breach.OpenRangeDirectory(writeRangeFiles(t, map[string]int{"password": 10}))
+	require.NoError(t, err)
+
+	breach.SetCorpus(corpus)
+	t.Cleanup(func() { breach.SetCorpus(nil) })
+
+	breached, err := breach.IsBreached("password", 0)
+	require.NoError(t, err)
+	assert.True(t, breached, "a threshold below one is treated as one")
+
+	breached, err = breach.IsBreached("password", 11)
+	require.NoError(t, err)
+	assert.False(t, breached)
+}
+
+func TestLoadFromEnv(t *testing.T) {
+	dir := writeRangeFiles(t, map[string]int{})
+
+	t.Setenv("BREACHED_PASSWORDS_RANGE_DIR", "")
+	t.Setenv("BREACHED_PASSWORDS_BLOOM_FILE", "")
+
+	corpus, err := breach.LoadFromEnv()
+	require.NoError(t, err)
+	assert.Nil(t, corpus)
+
+	t.Setenv("BREACHED_PASSWORDS_RANGE_DIR", dir)
+
+	corpus, err = breach.LoadFromEnv()
+	require.NoError(t, err)
+	assert.IsType(t, &breach.RangeDirectory{}, corpus)
+
+	t.Setenv("BREACHED_PASSWORDS_BLOOM_FILE", filepath.Join(dir, "filter.bin"))
+
+	_, err = breach.LoadFromEnv()
+	assert.Error(t, err, "both datasets configured")
+}
@@ -0,0 +1,127 @@
+package breach
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// rangePrefixLength is the number of hex characters of the SHA-1 digest that
+// name a range file; the remaining 35 are listed inside it.
+const rangePrefixLength = 5
+
+// errStopWalk ends walkRangeFile once the hash being looked up is found.
+var errStopWalk = errors.New("breach: stop walk")
+
+// RangeDirectory is a local copy of the HIBP k-anonymity range API: a file
+// per prefix (e.g. "21BD1" or "21BD1.txt") holding "SUFFIX:COUNT" lines.
+// Files are read on demand, so the dataset doesn't have to fit in memory.
+type RangeDirectory struct {
+	dir string
+}
+
+// OpenRangeDirectory checks that dir looks like a range dataset by looking
+// for the file of the first prefix.
+func OpenRangeDirectory(dir string) (*RangeDirectory, error) {
+	r := &RangeDirectory{dir: dir}
+
+	if _, err := r.rangeFile("00000"); err != nil {
+		return nil, fmt.Errorf("breach: %s is not a range directory: %w", dir, err)
+	}
+
+	return r, nil
+}
+
+func (r *RangeDirectory) IsBreached(digest [sha1.Size]byte, threshold int) (bool, error) {
+	prefix := strings.ToUpper(hex.EncodeToString(digest[:]))[:rangePrefixLength]
+
+	path, err := r.rangeFile(prefix)
+	if err != nil {
+		return false, err
+	}
+
+	breached := false
+	err = walkRangeFile(path, prefix, func(entry [sha1.Size]byte, occurrences int) error {
+		if entry == digest {
+			breached = occurrences >= threshold
+			return errStopWalk
+		}
+		return nil
+	})
+
+	if err != nil && err != errStopWalk {
+		return false, err
+	}
+
+	return breached, nil
+}
+
+// rangeFile returns the path of the file holding prefix, with or without the
+// .txt extension.
+func (r *RangeDirectory) rangeFile(prefix string) (string, error) {
+	for _, name := range []string{prefix, prefix + ".txt"} {
+		path := filepath.Join(r.dir, name)
+
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("breach: range file %s not found", prefix)
+}
+
+// Walk calls fn for every hash of the dataset, in prefix order.
+func (r *RangeDirectory) Walk(fn func(digest [sha1.Size]byte, occurrences int) error) error {
+	for prefix := 0; prefix < 1<<(4*rangePrefixLength); prefix++ {
+		name := fmt.Sprintf("%05X", prefix)
+
+		path, err := r.rangeFile(name)
+		if err != nil {
+			return err
+		}
+
+		if err := walkRangeFile(path, name, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkRangeFile(path, prefix string, fn func(digest [sha1.Size]byte, occurrences int) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		suffix, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !found {
+			continue
+		}
+
+		var digest [sha1.Size]byte
+		if _, err := hex.Decode(digest[:], []byte(prefix+suffix)); err != nil {
+			return fmt.Errorf("breach: invalid hash in range file %s: %w", path, err)
+		}
+
+		occurrences, err := strconv.Atoi(count)
+		if err != nil {
+			return fmt.Errorf("breach: invalid count in range file %s: %w", path, err)
+		}
+
+		if err := fn(digest, occurrences); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
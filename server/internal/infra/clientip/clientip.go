@@ -0,0 +1,109 @@
+package clientip
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"os"
+	"strings"
+	"sync"
+)
+
+var (
+	trustedProxiesMu sync.RWMutex
+	trustedProxies   []netip.Prefix
+)
+
+// SetTrustedProxies sets the reverse proxies whose X-Forwarded-For and
+// X-Real-Ip headers RealIP honours. With none, only RemoteAddr is used.
+func SetTrustedProxies(prefixes []netip.Prefix) {
+	trustedProxiesMu.Lock()
+	defer trustedProxiesMu.Unlock()
+	trustedProxies = prefixes
+}
+
+// TrustedProxiesFromEnv parses TRUSTED_PROXY_CIDRS, a comma-separated list of
+// CIDRs or single addresses, e.g. "10.0.0.0/8,127.0.0.1".
+func TrustedProxiesFromEnv() ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+
+	for _, value := range strings.Split(os.Getenv("TRUSTED_PROXY_CIDRS"), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		if !strings.Contains(value, "/") {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return nil, fmt.Errorf("clientip: invalid trusted proxy %q: %w", value, err)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("clientip: invalid trusted proxy %q: %w", value, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+func isTrustedProxy(addr netip.Addr) bool {
+	trustedProxiesMu.RLock()
+	defer trustedProxiesMu.RUnlock()
+
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RealIP returns the client IP address of the request, without port. It is
+// the address requests are rate limited by and the one recorded in audit
+// logs, sessions and authorization codes. Proxy headers are only honoured
+// when the request comes from a trusted proxy, otherwise any client could
+// pick its own address. X-Forwarded-For is read from
+// the right, skipping trusted proxies, since only the entries they appended
+// can be relied on.
+func RealIP(r *http.Request) string {
+	remote, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	client := remote.Addr().Unmap()
+	if !isTrustedProxy(client) {
+		return client.String()
+	}
+
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				break
+			}
+
+			client = hop.Unmap()
+			if !isTrustedProxy(client) {
+				break
+			}
+		}
+
+		return client.String()
+	}
+
+	if realIP, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-Ip"))); err == nil {
+		return realIP.Unmap().String()
+	}
+
+	return client.String()
+}
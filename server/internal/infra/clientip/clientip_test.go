@@ -0,0 +1,59 @@
+package clientip_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gate-keeper/internal/infra/clientip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func useTrustedProxies(t *testing.T, cidrs string) {
+	t.Setenv("TRUSTED_PROXY_CIDRS", cidrs)
+
+	prefixes, err := clientip.TrustedProxiesFromEnv()
+	require.NoError(t, err)
+
+	clientip.SetTrustedProxies(prefixes)
+	t.Cleanup(func() { clientip.SetTrustedProxies(nil) })
+}
+
+func TestRealIP_IgnoresHeadersFromUntrustedClients(t *testing.T) {
+	useTrustedProxies(t, "10.0.0.0/8")
+
+	r := httptest.NewRequest("POST", "/", nil)
+	r.RemoteAddr = "203.0.113.7:4242"
+	r.Header.Set("X-Forwarded-For", "198.51.100.1")
+	r.Header.Set("X-Real-Ip", "198.51.100.2")
+
+	assert.Equal(t, "203.0.113.7", clientip.RealIP(r))
+}
+
+func TestRealIP_SkipsTrustedProxiesFromTheRight(t *testing.T) {
+	useTrustedProxies(t, "10.0.0.0/8,192.0.2.10")
+
+	r := httptest.NewRequest("POST", "/", nil)
+	r.RemoteAddr = "10.0.0.5:4242"
+	r.Header.Set("X-Forwarded-For", "198.51.100.99, 203.0.113.7, 192.0.2.10")
+
+	assert.Equal(t, "203.0.113.7", clientip.RealIP(r))
+}
+
+func TestRealIP_FallsBackToXRealIPFromTrustedProxy(t *testing.T) {
+	useTrustedProxies(t, "127.0.0.1")
+
+	r := httptest.NewRequest("POST", "/", nil)
+	r.RemoteAddr = "127.0.0.1:4242"
+	r.Header.Set("X-Real-Ip", "203.0.113.7")
+
+	assert.Equal(t, "203.0.113.7", clientip.RealIP(r))
+}
+
+func TestTrustedProxiesFromEnv_RejectsInvalidEntries(t *testing.T) {
+	t.Setenv("TRUSTED_PROXY_CIDRS", "10.0.0.0/8,proxy.local")
+
+	_, err := clientip.TrustedProxiesFromEnv()
+
+	assert.Error(t, err)
+}
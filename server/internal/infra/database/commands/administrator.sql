@@ -0,0 +1,70 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: AddPlatformAdmin :exec
+INSERT INTO
+    platform_admin (user_id, created_at)
+VALUES
+    (sqlc.arg('user_id'), sqlc.arg('created_at'))
+ON CONFLICT (user_id) DO NOTHING;
+
+-- name: AddTenantAdmin :exec
+INSERT INTO
+    tenant_admin (tenant_id, user_id, created_at)
+VALUES
+    (
+        sqlc.arg('tenant_id'),
+        sqlc.arg('user_id'),
+        sqlc.arg('created_at')
+    )
+ON CONFLICT (tenant_id, user_id) DO NOTHING;
+
+-- name: RemoveTenantAdmin :exec
+DELETE FROM
+    tenant_admin
+WHERE
+    tenant_id = sqlc.arg('tenant_id')
+    AND user_id = sqlc.arg('user_id');
+
+------------------------------------QUERIES--------------------------------------
+-- name: IsPlatformAdmin :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            platform_admin
+        WHERE
+            user_id = sqlc.arg('user_id')
+    );
+
+-- name: IsTenantAdmin :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            tenant_admin
+        WHERE
+            tenant_id = sqlc.arg('tenant_id')
+            AND user_id = sqlc.arg('user_id')
+    );
+
+-- name: ListAdminTenantIDs :many
+SELECT
+    tenant_id
+FROM
+    tenant_admin
+WHERE
+    user_id = sqlc.arg('user_id');
+
+-- name: ListTenantAdmins :many
+SELECT
+    ta.user_id,
+    tu.email,
+    ta.created_at
+FROM
+    tenant_admin ta
+    INNER JOIN tenant_user tu ON tu.id = ta.user_id
+WHERE
+    ta.tenant_id = sqlc.arg('tenant_id')
+ORDER BY
+    ta.created_at;
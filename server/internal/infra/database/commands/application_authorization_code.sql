@@ -12,7 +12,9 @@ INSERT INTO
         code_challenge,
         code_challenge_method,
         nonce,
-        scope
+        scope,
+        ip_address,
+        user_agent
     )
 VALUES
     (
@@ -25,7 +27,9 @@ VALUES
         sqlc.arg('code_challenge'),
         sqlc.arg('code_challenge_method'),
         sqlc.arg('nonce'),
-        sqlc.arg('scope')
+        sqlc.arg('scope'),
+        sqlc.arg('ip_address'),
+        sqlc.arg('user_agent')
     );
 
 -- name: RemoveAuthorizationCode :exec
@@ -49,7 +53,9 @@ SELECT
     code_challenge,
     code_challenge_method,
     nonce,
-    scope
+    scope,
+    ip_address,
+    user_agent
 FROM
     application_authorization_code
 WHERE
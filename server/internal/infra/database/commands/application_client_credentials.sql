@@ -0,0 +1,74 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: UpsertApplicationClientCredentials :exec
+INSERT INTO
+    application_client_credentials (
+        application_id,
+        scopes,
+        created_at,
+        updated_at
+    )
+VALUES
+    (
+        sqlc.arg('application_id'),
+        sqlc.arg('scopes'),
+        sqlc.arg('created_at'),
+        sqlc.arg('updated_at')
+    ) ON CONFLICT (application_id) DO
+UPDATE
+SET
+    scopes = EXCLUDED.scopes,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: AddApplicationClientRole :exec
+INSERT INTO
+    application_client_role (
+        application_id,
+        role_id,
+        created_at
+    )
+VALUES
+    (
+        sqlc.arg('application_id'),
+        sqlc.arg('role_id'),
+        sqlc.arg('created_at')
+    );
+
+-- name: RemoveApplicationClientRoles :exec
+DELETE FROM
+    application_client_role
+WHERE
+    application_id = sqlc.arg('application_id');
+
+-- name: RemoveApplicationClientCredentials :exec
+DELETE FROM
+    application_client_credentials
+WHERE
+    application_id = sqlc.arg('application_id');
+
+------------------------------------QUERIES--------------------------------------
+-- name: GetApplicationClientCredentials :one
+SELECT
+    application_id,
+    scopes,
+    created_at,
+    updated_at
+FROM
+    application_client_credentials
+WHERE
+    application_id = sqlc.arg('application_id');
+
+-- name: ListApplicationClientRoles :many
+SELECT
+    ar.id,
+    ar.application_id,
+    ar.name,
+    ar.description,
+    ar.created_at,
+    ar.updated_at
+FROM
+    application_client_role acr
+    INNER JOIN application_role ar ON ar.id = acr.role_id
+WHERE
+    acr.application_id = sqlc.arg('application_id')
+ORDER BY
+    ar.name;
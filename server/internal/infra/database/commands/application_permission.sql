@@ -0,0 +1,160 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: AddPermission :exec
+-- Add Permission to Application
+INSERT INTO
+    application_permission (
+        id,
+        application_id,
+        name,
+        description,
+        created_at,
+        updated_at
+    )
+VALUES
+    (
+        sqlc.arg('id'),
+        sqlc.arg('application_id'),
+        sqlc.arg('name'),
+        sqlc.arg('description'),
+        sqlc.arg('created_at'),
+        sqlc.arg('updated_at')
+    );
+
+-- name: UpdatePermission :exec
+UPDATE
+    application_permission
+SET
+    name = sqlc.arg('name'),
+    description = sqlc.arg('description'),
+    updated_at = sqlc.arg('updated_at')
+WHERE
+    id = sqlc.arg('id')
+    AND application_id = sqlc.arg('application_id');
+
+-- name: RemovePermission :exec
+DELETE FROM
+    application_permission
+WHERE
+    id = sqlc.arg('id')
+    AND application_id = sqlc.arg('application_id');
+
+-- name: AddRolePermission :exec
+INSERT INTO
+    role_permission (role_id, permission_id, created_at)
+VALUES
+    (
+        sqlc.arg('role_id'),
+        sqlc.arg('permission_id'),
+        sqlc.arg('created_at')
+    );
+
+-- name: RemoveRolePermissions :exec
+DELETE FROM
+    role_permission
+WHERE
+    role_id = sqlc.arg('role_id');
+
+------------------------------------QUERIES--------------------------------------
+-- name: GetPermissionByID :one
+SELECT
+    id,
+    application_id,
+    name,
+    description,
+    created_at,
+    updated_at
+FROM
+    application_permission
+WHERE
+    id = sqlc.arg('id')
+    AND application_id = sqlc.arg('application_id');
+
+-- name: GetPermissionByName :one
+SELECT
+    id,
+    application_id,
+    name,
+    description,
+    created_at,
+    updated_at
+FROM
+    application_permission
+WHERE
+    application_id = sqlc.arg('application_id')
+    AND name = sqlc.arg('name');
+
+-- name: ListPermissionsFromApplication :many
+SELECT
+    id,
+    application_id,
+    name,
+    description,
+    created_at,
+    updated_at
+FROM
+    application_permission
+WHERE
+    application_id = sqlc.arg('application_id')
+ORDER BY
+    name;
+
+-- name: ListPermissionsFromApplicationPaged :many
+SELECT
+    id,
+    application_id,
+    name,
+    description,
+    created_at,
+    updated_at,
+    COUNT(*) OVER () AS total_count
+FROM
+    application_permission
+WHERE
+    application_id = sqlc.arg('application_id')
+ORDER BY
+    created_at
+LIMIT
+    sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListRolePermissions :many
+SELECT
+    p.id,
+    p.application_id,
+    p.name,
+    p.description,
+    p.created_at,
+    p.updated_at
+FROM
+    role_permission rp
+    INNER JOIN application_permission p ON p.id = rp.permission_id
+WHERE
+    rp.role_id = sqlc.arg('role_id')
+ORDER BY
+    p.name;
+
+-- name: GetUserPermissionsFromApplication :many
+-- Permissions granted to a user through their roles in an application
+SELECT
+    DISTINCT p.name
+FROM
+    user_role ur
+    INNER JOIN role_permission rp ON rp.role_id = ur.role_id
+    INNER JOIN application_permission p ON p.id = rp.permission_id
+WHERE
+    ur.user_id = sqlc.arg('user_id')
+    AND p.application_id = sqlc.arg('application_id')
+ORDER BY
+    p.name;
+
+-- name: GetApplicationClientPermissions :many
+-- Permissions granted to an application acting on its own behalf
+SELECT
+    DISTINCT p.name
+FROM
+    application_client_role acr
+    INNER JOIN role_permission rp ON rp.role_id = acr.role_id
+    INNER JOIN application_permission p ON p.id = rp.permission_id
+WHERE
+    acr.application_id = sqlc.arg('application_id')
+ORDER BY
+    p.name;
@@ -0,0 +1,65 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: AddApplicationRedirectUri :exec
+-- Register a redirect URI (login or post-logout) for an application
+INSERT INTO
+    application_redirect_uri (
+        id,
+        application_id,
+        uri,
+        kind,
+        created_at
+    )
+VALUES
+    (
+        sqlc.arg('id'),
+        sqlc.arg('application_id'),
+        sqlc.arg('uri'),
+        sqlc.arg('kind'),
+        sqlc.arg('created_at')
+    );
+
+-- name: RemoveApplicationRedirectUris :exec
+DELETE FROM
+    application_redirect_uri
+WHERE
+    application_id = sqlc.arg('application_id')
+    AND kind = sqlc.arg('kind');
+
+------------------------------------QUERIES---------------------------------------
+-- name: ListActiveApplicationsWithoutLoginRedirectUri :many
+-- Active applications that can't complete a login until a redirect URI is registered
+SELECT
+    a.id,
+    a.tenant_id,
+    a.name
+FROM
+    application a
+WHERE
+    a.is_active = TRUE
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            application_redirect_uri r
+        WHERE
+            r.application_id = a.id
+            AND r.kind = 'login'
+    )
+ORDER BY
+    a.tenant_id,
+    a.name;
+
+-- name: ListApplicationRedirectUris :many
+SELECT
+    id,
+    application_id,
+    uri,
+    kind,
+    created_at
+FROM
+    application_redirect_uri
+WHERE
+    application_id = sqlc.arg('application_id')
+ORDER BY
+    created_at,
+    uri;
@@ -28,6 +28,20 @@ WHERE
     id = sqlc.arg('id');
 
 ------------------------------------QUERIES---------------------------------------
+-- name: GetRoleByID :one
+SELECT
+    id,
+    application_id,
+    name,
+    description,
+    created_at,
+    updated_at
+FROM
+    application_role
+WHERE
+    id = sqlc.arg('id')
+    AND application_id = sqlc.arg('application_id');
+
 -- List Roles from Application
 -- name: ListRolesFromApplication :many
 SELECT
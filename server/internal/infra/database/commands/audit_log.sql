@@ -3,6 +3,7 @@
 INSERT INTO
   audit_log (
     id,
+    tenant_id,
     user_id,
     application_id,
     event_type,
@@ -10,21 +11,66 @@ INSERT INTO
     user_agent,
     result,
     details,
-    created_at
+    created_at,
+    chain_sequence,
+    previous_hash,
+    hash
   )
 VALUES
   (
     sqlc.arg('id'),
-    sqlc.arg('user_id'),
-    sqlc.arg('application_id'),
+    sqlc.arg('tenant_id'),
+    sqlc.narg('user_id'),
+    sqlc.narg('application_id'),
     sqlc.arg('event_type'),
     sqlc.arg('ip_address'),
     sqlc.arg('user_agent'),
     sqlc.arg('result'),
     sqlc.arg('details'),
-    sqlc.arg('created_at')
+    sqlc.arg('created_at'),
+    sqlc.arg('chain_sequence'),
+    sqlc.arg('previous_hash'),
+    sqlc.arg('hash')
   );
 
+-- name: LockAuditLogChainHead :one
+-- Starts the chain of the tenant when it has none, and locks its head until
+-- the transaction ends
+INSERT INTO
+  audit_log_chain_head (
+    tenant_id,
+    chain_sequence,
+    audit_log_id,
+    hash,
+    updated_at
+  )
+VALUES
+  (
+    sqlc.arg('tenant_id'),
+    0,
+    NULL,
+    sqlc.arg('genesis_hash'),
+    sqlc.arg('updated_at')
+  ) ON CONFLICT (tenant_id) DO
+UPDATE
+SET
+  tenant_id = EXCLUDED.tenant_id RETURNING tenant_id,
+  chain_sequence,
+  audit_log_id,
+  hash,
+  updated_at;
+
+-- name: UpdateAuditLogChainHead :exec
+UPDATE
+  audit_log_chain_head
+SET
+  chain_sequence = sqlc.arg('chain_sequence'),
+  audit_log_id = sqlc.arg('audit_log_id'),
+  hash = sqlc.arg('hash'),
+  updated_at = sqlc.arg('updated_at')
+WHERE
+  tenant_id = sqlc.arg('tenant_id');
+
 ------------------------------------QUERIES--------------------------------------
 -- name: GetAuditLogsByUserID :many
 SELECT
@@ -36,7 +82,11 @@ SELECT
   user_agent,
   result,
   details,
-  created_at
+  created_at,
+  tenant_id,
+  chain_sequence,
+  previous_hash,
+  hash
 FROM
   audit_log
 WHERE
@@ -44,4 +94,122 @@ WHERE
 ORDER BY
   created_at DESC
 LIMIT
-  sqlc.arg('limit_count') OFFSET sqlc.arg('offset_count');
\ No newline at end of file
+  sqlc.arg('limit_count') OFFSET sqlc.arg('offset_count');
+
+-- name: ListTenantAuditLogs :many
+-- Keyset-paged audit log of a tenant, newest first; null filters match every entry
+SELECT
+  al.id,
+  al.user_id,
+  al.application_id,
+  al.event_type,
+  al.ip_address,
+  al.user_agent,
+  al.result,
+  al.details,
+  al.created_at,
+  al.tenant_id,
+  al.chain_sequence,
+  al.previous_hash,
+  al.hash
+FROM
+  audit_log al
+WHERE
+  al.tenant_id = sqlc.arg('tenant_id')
+  AND (
+    sqlc.narg('user_id') :: uuid IS NULL
+    OR al.user_id = sqlc.narg('user_id')
+  )
+  AND (
+    sqlc.narg('application_id') :: uuid IS NULL
+    OR al.application_id = sqlc.narg('application_id')
+  )
+  AND (
+    sqlc.narg('event_type') :: text IS NULL
+    OR al.event_type = sqlc.narg('event_type')
+  )
+  AND (
+    sqlc.narg('result') :: text IS NULL
+    OR al.result = sqlc.narg('result')
+  )
+  AND (
+    sqlc.narg('ip_address') :: text IS NULL
+    OR al.ip_address = sqlc.narg('ip_address')
+  )
+  AND (
+    sqlc.narg('created_from') :: timestamp IS NULL
+    OR al.created_at >= sqlc.narg('created_from')
+  )
+  AND (
+    sqlc.narg('created_to') :: timestamp IS NULL
+    OR al.created_at < sqlc.narg('created_to')
+  )
+  AND (
+    sqlc.narg('before_id') :: uuid IS NULL
+    OR al.id < sqlc.narg('before_id')
+  )
+ORDER BY
+  al.id DESC
+LIMIT
+  sqlc.arg('limit');
+
+-- name: GetAuditLogChainHead :one
+SELECT
+  tenant_id,
+  chain_sequence,
+  audit_log_id,
+  hash,
+  updated_at
+FROM
+  audit_log_chain_head
+WHERE
+  tenant_id = sqlc.arg('tenant_id');
+
+-- name: ListAuditLogChain :many
+-- Chained entries of a tenant in chain order, starting after after_sequence
+SELECT
+  id,
+  user_id,
+  application_id,
+  event_type,
+  ip_address,
+  user_agent,
+  result,
+  details,
+  created_at,
+  tenant_id,
+  chain_sequence,
+  previous_hash,
+  hash
+FROM
+  audit_log
+WHERE
+  tenant_id = sqlc.arg('tenant_id')
+  AND chain_sequence > sqlc.arg('after_sequence')
+ORDER BY
+  chain_sequence ASC
+LIMIT
+  sqlc.arg('limit');
+
+-- name: ListAuditLogChainHeadsToCheckpoint :many
+-- Chain heads that moved since the last checkpoint of their tenant
+SELECT
+  h.tenant_id,
+  h.chain_sequence,
+  h.audit_log_id,
+  h.hash,
+  h.updated_at
+FROM
+  audit_log_chain_head h
+WHERE
+  h.chain_sequence > COALESCE(
+    (
+      SELECT
+        MAX(c.chain_sequence)
+      FROM
+        audit_log_checkpoint c
+      WHERE
+        c.tenant_id = h.tenant_id
+    ),
+    0
+  );
@@ -0,0 +1,42 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: AddAuditLogCheckpoint :exec
+INSERT INTO
+  audit_log_checkpoint (
+    id,
+    tenant_id,
+    chain_sequence,
+    audit_log_id,
+    hash,
+    kid,
+    signature,
+    created_at
+  )
+VALUES
+  (
+    sqlc.arg('id'),
+    sqlc.arg('tenant_id'),
+    sqlc.arg('chain_sequence'),
+    sqlc.arg('audit_log_id'),
+    sqlc.arg('hash'),
+    sqlc.arg('kid'),
+    sqlc.arg('signature'),
+    sqlc.arg('created_at')
+  ) ON CONFLICT (tenant_id, chain_sequence) DO NOTHING;
+
+------------------------------------QUERIES--------------------------------------
+-- name: ListAuditLogCheckpoints :many
+SELECT
+  id,
+  tenant_id,
+  chain_sequence,
+  audit_log_id,
+  hash,
+  kid,
+  signature,
+  created_at
+FROM
+  audit_log_checkpoint
+WHERE
+  tenant_id = sqlc.arg('tenant_id')
+ORDER BY
+  chain_sequence ASC;
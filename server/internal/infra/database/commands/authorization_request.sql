@@ -0,0 +1,62 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: AddAuthorizationRequest :exec
+-- Persist a validated browser authorization request while the user signs in
+INSERT INTO
+    authorization_request (
+        id,
+        application_id,
+        redirect_uri,
+        response_type,
+        scope,
+        state,
+        nonce,
+        code_challenge,
+        code_challenge_method,
+        expires_at,
+        created_at
+    )
+VALUES
+    (
+        sqlc.arg('id'),
+        sqlc.arg('application_id'),
+        sqlc.arg('redirect_uri'),
+        sqlc.arg('response_type'),
+        sqlc.arg('scope'),
+        sqlc.arg('state'),
+        sqlc.arg('nonce'),
+        sqlc.arg('code_challenge'),
+        sqlc.arg('code_challenge_method'),
+        sqlc.arg('expires_at'),
+        sqlc.arg('created_at')
+    );
+
+-- name: RemoveAuthorizationRequest :exec
+DELETE FROM
+    authorization_request
+WHERE
+    id = sqlc.arg('id');
+
+-- name: DeleteExpiredAuthorizationRequests :exec
+DELETE FROM
+    authorization_request
+WHERE
+    expires_at < NOW();
+
+------------------------------------QUERIES---------------------------------------
+-- name: GetAuthorizationRequestByID :one
+SELECT
+    id,
+    application_id,
+    redirect_uri,
+    response_type,
+    scope,
+    state,
+    nonce,
+    code_challenge,
+    code_challenge_method,
+    expires_at,
+    created_at
+FROM
+    authorization_request
+WHERE
+    id = sqlc.arg('id');
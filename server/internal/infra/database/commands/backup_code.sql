@@ -34,6 +34,19 @@ DELETE FROM
 WHERE
   user_id = sqlc.arg('user_id');
 
+-- name: UseBackupCode :one
+UPDATE
+  backup_code
+SET
+  is_used = TRUE,
+  used_at = NOW()
+WHERE
+  user_id = sqlc.arg('user_id')
+  AND code_hash = sqlc.arg('code_hash')
+  AND is_used = FALSE
+RETURNING
+  id;
+
 ------------------------------------QUERIES--------------------------------------
 -- name: GetUnusedBackupCodesByUserID :many
 SELECT
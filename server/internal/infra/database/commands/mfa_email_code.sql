@@ -44,6 +44,23 @@ WHERE
     mfa_method_id = sqlc.arg('mfa_method_id');
 
 ------------------------------------QUERIES--------------------------------------
+-- name: GetLatestMfaEmailCodeByMfaMethodID :one
+SELECT
+    id,
+    mfa_method_id,
+    token,
+    created_at,
+    expires_at,
+    verified
+FROM
+    mfa_email_code
+WHERE
+    mfa_method_id = sqlc.arg('mfa_method_id')
+ORDER BY
+    created_at DESC
+LIMIT
+    1;
+
 -- name: GetMfaEmailCodeByToken :one
 SELECT
     id,
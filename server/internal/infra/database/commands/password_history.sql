@@ -0,0 +1,50 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: AddPasswordHistory :exec
+INSERT INTO
+    password_history (
+        id,
+        user_id,
+        password_hash,
+        created_at
+    )
+VALUES
+    (
+        sqlc.arg('id'),
+        sqlc.arg('user_id'),
+        sqlc.arg('password_hash'),
+        sqlc.arg('created_at')
+    );
+
+-- name: RemoveOldPasswordHistory :exec
+DELETE FROM
+    password_history
+WHERE
+    user_id = sqlc.arg('user_id')
+    AND id NOT IN (
+        SELECT
+            id
+        FROM
+            password_history
+        WHERE
+            user_id = sqlc.arg('user_id')
+        ORDER BY
+            created_at DESC
+        LIMIT
+            sqlc.arg('keep')
+    );
+
+------------------------------------QUERIES--------------------------------------
+-- name: ListPasswordHistoryByUserID :many
+SELECT
+    id,
+    user_id,
+    password_hash,
+    created_at
+FROM
+    password_history
+WHERE
+    user_id = sqlc.arg('user_id')
+ORDER BY
+    created_at DESC
+LIMIT
+    sqlc.arg('limit');
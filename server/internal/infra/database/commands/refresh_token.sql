@@ -5,7 +5,11 @@ INSERT INTO
         id,
         user_id,
         expires_at,
-        created_at
+        created_at,
+        family_id,
+        application_id,
+        session_id,
+        scope
     )
 VALUES
     (
@@ -15,14 +19,25 @@ VALUES
         -- user_id
         sqlc.arg('expires_at'),
         -- expires_at
-        sqlc.arg('created_at') -- created_at
+        sqlc.arg('created_at'),
+        -- created_at
+        sqlc.arg('family_id'),
+        -- family_id
+        sqlc.narg('application_id'),
+        -- application_id
+        sqlc.narg('session_id'),
+        -- session_id
+        sqlc.narg('scope') -- scope
     );
 
 -- name: RevokeRefreshTokenFromUser :exec
-DELETE FROM
+UPDATE
     refresh_token
+SET
+    revoked_at = sqlc.arg('revoked_at')
 WHERE
-    user_id = sqlc.arg('user_id');
+    user_id = sqlc.arg('user_id')
+    AND revoked_at IS NULL;
 
 -- name: RevokeRefreshTokenByID :exec
 DELETE FROM
@@ -30,13 +45,56 @@ DELETE FROM
 WHERE
     id = sqlc.arg('id');
 
+-- name: MarkRefreshTokenUsed :exec
+UPDATE
+    refresh_token
+SET
+    used_at = sqlc.arg('used_at')
+WHERE
+    id = sqlc.arg('id');
+
+-- name: RevokeRefreshTokenFamily :exec
+UPDATE
+    refresh_token
+SET
+    revoked_at = sqlc.arg('revoked_at')
+WHERE
+    family_id = sqlc.arg('family_id')
+    AND revoked_at IS NULL;
+
+-- name: RevokeRefreshTokensFromApplication :exec
+UPDATE
+    refresh_token
+SET
+    revoked_at = sqlc.arg('revoked_at')
+WHERE
+    user_id = sqlc.arg('user_id')
+    AND application_id = sqlc.arg('application_id')
+    AND revoked_at IS NULL;
+
+-- name: RevokeRefreshTokensFromSession :exec
+UPDATE
+    refresh_token
+SET
+    revoked_at = sqlc.arg('revoked_at')
+WHERE
+    user_id = sqlc.arg('user_id')
+    AND session_id = sqlc.arg('session_id')
+    AND revoked_at IS NULL;
+
 ------------------------------------QUERIES--------------------------------------
 -- name: GetRefreshTokensFromUser :many
 SELECT
     id,
     user_id,
     expires_at,
-    created_at
+    created_at,
+    family_id,
+    application_id,
+    session_id,
+    scope,
+    used_at,
+    revoked_at
 FROM
     refresh_token
 WHERE
@@ -47,7 +105,13 @@ SELECT
     rt.id,
     rt.user_id,
     rt.expires_at,
-    rt.created_at
+    rt.created_at,
+    rt.family_id,
+    rt.application_id,
+    rt.session_id,
+    rt.scope,
+    rt.used_at,
+    rt.revoked_at
 FROM
     refresh_token rt
     INNER JOIN tenant_user au ON au.id = rt.user_id
@@ -55,4 +119,38 @@ WHERE
     au.id = sqlc.arg('user_id')
     AND au.tenant_id = sqlc.arg('tenant_id')
 ORDER BY
-    rt.created_at DESC;
\ No newline at end of file
+    rt.created_at DESC;
+
+-- name: GetRefreshTokenByIDForUpdate :one
+SELECT
+    id,
+    user_id,
+    expires_at,
+    created_at,
+    family_id,
+    application_id,
+    session_id,
+    scope,
+    used_at,
+    revoked_at
+FROM
+    refresh_token
+WHERE
+    id = sqlc.arg('id') FOR UPDATE;
+
+-- name: GetRefreshTokenByID :one
+SELECT
+    id,
+    user_id,
+    expires_at,
+    created_at,
+    family_id,
+    application_id,
+    session_id,
+    scope,
+    used_at,
+    revoked_at
+FROM
+    refresh_token
+WHERE
+    id = sqlc.arg('id');
@@ -0,0 +1,33 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: AddRevokedAccessToken :exec
+INSERT INTO
+    revoked_access_token (
+        jti,
+        expires_at,
+        revoked_at
+    )
+VALUES
+    (
+        sqlc.arg('jti'),
+        sqlc.arg('expires_at'),
+        sqlc.arg('revoked_at')
+    ) ON CONFLICT (jti) DO NOTHING;
+
+-- name: DeleteExpiredRevokedAccessTokens :exec
+-- Entries are useless once the token they deny has expired
+DELETE FROM
+    revoked_access_token
+WHERE
+    expires_at < NOW();
+
+------------------------------------QUERIES--------------------------------------
+-- name: CheckIfAccessTokenRevoked :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            revoked_access_token
+        WHERE
+            jti = sqlc.arg('jti')
+    );
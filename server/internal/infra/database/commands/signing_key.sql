@@ -0,0 +1,94 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: AddSigningKey :exec
+INSERT INTO
+  signing_key (
+    id,
+    tenant_id,
+    kid,
+    algorithm,
+    private_key,
+    status,
+    created_at,
+    activated_at,
+    retired_at,
+    expires_at
+  )
+VALUES
+  (
+    sqlc.arg('id'),
+    sqlc.arg('tenant_id'),
+    sqlc.arg('kid'),
+    sqlc.arg('algorithm'),
+    sqlc.arg('private_key'),
+    sqlc.arg('status'),
+    sqlc.arg('created_at'),
+    sqlc.narg('activated_at'),
+    sqlc.narg('retired_at'),
+    sqlc.narg('expires_at')
+  );
+
+-- name: UpdateSigningKeyStatus :exec
+UPDATE
+  signing_key
+SET
+  status = sqlc.arg('status'),
+  activated_at = sqlc.narg('activated_at'),
+  retired_at = sqlc.narg('retired_at'),
+  expires_at = sqlc.narg('expires_at')
+WHERE
+  id = sqlc.arg('id');
+
+-- name: DeleteExpiredSigningKeys :exec
+DELETE FROM
+  signing_key
+WHERE
+  expires_at IS NOT NULL
+  AND expires_at < NOW();
+
+-- name: TryLockSigningKeyRotation :one
+-- Held until the transaction ends, so only one node rotates keys at a time
+SELECT
+  pg_try_advisory_xact_lock(hashtext('signing_key_rotation')) AS locked;
+
+------------------------------------QUERIES--------------------------------------
+-- name: ListPublishedSigningKeys :many
+SELECT
+  id,
+  tenant_id,
+  kid,
+  algorithm,
+  private_key,
+  status,
+  created_at,
+  activated_at,
+  retired_at,
+  expires_at
+FROM
+  signing_key
+WHERE
+  status IN ('next', 'active', 'retired')
+  AND (
+    expires_at IS NULL
+    OR expires_at > NOW()
+  )
+ORDER BY
+  created_at ASC;
+
+-- name: ListSigningKeysByTenant :many
+SELECT
+  id,
+  tenant_id,
+  kid,
+  algorithm,
+  private_key,
+  status,
+  created_at,
+  activated_at,
+  retired_at,
+  expires_at
+FROM
+  signing_key
+WHERE
+  tenant_id = sqlc.arg('tenant_id')
+ORDER BY
+  created_at DESC;
@@ -6,6 +6,23 @@ INSERT INTO
         name,
         description,
         password_hash_secret,
+        password_hash_memory,
+        password_hash_iterations,
+        password_hash_parallelism,
+        password_min_length,
+        password_require_uppercase,
+        password_require_lowercase,
+        password_require_digit,
+        password_require_symbol,
+        password_max_age_days,
+        password_history_count,
+        password_forbidden_words,
+        breached_password_check,
+        breached_password_threshold,
+        lockout_threshold,
+        lockout_duration_seconds,
+        lockout_max_duration_seconds,
+        lockout_notify_user,
         created_at
     )
 VALUES
@@ -18,6 +35,40 @@ VALUES
         -- description
         sqlc.arg('password_hash_secret'),
         -- password_hash_secret
+        sqlc.arg('password_hash_memory'),
+        -- password_hash_memory
+        sqlc.arg('password_hash_iterations'),
+        -- password_hash_iterations
+        sqlc.arg('password_hash_parallelism'),
+        -- password_hash_parallelism
+        sqlc.arg('password_min_length'),
+        -- password_min_length
+        sqlc.arg('password_require_uppercase'),
+        -- password_require_uppercase
+        sqlc.arg('password_require_lowercase'),
+        -- password_require_lowercase
+        sqlc.arg('password_require_digit'),
+        -- password_require_digit
+        sqlc.arg('password_require_symbol'),
+        -- password_require_symbol
+        sqlc.arg('password_max_age_days'),
+        -- password_max_age_days
+        sqlc.arg('password_history_count'),
+        -- password_history_count
+        sqlc.arg('password_forbidden_words'),
+        -- password_forbidden_words
+        sqlc.arg('breached_password_check'),
+        -- breached_password_check
+        sqlc.arg('breached_password_threshold'),
+        -- breached_password_threshold
+        sqlc.arg('lockout_threshold'),
+        -- lockout_threshold
+        sqlc.arg('lockout_duration_seconds'),
+        -- lockout_duration_seconds
+        sqlc.arg('lockout_max_duration_seconds'),
+        -- lockout_max_duration_seconds
+        sqlc.arg('lockout_notify_user'),
+        -- lockout_notify_user
         sqlc.arg('created_at') -- created_at
     );
 
@@ -34,6 +85,24 @@ SET
     name = sqlc.arg('name'),
     description = sqlc.arg('description'),
     password_hash_secret = sqlc.arg('password_hash_secret'),
+    previous_password_hash_secret = sqlc.arg('previous_password_hash_secret'),
+    password_hash_memory = sqlc.arg('password_hash_memory'),
+    password_hash_iterations = sqlc.arg('password_hash_iterations'),
+    password_hash_parallelism = sqlc.arg('password_hash_parallelism'),
+    password_min_length = sqlc.arg('password_min_length'),
+    password_require_uppercase = sqlc.arg('password_require_uppercase'),
+    password_require_lowercase = sqlc.arg('password_require_lowercase'),
+    password_require_digit = sqlc.arg('password_require_digit'),
+    password_require_symbol = sqlc.arg('password_require_symbol'),
+    password_max_age_days = sqlc.arg('password_max_age_days'),
+    password_history_count = sqlc.arg('password_history_count'),
+    password_forbidden_words = sqlc.arg('password_forbidden_words'),
+    breached_password_check = sqlc.arg('breached_password_check'),
+    breached_password_threshold = sqlc.arg('breached_password_threshold'),
+    lockout_threshold = sqlc.arg('lockout_threshold'),
+    lockout_duration_seconds = sqlc.arg('lockout_duration_seconds'),
+    lockout_max_duration_seconds = sqlc.arg('lockout_max_duration_seconds'),
+    lockout_notify_user = sqlc.arg('lockout_notify_user'),
     updated_at = sqlc.arg('updated_at')
 WHERE
     id = sqlc.arg('id');
@@ -45,6 +114,24 @@ SELECT
     name,
     description,
     password_hash_secret,
+    previous_password_hash_secret,
+    password_hash_memory,
+    password_hash_iterations,
+    password_hash_parallelism,
+    password_min_length,
+    password_require_uppercase,
+    password_require_lowercase,
+    password_require_digit,
+    password_require_symbol,
+    password_max_age_days,
+    password_history_count,
+    password_forbidden_words,
+    breached_password_check,
+    breached_password_threshold,
+    lockout_threshold,
+    lockout_duration_seconds,
+    lockout_max_duration_seconds,
+    lockout_notify_user,
     created_at,
     updated_at
 FROM
@@ -58,6 +145,24 @@ SELECT
     name,
     description,
     password_hash_secret,
+    previous_password_hash_secret,
+    password_hash_memory,
+    password_hash_iterations,
+    password_hash_parallelism,
+    password_min_length,
+    password_require_uppercase,
+    password_require_lowercase,
+    password_require_digit,
+    password_require_symbol,
+    password_max_age_days,
+    password_history_count,
+    password_forbidden_words,
+    breached_password_check,
+    breached_password_threshold,
+    lockout_threshold,
+    lockout_duration_seconds,
+    lockout_max_duration_seconds,
+    lockout_notify_user,
     created_at,
     updated_at
 FROM
@@ -142,4 +142,58 @@ WHERE
 ORDER BY
     au.created_at
 LIMIT
-    sqlc.arg('limit') OFFSET sqlc.arg('offset');
\ No newline at end of file
+    sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListTenantUsersForExport :many
+-- Keyset-paged users of a tenant with their profile, roles and external identities
+SELECT
+    au.id,
+    au.email,
+    au.is_active,
+    au.is_email_confirmed,
+    up.display_name,
+    up.first_name,
+    up.last_name,
+    up.phone_number,
+    up.address,
+    up.photo_url,
+    COALESCE(r.roles, '{}' :: text []) :: text [] AS roles,
+    COALESCE(ei.external_identities, '{}' :: text []) :: text [] AS external_identities
+FROM
+    "tenant_user" au
+    LEFT JOIN "user_profile" up ON up.user_id = au.id
+    LEFT JOIN LATERAL (
+        SELECT
+            array_agg(
+                ar.application_id :: text || ':' || ar.name
+                ORDER BY
+                    ar.application_id,
+                    ar.name
+            ) AS roles
+        FROM
+            "user_role" ur
+            JOIN "application_role" ar ON ar.id = ur.role_id
+        WHERE
+            ur.user_id = au.id
+    ) r ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT
+            array_agg(
+                aop.application_id :: text || ':' || aop.name || ':' || e.provider_user_id
+                ORDER BY
+                    aop.application_id,
+                    aop.name
+            ) AS external_identities
+        FROM
+            "external_identity" e
+            JOIN "application_oauth_provider" aop ON aop.id = e.application_oauth_provider_id
+        WHERE
+            e.user_id = au.id
+    ) ei ON TRUE
+WHERE
+    au.tenant_id = sqlc.arg('tenant_id')
+    AND au.id > sqlc.arg('after_id')
+ORDER BY
+    au.id
+LIMIT
+    sqlc.arg('limit');
@@ -7,6 +7,7 @@ INSERT INTO
         password_hash,
         password_algorithm,
         should_change_pass,
+        password_changed_at,
         created_at,
         updated_at
     )
@@ -17,6 +18,7 @@ VALUES
         sqlc.arg('password_hash'),
         sqlc.arg('password_algorithm'),
         sqlc.arg('should_change_pass'),
+        sqlc.arg('password_changed_at'),
         sqlc.arg('created_at'),
         sqlc.arg('updated_at')
     );
@@ -34,6 +36,7 @@ SET
     password_hash = sqlc.arg('password_hash'),
     password_algorithm = sqlc.arg('password_algorithm'),
     should_change_pass = sqlc.arg('should_change_pass'),
+    password_changed_at = sqlc.arg('password_changed_at'),
     updated_at = sqlc.arg('updated_at')
 WHERE
     user_id = sqlc.arg('user_id');
@@ -46,6 +49,7 @@ SELECT
     password_hash,
     password_algorithm,
     should_change_pass,
+    password_changed_at,
     created_at,
     updated_at
 FROM
@@ -0,0 +1,109 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: AddUserImportJob :one
+INSERT INTO
+    user_import_job (
+        id,
+        tenant_id,
+        idempotency_key,
+        format,
+        dry_run,
+        status,
+        total_rows,
+        created_at
+    )
+VALUES
+    (
+        sqlc.arg('id'),
+        sqlc.arg('tenant_id'),
+        sqlc.arg('idempotency_key'),
+        sqlc.arg('format'),
+        sqlc.arg('dry_run'),
+        sqlc.arg('status'),
+        sqlc.arg('total_rows'),
+        sqlc.arg('created_at')
+    ) ON CONFLICT (tenant_id, idempotency_key) DO NOTHING
+RETURNING
+    id;
+
+-- name: UpdateUserImportJob :exec
+UPDATE
+    user_import_job
+SET
+    status = sqlc.arg('status'),
+    processed_rows = sqlc.arg('processed_rows'),
+    imported_rows = sqlc.arg('imported_rows'),
+    skipped_rows = sqlc.arg('skipped_rows'),
+    failed_rows = sqlc.arg('failed_rows'),
+    errors = sqlc.arg('errors'),
+    completed_at = sqlc.arg('completed_at'),
+    heartbeat_at = sqlc.arg('heartbeat_at')
+WHERE
+    id = sqlc.arg('id');
+
+-- name: TouchUserImportJob :exec
+-- Tells other instances the job is still being processed
+UPDATE
+    user_import_job
+SET
+    heartbeat_at = sqlc.arg('heartbeat_at')
+WHERE
+    id = sqlc.arg('id');
+
+-- name: FailInterruptedUserImportJobs :exec
+-- Jobs run in-process, so a job still pending or running whose instance
+-- stopped refreshing its heartbeat was interrupted
+UPDATE
+    user_import_job
+SET
+    status = 'failed',
+    completed_at = sqlc.arg('completed_at')
+WHERE
+    status IN ('pending', 'running')
+    AND COALESCE(heartbeat_at, created_at) < sqlc.arg('stale_before');
+
+------------------------------------QUERIES--------------------------------------
+-- name: GetUserImportJobByID :one
+SELECT
+    id,
+    tenant_id,
+    idempotency_key,
+    format,
+    dry_run,
+    status,
+    total_rows,
+    processed_rows,
+    imported_rows,
+    skipped_rows,
+    failed_rows,
+    errors,
+    created_at,
+    completed_at,
+    heartbeat_at
+FROM
+    user_import_job
+WHERE
+    id = sqlc.arg('id')
+    AND tenant_id = sqlc.arg('tenant_id');
+
+-- name: GetUserImportJobByIdempotencyKey :one
+SELECT
+    id,
+    tenant_id,
+    idempotency_key,
+    format,
+    dry_run,
+    status,
+    total_rows,
+    processed_rows,
+    imported_rows,
+    skipped_rows,
+    failed_rows,
+    errors,
+    created_at,
+    completed_at,
+    heartbeat_at
+FROM
+    user_import_job
+WHERE
+    tenant_id = sqlc.arg('tenant_id')
+    AND idempotency_key = sqlc.arg('idempotency_key');
@@ -0,0 +1,53 @@
+------------------------------------COMMANDS--------------------------------------
+-- name: RemoveUserLockout :exec
+DELETE FROM
+    user_lockout
+WHERE
+    user_id = sqlc.arg('user_id');
+
+-- name: UpsertUserLockout :exec
+INSERT INTO
+    user_lockout (
+        user_id,
+        application_id,
+        failed_attempts,
+        lockout_count,
+        last_failed_at,
+        locked_until,
+        updated_at
+    )
+VALUES
+    (
+        sqlc.arg('user_id'),
+        sqlc.arg('application_id'),
+        sqlc.arg('failed_attempts'),
+        sqlc.arg('lockout_count'),
+        sqlc.arg('last_failed_at'),
+        sqlc.arg('locked_until'),
+        sqlc.arg('updated_at')
+    ) ON CONFLICT (user_id) DO
+UPDATE
+SET
+    application_id = EXCLUDED.application_id,
+    failed_attempts = EXCLUDED.failed_attempts,
+    lockout_count = EXCLUDED.lockout_count,
+    last_failed_at = EXCLUDED.last_failed_at,
+    locked_until = EXCLUDED.locked_until,
+    updated_at = EXCLUDED.updated_at;
+
+------------------------------------QUERIES--------------------------------------
+-- name: GetUserLockoutByUserID :one
+-- Locks the row so concurrent failed logins don't lose increments
+SELECT
+    user_id,
+    application_id,
+    failed_attempts,
+    lockout_count,
+    last_failed_at,
+    locked_until,
+    updated_at
+FROM
+    user_lockout
+WHERE
+    user_id = sqlc.arg('user_id') FOR
+UPDATE;
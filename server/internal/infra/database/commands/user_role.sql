@@ -27,4 +27,17 @@ FROM
     user_role AS ur
     INNER JOIN application_role AS r ON ur.role_id = r.id
 WHERE
-    user_id = sqlc.arg('user_id');
\ No newline at end of file
+    user_id = sqlc.arg('user_id');
+
+-- name: GetUserRolesFromApplication :many
+SELECT
+    r.id AS id,
+    r.name AS name
+FROM
+    user_role AS ur
+    INNER JOIN application_role AS r ON ur.role_id = r.id
+WHERE
+    ur.user_id = sqlc.arg('user_id')
+    AND r.application_id = sqlc.arg('application_id')
+ORDER BY
+    r.name;
\ No newline at end of file
@@ -45,6 +45,16 @@ WHERE
   user_id = sqlc.arg('user_id')
   AND is_revoked = FALSE;
 
+-- name: RevokeUserSessionsFromApplication :exec
+UPDATE
+  user_session
+SET
+  is_revoked = TRUE
+WHERE
+  user_id = sqlc.arg('user_id')
+  AND application_id = sqlc.arg('application_id')
+  AND is_revoked = FALSE;
+
 -- name: UpdateUserSessionLastActive :exec
 UPDATE
   user_session
@@ -54,6 +64,18 @@ WHERE
   id = sqlc.arg('id');
 
 ------------------------------------QUERIES--------------------------------------
+-- name: CheckIfUserSessionActive :one
+SELECT
+  EXISTS (
+    SELECT
+      1
+    FROM
+      user_session
+    WHERE
+      id = sqlc.arg('id')
+      AND is_revoked = FALSE
+  );
+
 -- name: GetActiveUserSessions :many
 SELECT
   id,
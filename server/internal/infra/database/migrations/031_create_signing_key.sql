@@ -0,0 +1,32 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS signing_key (
+  id UUID PRIMARY KEY,
+  tenant_id UUID NOT NULL,
+  kid VARCHAR(128) NOT NULL,
+  algorithm VARCHAR(16) NOT NULL,
+  private_key TEXT NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  activated_at TIMESTAMP NULL,
+  retired_at TIMESTAMP NULL,
+  expires_at TIMESTAMP NULL,
+  /* signing_key >- tenant = fk_signing_key_tenant */
+  CONSTRAINT fk_signing_key_tenant FOREIGN KEY (tenant_id) REFERENCES "tenant" (id) ON DELETE CASCADE,
+  CONSTRAINT uq_signing_key_kid UNIQUE (kid)
+);
+
+CREATE INDEX idx_signing_key_tenant_id ON signing_key (tenant_id);
+
+CREATE INDEX idx_signing_key_status ON signing_key (status);
+
+-- At most one active and one pending key per tenant
+CREATE UNIQUE INDEX uq_signing_key_tenant_active ON signing_key (tenant_id)
+WHERE
+  status = 'active';
+
+CREATE UNIQUE INDEX uq_signing_key_tenant_next ON signing_key (tenant_id)
+WHERE
+  status = 'next';
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS signing_key;
@@ -0,0 +1,37 @@
+-- Write your migrate up statements here
+ALTER TABLE refresh_token
+    ADD COLUMN family_id UUID NULL,
+    ADD COLUMN application_id UUID NULL,
+    ADD COLUMN session_id UUID NULL,
+    ADD COLUMN scope TEXT NULL,
+    ADD COLUMN used_at TIMESTAMP NULL,
+    ADD COLUMN revoked_at TIMESTAMP NULL;
+
+-- Tokens issued before rotation each start their own family
+UPDATE refresh_token SET family_id = id WHERE family_id IS NULL;
+
+ALTER TABLE refresh_token ALTER COLUMN family_id SET NOT NULL;
+
+ALTER TABLE refresh_token
+    /* refresh_token >- application = fk_application_refresh_token */
+    ADD CONSTRAINT fk_application_refresh_token FOREIGN KEY (application_id) REFERENCES "application" (id) ON DELETE CASCADE,
+    /* refresh_token >- user_session = fk_user_session_refresh_token */
+    ADD CONSTRAINT fk_user_session_refresh_token FOREIGN KEY (session_id) REFERENCES "user_session" (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_refresh_token_family_id ON refresh_token (family_id);
+
+---- create above / drop below ----
+DROP INDEX IF EXISTS idx_refresh_token_family_id;
+
+ALTER TABLE refresh_token
+    DROP CONSTRAINT IF EXISTS fk_user_session_refresh_token,
+    DROP CONSTRAINT IF EXISTS fk_application_refresh_token,
+    DROP COLUMN IF EXISTS revoked_at,
+    DROP COLUMN IF EXISTS used_at,
+    DROP COLUMN IF EXISTS scope,
+    DROP COLUMN IF EXISTS session_id,
+    DROP COLUMN IF EXISTS application_id,
+    DROP COLUMN IF EXISTS family_id;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,28 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS application_client_credentials (
+    application_id UUID PRIMARY KEY,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NULL,
+    /* application_client_credentials - application = fk_application_client_credentials_application */
+    CONSTRAINT fk_application_client_credentials_application FOREIGN KEY (application_id) REFERENCES "application" (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS application_client_role (
+    application_id UUID NOT NULL,
+    role_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (application_id, role_id),
+    /* application_client_role >- application_client_credentials = fk_application_client_role_client_credentials */
+    CONSTRAINT fk_application_client_role_client_credentials FOREIGN KEY (application_id) REFERENCES "application_client_credentials" (application_id) ON DELETE CASCADE,
+    /* application_client_role >- application_role = fk_application_client_role_application_role */
+    CONSTRAINT fk_application_client_role_application_role FOREIGN KEY (role_id) REFERENCES "application_role" (id) ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS application_client_role;
+
+DROP TABLE IF EXISTS application_client_credentials;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
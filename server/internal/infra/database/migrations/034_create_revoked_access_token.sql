@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS revoked_access_token (
+    jti VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_revoked_access_token_expires_at ON revoked_access_token (expires_at);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS revoked_access_token;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
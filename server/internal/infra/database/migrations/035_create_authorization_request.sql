@@ -0,0 +1,22 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS authorization_request (
+    id UUID PRIMARY KEY,
+    application_id UUID NOT NULL,
+    redirect_uri VARCHAR(512) NOT NULL,
+    response_type VARCHAR(50) NOT NULL,
+    scope VARCHAR(512) NOT NULL,
+    state VARCHAR(512) NULL,
+    nonce VARCHAR(255) NULL,
+    code_challenge VARCHAR(256) NOT NULL,
+    code_challenge_method VARCHAR(50) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    /* authorization_request >- application = fk_authorization_request_application */
+    CONSTRAINT fk_authorization_request_application FOREIGN KEY (application_id) REFERENCES "application" (id) ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS authorization_request;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,17 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS application_redirect_uri (
+    id UUID PRIMARY KEY,
+    application_id UUID NOT NULL,
+    uri VARCHAR(512) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    /* application_redirect_uri >- application = fk_application_redirect_uri_application */
+    CONSTRAINT fk_application_redirect_uri_application FOREIGN KEY (application_id) REFERENCES "application" (id) ON DELETE CASCADE,
+    CONSTRAINT uq_application_redirect_uri UNIQUE (application_id, kind, uri)
+);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS application_redirect_uri;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,33 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS application_permission (
+    id UUID PRIMARY KEY,
+    application_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NULL,
+    UNIQUE (application_id, name),
+    /* application_permission >- application = fk_application_permission_application */
+    CONSTRAINT fk_application_permission_application FOREIGN KEY (application_id) REFERENCES "application" (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS role_permission (
+    role_id UUID NOT NULL,
+    permission_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (role_id, permission_id),
+    /* role_permission >- application_role = fk_role_permission_application_role */
+    CONSTRAINT fk_role_permission_application_role FOREIGN KEY (role_id) REFERENCES "application_role" (id) ON DELETE CASCADE,
+    /* role_permission >- application_permission = fk_role_permission_application_permission */
+    CONSTRAINT fk_role_permission_application_permission FOREIGN KEY (permission_id) REFERENCES "application_permission" (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_role_permission_permission_id ON role_permission (permission_id);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS role_permission;
+
+DROP TABLE IF EXISTS application_permission;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
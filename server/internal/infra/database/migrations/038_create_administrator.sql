@@ -0,0 +1,27 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS platform_admin (
+    user_id UUID PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    /* platform_admin - tenant_user = fk_platform_admin_user */
+    CONSTRAINT fk_platform_admin_user FOREIGN KEY (user_id) REFERENCES "tenant_user" (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS tenant_admin (
+    tenant_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, user_id),
+    /* tenant_admin >- tenant = fk_tenant_admin_tenant */
+    CONSTRAINT fk_tenant_admin_tenant FOREIGN KEY (tenant_id) REFERENCES "tenant" (id) ON DELETE CASCADE,
+    /* tenant_admin >- tenant_user = fk_tenant_admin_user */
+    CONSTRAINT fk_tenant_admin_user FOREIGN KEY (user_id) REFERENCES "tenant_user" (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_admin_user_id ON tenant_admin (user_id);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS tenant_admin;
+DROP TABLE IF EXISTS platform_admin;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
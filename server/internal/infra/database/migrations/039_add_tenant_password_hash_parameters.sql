@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+ALTER TABLE
+  "tenant"
+ADD
+  COLUMN password_hash_memory INTEGER NOT NULL DEFAULT 65536,
+ADD
+  COLUMN password_hash_iterations INTEGER NOT NULL DEFAULT 3,
+ADD
+  COLUMN password_hash_parallelism INTEGER NOT NULL DEFAULT 4;
+
+---- create above / drop below ----
+ALTER TABLE
+  "tenant" DROP COLUMN password_hash_parallelism,
+  DROP COLUMN password_hash_iterations,
+  DROP COLUMN password_hash_memory;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
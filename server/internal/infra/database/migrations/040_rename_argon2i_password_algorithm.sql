@@ -0,0 +1,15 @@
+-- Native hashes have always been Argon2id; record them under the right name
+UPDATE
+  "user_credentials"
+SET
+  password_algorithm = 'argon2id'
+WHERE
+  password_algorithm = 'argon2i';
+
+---- create above / drop below ----
+UPDATE
+  "user_credentials"
+SET
+  password_algorithm = 'argon2i'
+WHERE
+  password_algorithm = 'argon2id';
@@ -0,0 +1,26 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS user_import_job (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    format VARCHAR(16) NOT NULL,
+    dry_run BOOLEAN NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    total_rows INTEGER NOT NULL,
+    processed_rows INTEGER NOT NULL DEFAULT 0,
+    imported_rows INTEGER NOT NULL DEFAULT 0,
+    skipped_rows INTEGER NOT NULL DEFAULT 0,
+    failed_rows INTEGER NOT NULL DEFAULT 0,
+    errors JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP NULL,
+    /* user_import_job >- tenant = fk_user_import_job_tenant */
+    CONSTRAINT fk_user_import_job_tenant FOREIGN KEY (tenant_id) REFERENCES "tenant" (id) ON DELETE CASCADE,
+    CONSTRAINT uq_user_import_job_idempotency_key UNIQUE (tenant_id, idempotency_key)
+);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS user_import_job;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
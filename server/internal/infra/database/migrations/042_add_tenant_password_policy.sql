@@ -0,0 +1,66 @@
+-- Write your migrate up statements here
+ALTER TABLE
+  "tenant"
+ADD
+  COLUMN password_min_length INTEGER NOT NULL DEFAULT 8,
+ADD
+  COLUMN password_require_uppercase BOOLEAN NOT NULL DEFAULT FALSE,
+ADD
+  COLUMN password_require_lowercase BOOLEAN NOT NULL DEFAULT FALSE,
+ADD
+  COLUMN password_require_digit BOOLEAN NOT NULL DEFAULT FALSE,
+ADD
+  COLUMN password_require_symbol BOOLEAN NOT NULL DEFAULT FALSE,
+ADD
+  COLUMN password_max_age_days INTEGER NOT NULL DEFAULT 0,
+ADD
+  COLUMN password_history_count INTEGER NOT NULL DEFAULT 0,
+ADD
+  COLUMN password_forbidden_words TEXT [] NOT NULL DEFAULT '{}';
+
+ALTER TABLE
+  user_credentials
+ADD
+  COLUMN password_changed_at TIMESTAMP NULL;
+
+UPDATE
+  user_credentials
+SET
+  password_changed_at = COALESCE(updated_at, created_at);
+
+ALTER TABLE
+  user_credentials
+ALTER COLUMN
+  password_changed_at
+SET
+  NOT NULL;
+
+CREATE TABLE IF NOT EXISTS password_history (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL,
+  password_hash TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  /* password_history >- tenant_user = fk_password_history_user */
+  CONSTRAINT fk_password_history_user FOREIGN KEY (user_id) REFERENCES "tenant_user" (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_password_history_user_id ON password_history (user_id, created_at DESC);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS password_history;
+
+ALTER TABLE
+  user_credentials DROP COLUMN password_changed_at;
+
+ALTER TABLE
+  "tenant" DROP COLUMN password_forbidden_words,
+  DROP COLUMN password_history_count,
+  DROP COLUMN password_max_age_days,
+  DROP COLUMN password_require_symbol,
+  DROP COLUMN password_require_digit,
+  DROP COLUMN password_require_lowercase,
+  DROP COLUMN password_require_uppercase,
+  DROP COLUMN password_min_length;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,15 @@
+-- Write your migrate up statements here
+ALTER TABLE
+  "tenant"
+ADD
+  COLUMN breached_password_check BOOLEAN NOT NULL DEFAULT FALSE,
+ADD
+  COLUMN breached_password_threshold INTEGER NOT NULL DEFAULT 1;
+
+---- create above / drop below ----
+ALTER TABLE
+  "tenant" DROP COLUMN breached_password_threshold,
+  DROP COLUMN breached_password_check;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,37 @@
+-- Write your migrate up statements here
+ALTER TABLE
+  "tenant"
+ADD
+  COLUMN lockout_threshold INTEGER NOT NULL DEFAULT 10,
+ADD
+  COLUMN lockout_duration_seconds INTEGER NOT NULL DEFAULT 300,
+ADD
+  COLUMN lockout_max_duration_seconds INTEGER NOT NULL DEFAULT 86400,
+ADD
+  COLUMN lockout_notify_user BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS user_lockout (
+  user_id UUID PRIMARY KEY,
+  application_id UUID NOT NULL,
+  failed_attempts INTEGER NOT NULL DEFAULT 0,
+  lockout_count INTEGER NOT NULL DEFAULT 0,
+  last_failed_at TIMESTAMP NULL,
+  locked_until TIMESTAMP NULL,
+  updated_at TIMESTAMP NOT NULL,
+  /* user_lockout - tenant_user = fk_user_lockout_user */
+  CONSTRAINT fk_user_lockout_user FOREIGN KEY (user_id) REFERENCES "tenant_user" (id) ON DELETE CASCADE,
+  /* user_lockout >- application = fk_user_lockout_application */
+  CONSTRAINT fk_user_lockout_application FOREIGN KEY (application_id) REFERENCES "application" (id) ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS user_lockout;
+
+ALTER TABLE
+  "tenant" DROP COLUMN lockout_notify_user,
+  DROP COLUMN lockout_max_duration_seconds,
+  DROP COLUMN lockout_duration_seconds,
+  DROP COLUMN lockout_threshold;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
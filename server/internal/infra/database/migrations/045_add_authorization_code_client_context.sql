@@ -0,0 +1,15 @@
+-- Write your migrate up statements here
+ALTER TABLE
+  application_authorization_code
+ADD
+  COLUMN ip_address TEXT NULL,
+ADD
+  COLUMN user_agent TEXT NULL;
+
+---- create above / drop below ----
+ALTER TABLE
+  application_authorization_code DROP COLUMN user_agent,
+  DROP COLUMN ip_address;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
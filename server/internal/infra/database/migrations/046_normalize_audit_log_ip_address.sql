@@ -0,0 +1,19 @@
+-- Write your migrate up statements here
+-- Audit entries used to store the remote address with its port, which made
+-- searching by IP impossible. Keep the host only.
+UPDATE
+  audit_log
+SET
+  ip_address = CASE
+    WHEN ip_address ~ '^\[.*\]:[0-9]+$' THEN substring(ip_address FROM '^\[(.*)\]:[0-9]+$')
+    WHEN ip_address ~ '^[0-9.]+:[0-9]+$' THEN split_part(ip_address, ':', 1)
+    ELSE ip_address
+  END;
+
+CREATE INDEX idx_audit_log_ip_address ON audit_log (ip_address);
+
+---- create above / drop below ----
+DROP INDEX IF EXISTS idx_audit_log_ip_address;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,72 @@
+-- Write your migrate up statements here
+-- Audit entries must outlive the users and applications they mention, and
+-- some events have neither: failed logins for unknown e-mails and tenant
+-- admin operations. Entries now belong to a tenant instead.
+ALTER TABLE
+  audit_log
+ADD
+  COLUMN tenant_id UUID NULL;
+
+UPDATE
+  audit_log al
+SET
+  tenant_id = a.tenant_id
+FROM
+  "application" a
+WHERE
+  a.id = al.application_id;
+
+ALTER TABLE
+  audit_log
+ALTER COLUMN
+  tenant_id
+SET
+  NOT NULL,
+  DROP CONSTRAINT fk_audit_log_user,
+  DROP CONSTRAINT fk_audit_log_application,
+ALTER COLUMN
+  user_id DROP NOT NULL,
+ALTER COLUMN
+  application_id DROP NOT NULL;
+
+CREATE INDEX idx_audit_log_tenant_id ON audit_log (tenant_id, id);
+
+---- create above / drop below ----
+DROP INDEX IF EXISTS idx_audit_log_tenant_id;
+
+DELETE FROM
+  audit_log
+WHERE
+  user_id IS NULL
+  OR user_id NOT IN (
+    SELECT
+      id
+    FROM
+      "tenant_user"
+  )
+  OR application_id IS NULL
+  OR application_id NOT IN (
+    SELECT
+      id
+    FROM
+      "application"
+  );
+
+ALTER TABLE
+  audit_log
+ALTER COLUMN
+  user_id
+SET
+  NOT NULL,
+ALTER COLUMN
+  application_id
+SET
+  NOT NULL,
+ADD
+  CONSTRAINT fk_audit_log_user FOREIGN KEY (user_id) REFERENCES "tenant_user" (id) ON DELETE CASCADE,
+ADD
+  CONSTRAINT fk_audit_log_application FOREIGN KEY (application_id) REFERENCES "application" (id) ON DELETE CASCADE,
+  DROP COLUMN tenant_id;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,63 @@
+-- Write your migrate up statements here
+-- Every entry of a tenant is chained to the previous one by hash, so edited,
+-- removed or reordered entries can be detected. Entries written before the
+-- chain existed keep chain_sequence 0 and are not part of it.
+ALTER TABLE
+  audit_log
+ADD
+  COLUMN chain_sequence BIGINT NOT NULL DEFAULT 0,
+ADD
+  COLUMN previous_hash VARCHAR(64) NOT NULL DEFAULT '',
+ADD
+  COLUMN hash VARCHAR(64) NOT NULL DEFAULT '';
+
+ALTER TABLE
+  audit_log
+ALTER COLUMN
+  chain_sequence DROP DEFAULT,
+ALTER COLUMN
+  previous_hash DROP DEFAULT,
+ALTER COLUMN
+  hash DROP DEFAULT;
+
+CREATE UNIQUE INDEX uq_audit_log_tenant_chain_sequence ON audit_log (tenant_id, chain_sequence)
+WHERE
+  chain_sequence > 0;
+
+-- Last link of each tenant's chain. Writers lock the row, so entries of a
+-- tenant are chained one at a time. Like audit_log it outlives the tenant.
+CREATE TABLE IF NOT EXISTS audit_log_chain_head (
+  tenant_id UUID PRIMARY KEY,
+  chain_sequence BIGINT NOT NULL,
+  audit_log_id UUID NULL,
+  hash VARCHAR(64) NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+);
+
+-- Chain heads signed with the tenant's signing key, for external archival
+CREATE TABLE IF NOT EXISTS audit_log_checkpoint (
+  id UUID PRIMARY KEY,
+  tenant_id UUID NOT NULL,
+  chain_sequence BIGINT NOT NULL,
+  audit_log_id UUID NOT NULL,
+  hash VARCHAR(64) NOT NULL,
+  kid VARCHAR(128) NOT NULL,
+  signature TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  CONSTRAINT uq_audit_log_checkpoint_tenant_chain_sequence UNIQUE (tenant_id, chain_sequence)
+);
+
+---- create above / drop below ----
+DROP TABLE IF EXISTS audit_log_checkpoint;
+
+DROP TABLE IF EXISTS audit_log_chain_head;
+
+DROP INDEX IF EXISTS uq_audit_log_tenant_chain_sequence;
+
+ALTER TABLE
+  audit_log DROP COLUMN hash,
+  DROP COLUMN previous_hash,
+  DROP COLUMN chain_sequence;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
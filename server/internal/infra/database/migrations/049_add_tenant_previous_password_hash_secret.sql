@@ -0,0 +1,23 @@
+-- Write your migrate up statements here
+-- The pepper a tenant replaced keeps verifying passwords until they are
+-- rehashed with the new one on login.
+ALTER TABLE
+  "tenant"
+ADD
+  COLUMN previous_password_hash_secret VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Tenants can no longer hash with more than 256 MiB. Existing hashes keep
+-- verifying with the memory they were produced with.
+UPDATE
+  "tenant"
+SET
+  password_hash_memory = 262144
+WHERE
+  password_hash_memory > 262144;
+
+---- create above / drop below ----
+ALTER TABLE
+  "tenant" DROP COLUMN previous_password_hash_secret;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
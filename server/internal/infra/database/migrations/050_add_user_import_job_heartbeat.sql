@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+-- Refreshed while a server instance processes the job, so an instance that
+-- starts up only fails the jobs nobody is still working on.
+ALTER TABLE
+    user_import_job
+ADD
+    COLUMN heartbeat_at TIMESTAMP NULL;
+
+---- create above / drop below ----
+ALTER TABLE
+    user_import_job DROP COLUMN heartbeat_at;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SeedPlatformAdmins grants platform administration to the comma-separated
+// user IDs in PLATFORM_ADMIN_USER_IDS. It is how the first administrator of a
+// fresh installation is created; IDs that are already administrators are kept
+// as they are.
+func SeedPlatformAdmins(ctx context.Context, pool *pgxpool.Pool) error {
+	value := os.Getenv("PLATFORM_ADMIN_USER_IDS")
+
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	admins := repositories.AdminRepository{Store: pgstore.New(pool)}
+
+	for _, rawID := range strings.Split(value, ",") {
+		userID, err := uuid.Parse(strings.TrimSpace(rawID))
+
+		if err != nil {
+			return fmt.Errorf("invalid PLATFORM_ADMIN_USER_IDS entry %q: %w", rawID, err)
+		}
+
+		if err := admins.AddPlatformAdmin(ctx, entities.NewPlatformAdmin(userID)); err != nil {
+			return fmt.Errorf("granting platform administration to %s: %w", userID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// WarnApplicationsWithoutRedirectURIs logs every active application that has
+// no login redirect URI registered. Redirect URIs are matched exactly, so
+// these applications reject every sign-in until an administrator registers
+// their callback URLs.
+func WarnApplicationsWithoutRedirectURIs(ctx context.Context, pool *pgxpool.Pool) error {
+	redirectURIs := repositories.RedirectURIRepository{Store: pgstore.New(pool)}
+
+	applications, err := redirectURIs.ListApplicationsWithoutLoginRedirectURI(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	for _, application := range applications {
+		slog.Warn("Application has no login redirect URI registered, sign-ins will be rejected",
+			"applicationID", application.ID,
+			"tenantID", application.TenantID,
+			"name", application.Name,
+		)
+	}
+
+	return nil
+}
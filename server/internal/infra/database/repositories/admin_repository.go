@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IAdminRepository defines all operations related to platform and tenant administrators.
+type IAdminRepository interface {
+	AddPlatformAdmin(ctx context.Context, admin *entities.PlatformAdmin) error
+	AddTenantAdmin(ctx context.Context, admin *entities.TenantAdmin) error
+	RemoveTenantAdmin(ctx context.Context, tenantID, userID uuid.UUID) error
+	IsPlatformAdmin(ctx context.Context, userID uuid.UUID) (bool, error)
+	IsTenantAdmin(ctx context.Context, tenantID, userID uuid.UUID) (bool, error)
+	ListAdminTenantIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// AdminRepository is the shared implementation for administrator-related DB operations.
+type AdminRepository struct {
+	Store *pgstore.Queries
+}
+
+func (r AdminRepository) AddPlatformAdmin(ctx context.Context, admin *entities.PlatformAdmin) error {
+	return r.Store.AddPlatformAdmin(ctx, pgstore.AddPlatformAdminParams{
+		UserID:    admin.UserID,
+		CreatedAt: pgtype.Timestamp{Time: admin.CreatedAt, Valid: true},
+	})
+}
+
+func (r AdminRepository) AddTenantAdmin(ctx context.Context, admin *entities.TenantAdmin) error {
+	return r.Store.AddTenantAdmin(ctx, pgstore.AddTenantAdminParams{
+		TenantID:  admin.TenantID,
+		UserID:    admin.UserID,
+		CreatedAt: pgtype.Timestamp{Time: admin.CreatedAt, Valid: true},
+	})
+}
+
+func (r AdminRepository) RemoveTenantAdmin(ctx context.Context, tenantID, userID uuid.UUID) error {
+	return r.Store.RemoveTenantAdmin(ctx, pgstore.RemoveTenantAdminParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+}
+
+func (r AdminRepository) IsPlatformAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return r.Store.IsPlatformAdmin(ctx, userID)
+}
+
+func (r AdminRepository) IsTenantAdmin(ctx context.Context, tenantID, userID uuid.UUID) (bool, error) {
+	return r.Store.IsTenantAdmin(ctx, pgstore.IsTenantAdminParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+}
+
+// ListAdminTenantIDs returns the tenants the user administers.
+func (r AdminRepository) ListAdminTenantIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	tenantIDs, err := r.Store.ListAdminTenantIDs(ctx, userID)
+
+	if err != nil && err != ErrNoRows {
+		return nil, err
+	}
+
+	return tenantIDs, nil
+}
@@ -45,7 +45,7 @@ func (r ApplicationRepository) GetApplicationByID(ctx context.Context, applicati
 		IsActive:             application.IsActive,
 		HasMfaAuthApp:        application.HasMfaAuthApp,
 		HasMfaEmail:          application.HasMfaEmail,
-		HasMfaWebauthn:       application.HasMfaWebauthn,
+		HasMfaPasskey:        application.HasMfaWebauthn,
 		RequiresHighSecurity: application.RequiresHighSecurity,
 		UpdatedAt:            application.UpdatedAt,
 		Badges:               strings.Split(*application.Badges, ","),
@@ -66,7 +66,7 @@ func (r ApplicationRepository) AddApplication(ctx context.Context, newApplicatio
 		IsActive:          newApplication.IsActive,
 		HasMfaAuthApp:     newApplication.HasMfaAuthApp,
 		HasMfaEmail:       newApplication.HasMfaEmail,
-		HasMfaWebauthn:    newApplication.HasMfaWebauthn,
+		HasMfaWebauthn:    newApplication.HasMfaPasskey,
 		Badges:            &badges,
 		UpdatedAt:         newApplication.UpdatedAt,
 		CanSelfSignUp:     newApplication.CanSelfSignUp,
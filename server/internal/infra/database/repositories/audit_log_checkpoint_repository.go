@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IAuditLogCheckpointRepository defines all operations related to the AuditLogCheckpoint entity.
+type IAuditLogCheckpointRepository interface {
+	AddAuditLogCheckpoint(ctx context.Context, checkpoint *entities.AuditLogCheckpoint) error
+	ListAuditLogCheckpoints(ctx context.Context, tenantID uuid.UUID) ([]*entities.AuditLogCheckpoint, error)
+}
+
+// AuditLogCheckpointRepository is the shared implementation for AuditLogCheckpoint-related DB operations.
+type AuditLogCheckpointRepository struct {
+	Store *pgstore.Queries
+}
+
+func (r AuditLogCheckpointRepository) AddAuditLogCheckpoint(ctx context.Context, checkpoint *entities.AuditLogCheckpoint) error {
+	return r.Store.AddAuditLogCheckpoint(ctx, pgstore.AddAuditLogCheckpointParams{
+		ID:            checkpoint.ID,
+		TenantID:      checkpoint.TenantID,
+		ChainSequence: checkpoint.ChainSequence,
+		AuditLogID:    checkpoint.AuditLogID,
+		Hash:          checkpoint.Hash,
+		Kid:           checkpoint.KeyID,
+		Signature:     checkpoint.Signature,
+		CreatedAt:     pgtype.Timestamp{Time: checkpoint.CreatedAt, Valid: true},
+	})
+}
+
+// ListAuditLogCheckpoints returns the checkpoints of the tenant, oldest first.
+func (r AuditLogCheckpointRepository) ListAuditLogCheckpoints(ctx context.Context, tenantID uuid.UUID) ([]*entities.AuditLogCheckpoint, error) {
+	rows, err := r.Store.ListAuditLogCheckpoints(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoints := make([]*entities.AuditLogCheckpoint, 0, len(rows))
+	for _, row := range rows {
+		checkpoints = append(checkpoints, &entities.AuditLogCheckpoint{
+			ID:            row.ID,
+			TenantID:      row.TenantID,
+			ChainSequence: row.ChainSequence,
+			AuditLogID:    row.AuditLogID,
+			Hash:          row.Hash,
+			KeyID:         row.Kid,
+			Signature:     row.Signature,
+			CreatedAt:     row.CreatedAt.Time,
+		})
+	}
+
+	return checkpoints, nil
+}
@@ -2,6 +2,8 @@ package repositories
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/gate-keeper/internal/domain/entities"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
@@ -13,6 +15,10 @@ import (
 type IAuditLogRepository interface {
 	AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error
 	GetAuditLogsByUserID(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]*entities.AuditLog, error)
+	ListTenantAuditLogs(ctx context.Context, tenantID uuid.UUID, filter entities.AuditLogFilter, beforeID *uuid.UUID, limit int) ([]*entities.AuditLog, error)
+	GetAuditLogChainHead(ctx context.Context, tenantID uuid.UUID) (*entities.AuditLogChainHead, error)
+	ListAuditLogChain(ctx context.Context, tenantID uuid.UUID, afterSequence int64, limit int) ([]*entities.AuditLog, error)
+	ListAuditLogChainHeadsToCheckpoint(ctx context.Context) ([]*entities.AuditLogChainHead, error)
 }
 
 // AuditLogRepository is the shared implementation for AuditLog-related DB operations.
@@ -20,9 +26,38 @@ type AuditLogRepository struct {
 	Store *pgstore.Queries
 }
 
+// AddAuditLog appends the entry to its tenant's hash chain. The chain head
+// stays locked until the transaction ends, so entries of a tenant are chained
+// one at a time.
 func (r AuditLogRepository) AddAuditLog(ctx context.Context, auditLog *entities.AuditLog) error {
-	return r.Store.AddAuditLog(ctx, pgstore.AddAuditLogParams{
+	if auditLog.TenantID == uuid.Nil {
+		if auditLog.ApplicationID == nil {
+			return fmt.Errorf("audit log %s has neither a tenant nor an application", auditLog.ID)
+		}
+
+		application, err := r.Store.GetApplicationByID(ctx, *auditLog.ApplicationID)
+		if err != nil {
+			return err
+		}
+
+		auditLog.TenantID = application.TenantID
+	}
+
+	now := time.Now().UTC()
+	head, err := r.Store.LockAuditLogChainHead(ctx, pgstore.LockAuditLogChainHeadParams{
+		TenantID:    auditLog.TenantID,
+		GenesisHash: entities.AuditLogGenesisHash,
+		UpdatedAt:   pgtype.Timestamp{Time: now, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	auditLog.ChainTo(head.ChainSequence, head.Hash)
+
+	if err := r.Store.AddAuditLog(ctx, pgstore.AddAuditLogParams{
 		ID:            auditLog.ID,
+		TenantID:      auditLog.TenantID,
 		UserID:        auditLog.UserID,
 		ApplicationID: auditLog.ApplicationID,
 		EventType:     auditLog.EventType,
@@ -31,6 +66,19 @@ func (r AuditLogRepository) AddAuditLog(ctx context.Context, auditLog *entities.
 		Result:        auditLog.Result,
 		Details:       auditLog.Details,
 		CreatedAt:     pgtype.Timestamp{Time: auditLog.CreatedAt, Valid: true},
+		ChainSequence: auditLog.ChainSequence,
+		PreviousHash:  auditLog.PreviousHash,
+		Hash:          auditLog.Hash,
+	}); err != nil {
+		return err
+	}
+
+	return r.Store.UpdateAuditLogChainHead(ctx, pgstore.UpdateAuditLogChainHeadParams{
+		ChainSequence: auditLog.ChainSequence,
+		AuditLogID:    &auditLog.ID,
+		Hash:          auditLog.Hash,
+		UpdatedAt:     pgtype.Timestamp{Time: now, Valid: true},
+		TenantID:      auditLog.TenantID,
 	})
 }
 
@@ -44,10 +92,92 @@ func (r AuditLogRepository) GetAuditLogsByUserID(ctx context.Context, userID uui
 		return nil, err
 	}
 
+	return auditLogsFromRows(rows), nil
+}
+
+// ListTenantAuditLogs returns up to limit entries of the tenant matching the
+// filter, newest first, starting after beforeID when it is set.
+func (r AuditLogRepository) ListTenantAuditLogs(ctx context.Context, tenantID uuid.UUID, filter entities.AuditLogFilter, beforeID *uuid.UUID, limit int) ([]*entities.AuditLog, error) {
+	rows, err := r.Store.ListTenantAuditLogs(ctx, pgstore.ListTenantAuditLogsParams{
+		TenantID:      tenantID,
+		UserID:        filter.UserID,
+		ApplicationID: filter.ApplicationID,
+		EventType:     filter.EventType,
+		Result:        filter.Result,
+		IpAddress:     filter.IPAddress,
+		CreatedFrom:   filter.From,
+		CreatedTo:     filter.To,
+		BeforeID:      beforeID,
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auditLogsFromRows(rows), nil
+}
+
+func (r AuditLogRepository) GetAuditLogChainHead(ctx context.Context, tenantID uuid.UUID) (*entities.AuditLogChainHead, error) {
+	head, err := r.Store.GetAuditLogChainHead(ctx, tenantID)
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return auditLogChainHeadFromRow(head), nil
+}
+
+// ListAuditLogChain returns up to limit chained entries of the tenant in chain
+// order, starting after afterSequence.
+func (r AuditLogRepository) ListAuditLogChain(ctx context.Context, tenantID uuid.UUID, afterSequence int64, limit int) ([]*entities.AuditLog, error) {
+	rows, err := r.Store.ListAuditLogChain(ctx, pgstore.ListAuditLogChainParams{
+		TenantID:      tenantID,
+		AfterSequence: afterSequence,
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auditLogsFromRows(rows), nil
+}
+
+// ListAuditLogChainHeadsToCheckpoint returns the chain heads that moved since
+// the last checkpoint of their tenant.
+func (r AuditLogRepository) ListAuditLogChainHeadsToCheckpoint(ctx context.Context) ([]*entities.AuditLogChainHead, error) {
+	rows, err := r.Store.ListAuditLogChainHeadsToCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	heads := make([]*entities.AuditLogChainHead, 0, len(rows))
+	for _, row := range rows {
+		heads = append(heads, auditLogChainHeadFromRow(row))
+	}
+
+	return heads, nil
+}
+
+func auditLogChainHeadFromRow(row pgstore.AuditLogChainHead) *entities.AuditLogChainHead {
+	return &entities.AuditLogChainHead{
+		TenantID:      row.TenantID,
+		ChainSequence: row.ChainSequence,
+		AuditLogID:    row.AuditLogID,
+		Hash:          row.Hash,
+		UpdatedAt:     row.UpdatedAt.Time,
+	}
+}
+
+func auditLogsFromRows(rows []pgstore.AuditLog) []*entities.AuditLog {
 	logs := make([]*entities.AuditLog, 0, len(rows))
 	for _, row := range rows {
 		logs = append(logs, &entities.AuditLog{
 			ID:            row.ID,
+			TenantID:      row.TenantID,
 			UserID:        row.UserID,
 			ApplicationID: row.ApplicationID,
 			EventType:     row.EventType,
@@ -56,8 +186,11 @@ func (r AuditLogRepository) GetAuditLogsByUserID(ctx context.Context, userID uui
 			Result:        row.Result,
 			Details:       row.Details,
 			CreatedAt:     row.CreatedAt.Time,
+			ChainSequence: row.ChainSequence,
+			PreviousHash:  row.PreviousHash,
+			Hash:          row.Hash,
 		})
 	}
 
-	return logs, nil
+	return logs
 }
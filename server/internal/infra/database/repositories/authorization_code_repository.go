@@ -33,6 +33,8 @@ func (r AuthorizationCodeRepository) AddAuthorizationCode(ctx context.Context, n
 		CodeChallengeMethod: newAuthorizationCode.CodeChallengeMethod,
 		Nonce:               newAuthorizationCode.Nonce,
 		Scope:               newAuthorizationCode.Scope,
+		IpAddress:           newAuthorizationCode.IPAddress,
+		UserAgent:           newAuthorizationCode.UserAgent,
 	})
 }
 
@@ -61,5 +63,7 @@ func (r AuthorizationCodeRepository) GetAuthorizationCodeById(ctx context.Contex
 		CodeChallengeMethod: authorizationCode.CodeChallengeMethod,
 		Nonce:               authorizationCode.Nonce,
 		Scope:               authorizationCode.Scope,
+		IPAddress:           authorizationCode.IpAddress,
+		UserAgent:           authorizationCode.UserAgent,
 	}, nil
 }
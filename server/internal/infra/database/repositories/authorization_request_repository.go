@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IAuthorizationRequestRepository defines all operations related to the AuthorizationRequest entity.
+type IAuthorizationRequestRepository interface {
+	AddAuthorizationRequest(ctx context.Context, authorizationRequest *entities.AuthorizationRequest) error
+	GetAuthorizationRequestByID(ctx context.Context, id uuid.UUID) (*entities.AuthorizationRequest, error)
+	RemoveAuthorizationRequest(ctx context.Context, id uuid.UUID) error
+}
+
+// AuthorizationRequestRepository is the shared implementation for AuthorizationRequest-related DB operations.
+type AuthorizationRequestRepository struct {
+	Store *pgstore.Queries
+}
+
+// AddAuthorizationRequest stores the request, pruning the ones that were never completed.
+func (r AuthorizationRequestRepository) AddAuthorizationRequest(ctx context.Context, authorizationRequest *entities.AuthorizationRequest) error {
+	if err := r.Store.DeleteExpiredAuthorizationRequests(ctx); err != nil {
+		return err
+	}
+
+	return r.Store.AddAuthorizationRequest(ctx, pgstore.AddAuthorizationRequestParams{
+		ID:                  authorizationRequest.ID,
+		ApplicationID:       authorizationRequest.ApplicationID,
+		RedirectUri:         authorizationRequest.RedirectUri,
+		ResponseType:        authorizationRequest.ResponseType,
+		Scope:               authorizationRequest.Scope,
+		State:               authorizationRequest.State,
+		Nonce:               authorizationRequest.Nonce,
+		CodeChallenge:       authorizationRequest.CodeChallenge,
+		CodeChallengeMethod: authorizationRequest.CodeChallengeMethod,
+		ExpiresAt:           pgtype.Timestamp{Time: authorizationRequest.ExpiresAt, Valid: true},
+		CreatedAt:           pgtype.Timestamp{Time: authorizationRequest.CreatedAt, Valid: true},
+	})
+}
+
+func (r AuthorizationRequestRepository) GetAuthorizationRequestByID(ctx context.Context, id uuid.UUID) (*entities.AuthorizationRequest, error) {
+	authorizationRequest, err := r.Store.GetAuthorizationRequestByID(ctx, id)
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.AuthorizationRequest{
+		ID:                  authorizationRequest.ID,
+		ApplicationID:       authorizationRequest.ApplicationID,
+		RedirectUri:         authorizationRequest.RedirectUri,
+		ResponseType:        authorizationRequest.ResponseType,
+		Scope:               authorizationRequest.Scope,
+		State:               authorizationRequest.State,
+		Nonce:               authorizationRequest.Nonce,
+		CodeChallenge:       authorizationRequest.CodeChallenge,
+		CodeChallengeMethod: authorizationRequest.CodeChallengeMethod,
+		ExpiresAt:           authorizationRequest.ExpiresAt.Time,
+		CreatedAt:           authorizationRequest.CreatedAt.Time,
+	}, nil
+}
+
+func (r AuthorizationRequestRepository) RemoveAuthorizationRequest(ctx context.Context, id uuid.UUID) error {
+	return r.Store.RemoveAuthorizationRequest(ctx, id)
+}
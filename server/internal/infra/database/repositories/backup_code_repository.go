@@ -16,6 +16,7 @@ type IBackupCodeRepository interface {
 	MarkBackupCodeUsed(ctx context.Context, codeID uuid.UUID) error
 	DeleteBackupCodesByUserID(ctx context.Context, userID uuid.UUID) error
 	CountUnusedBackupCodesByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	UseBackupCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
 }
 
 // BackupCodeRepository is the shared implementation for BackupCode-related DB operations.
@@ -66,3 +67,23 @@ func (r BackupCodeRepository) DeleteBackupCodesByUserID(ctx context.Context, use
 func (r BackupCodeRepository) CountUnusedBackupCodesByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
 	return r.Store.CountUnusedBackupCodesByUserID(ctx, userID)
 }
+
+// UseBackupCode marks the user's unused code with the given hash as used in a
+// single statement, so concurrent requests can't both redeem it. It reports
+// false when no such code exists.
+func (r BackupCodeRepository) UseBackupCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	_, err := r.Store.UseBackupCode(ctx, pgstore.UseBackupCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+
+	if err == ErrNoRows {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
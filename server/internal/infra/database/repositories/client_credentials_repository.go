@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IClientCredentialsRepository defines all operations related to the ApplicationClientCredentials entity.
+type IClientCredentialsRepository interface {
+	GetApplicationClientCredentials(ctx context.Context, applicationID uuid.UUID) (*entities.ApplicationClientCredentials, error)
+	SaveApplicationClientCredentials(ctx context.Context, credentials *entities.ApplicationClientCredentials) error
+	RemoveApplicationClientCredentials(ctx context.Context, applicationID uuid.UUID) error
+}
+
+// ClientCredentialsRepository is the shared implementation for ApplicationClientCredentials-related DB operations.
+type ClientCredentialsRepository struct {
+	Store *pgstore.Queries
+}
+
+// GetApplicationClientCredentials returns nil when the grant is not enabled for the application.
+func (r ClientCredentialsRepository) GetApplicationClientCredentials(ctx context.Context, applicationID uuid.UUID) (*entities.ApplicationClientCredentials, error) {
+	row, err := r.Store.GetApplicationClientCredentials(ctx, applicationID)
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := r.Store.ListApplicationClientRoles(ctx, applicationID)
+
+	if err != nil && err != ErrNoRows {
+		return nil, err
+	}
+
+	credentials := &entities.ApplicationClientCredentials{
+		ApplicationID: row.ApplicationID,
+		Scopes:        row.Scopes,
+		Roles:         make([]entities.ApplicationRole, 0, len(roles)),
+		CreatedAt:     row.CreatedAt.Time,
+		UpdatedAt:     row.UpdatedAt,
+	}
+
+	for _, role := range roles {
+		credentials.Roles = append(credentials.Roles, entities.ApplicationRole{
+			ID:            role.ID,
+			ApplicationID: role.ApplicationID,
+			Name:          role.Name,
+			Description:   role.Description,
+			CreatedAt:     role.CreatedAt.Time,
+			UpdatedAt:     role.UpdatedAt,
+		})
+	}
+
+	return credentials, nil
+}
+
+// SaveApplicationClientCredentials upserts the grant and replaces its roles.
+func (r ClientCredentialsRepository) SaveApplicationClientCredentials(ctx context.Context, credentials *entities.ApplicationClientCredentials) error {
+	err := r.Store.UpsertApplicationClientCredentials(ctx, pgstore.UpsertApplicationClientCredentialsParams{
+		ApplicationID: credentials.ApplicationID,
+		Scopes:        credentials.Scopes,
+		CreatedAt:     pgtype.Timestamp{Time: credentials.CreatedAt, Valid: true},
+		UpdatedAt:     credentials.UpdatedAt,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.Store.RemoveApplicationClientRoles(ctx, credentials.ApplicationID); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	for _, role := range credentials.Roles {
+		err := r.Store.AddApplicationClientRole(ctx, pgstore.AddApplicationClientRoleParams{
+			ApplicationID: credentials.ApplicationID,
+			RoleID:        role.ID,
+			CreatedAt:     pgtype.Timestamp{Time: now, Valid: true},
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r ClientCredentialsRepository) RemoveApplicationClientCredentials(ctx context.Context, applicationID uuid.UUID) error {
+	return r.Store.RemoveApplicationClientCredentials(ctx, applicationID)
+}
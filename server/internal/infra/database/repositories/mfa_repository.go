@@ -24,6 +24,7 @@ type IMfaRepository interface {
 	GetMfaTotpCodeByID(ctx context.Context, id uuid.UUID) (*entities.MfaTotpCode, error)
 	DeleteMfaTotpCode(ctx context.Context, appMfaCodeID uuid.UUID) error
 	AddMfaEmailCode(ctx context.Context, emailMfaCode *entities.MfaEmailCode) error
+	GetLatestMfaEmailCodeByMfaMethodID(ctx context.Context, mfaMethodID uuid.UUID) (*entities.MfaEmailCode, error)
 	GetMfaEmailCodeByToken(ctx context.Context, mfaMethodID uuid.UUID, token string) (*entities.MfaEmailCode, error)
 	DeleteEmailMfaCodeByID(ctx context.Context, emailMfaCodeID uuid.UUID) error
 	GetWebAuthnCredentialsByMfaMethodID(ctx context.Context, mfaMethodID uuid.UUID) ([]entities.MfaPasskeyCredentials, error)
@@ -220,6 +221,29 @@ func (r MfaRepository) AddMfaEmailCode(ctx context.Context, emailMfaCode *entiti
 	})
 }
 
+// GetLatestMfaEmailCodeByMfaMethodID returns the code last sent for the method,
+// which is the one the pending login challenge is waiting for.
+func (r MfaRepository) GetLatestMfaEmailCodeByMfaMethodID(ctx context.Context, mfaMethodID uuid.UUID) (*entities.MfaEmailCode, error) {
+	emailMfaCode, err := r.Store.GetLatestMfaEmailCodeByMfaMethodID(ctx, mfaMethodID)
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.MfaEmailCode{
+		ID:          emailMfaCode.ID,
+		MfaMethodID: emailMfaCode.MfaMethodID,
+		Token:       emailMfaCode.Token,
+		CreatedAt:   emailMfaCode.CreatedAt.Time,
+		ExpiresAt:   emailMfaCode.ExpiresAt.Time,
+		Verified:    emailMfaCode.Verified,
+	}, nil
+}
+
 func (r MfaRepository) GetMfaEmailCodeByToken(ctx context.Context, mfaMethodID uuid.UUID, token string) (*entities.MfaEmailCode, error) {
 	emailConfirmation, err := r.Store.GetMfaEmailCodeByToken(ctx, pgstore.GetMfaEmailCodeByTokenParams{
 		Token:       token,
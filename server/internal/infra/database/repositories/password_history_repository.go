@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IPasswordHistoryRepository defines all operations related to the PasswordHistory entity.
+type IPasswordHistoryRepository interface {
+	AddPasswordHistory(ctx context.Context, passwordHistory *entities.PasswordHistory) error
+	ListPasswordHistoryByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]entities.PasswordHistory, error)
+	RemoveOldPasswordHistory(ctx context.Context, userID uuid.UUID, keep int) error
+}
+
+// PasswordHistoryRepository is the shared implementation for PasswordHistory-related DB operations.
+type PasswordHistoryRepository struct {
+	Store *pgstore.Queries
+}
+
+func (r PasswordHistoryRepository) AddPasswordHistory(ctx context.Context, passwordHistory *entities.PasswordHistory) error {
+	return r.Store.AddPasswordHistory(ctx, pgstore.AddPasswordHistoryParams{
+		ID:           passwordHistory.ID,
+		UserID:       passwordHistory.UserID,
+		PasswordHash: passwordHistory.PasswordHash,
+		CreatedAt:    pgtype.Timestamp{Time: passwordHistory.CreatedAt, Valid: true},
+	})
+}
+
+// ListPasswordHistoryByUserID returns the user's previous passwords, newest first.
+func (r PasswordHistoryRepository) ListPasswordHistoryByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]entities.PasswordHistory, error) {
+	rows, err := r.Store.ListPasswordHistoryByUserID(ctx, pgstore.ListPasswordHistoryByUserIDParams{
+		UserID: userID,
+		Limit:  int32(limit),
+	})
+
+	if err != nil && err != ErrNoRows {
+		return nil, err
+	}
+
+	history := make([]entities.PasswordHistory, 0, len(rows))
+	for _, row := range rows {
+		history = append(history, entities.PasswordHistory{
+			ID:           row.ID,
+			UserID:       row.UserID,
+			PasswordHash: row.PasswordHash,
+			CreatedAt:    row.CreatedAt.Time,
+		})
+	}
+
+	return history, nil
+}
+
+// RemoveOldPasswordHistory deletes all but the user's newest keep entries.
+func (r PasswordHistoryRepository) RemoveOldPasswordHistory(ctx context.Context, userID uuid.UUID, keep int) error {
+	return r.Store.RemoveOldPasswordHistory(ctx, pgstore.RemoveOldPasswordHistoryParams{
+		UserID: userID,
+		Keep:   int32(keep),
+	})
+}
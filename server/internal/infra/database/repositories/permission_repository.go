@@ -0,0 +1,172 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IPermissionRepository defines all operations related to the ApplicationPermission entity
+// and its mapping to roles.
+type IPermissionRepository interface {
+	AddPermission(ctx context.Context, permission *entities.ApplicationPermission) error
+	UpdatePermission(ctx context.Context, permission *entities.ApplicationPermission) error
+	RemovePermission(ctx context.Context, permissionID, applicationID uuid.UUID) error
+	GetPermissionByID(ctx context.Context, permissionID, applicationID uuid.UUID) (*entities.ApplicationPermission, error)
+	GetPermissionByName(ctx context.Context, applicationID uuid.UUID, name string) (*entities.ApplicationPermission, error)
+	ListPermissionsFromApplication(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationPermission, error)
+	ListRolePermissions(ctx context.Context, roleID uuid.UUID) ([]entities.ApplicationPermission, error)
+	ReplaceRolePermissions(ctx context.Context, roleID uuid.UUID, permissionIDs []uuid.UUID) error
+	GetUserPermissionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]string, error)
+	GetApplicationClientPermissions(ctx context.Context, applicationID uuid.UUID) ([]string, error)
+}
+
+// PermissionRepository is the shared implementation for ApplicationPermission-related DB operations.
+type PermissionRepository struct {
+	Store *pgstore.Queries
+}
+
+func (r PermissionRepository) AddPermission(ctx context.Context, permission *entities.ApplicationPermission) error {
+	return r.Store.AddPermission(ctx, pgstore.AddPermissionParams{
+		ID:            permission.ID,
+		ApplicationID: permission.ApplicationID,
+		Name:          permission.Name,
+		Description:   permission.Description,
+		CreatedAt:     pgtype.Timestamp{Time: permission.CreatedAt, Valid: true},
+		UpdatedAt:     permission.UpdatedAt,
+	})
+}
+
+func (r PermissionRepository) UpdatePermission(ctx context.Context, permission *entities.ApplicationPermission) error {
+	return r.Store.UpdatePermission(ctx, pgstore.UpdatePermissionParams{
+		ID:            permission.ID,
+		ApplicationID: permission.ApplicationID,
+		Name:          permission.Name,
+		Description:   permission.Description,
+		UpdatedAt:     permission.UpdatedAt,
+	})
+}
+
+func (r PermissionRepository) RemovePermission(ctx context.Context, permissionID, applicationID uuid.UUID) error {
+	return r.Store.RemovePermission(ctx, pgstore.RemovePermissionParams{
+		ID:            permissionID,
+		ApplicationID: applicationID,
+	})
+}
+
+func (r PermissionRepository) GetPermissionByID(ctx context.Context, permissionID, applicationID uuid.UUID) (*entities.ApplicationPermission, error) {
+	permission, err := r.Store.GetPermissionByID(ctx, pgstore.GetPermissionByIDParams{
+		ID:            permissionID,
+		ApplicationID: applicationID,
+	})
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPermission(permission), nil
+}
+
+func (r PermissionRepository) GetPermissionByName(ctx context.Context, applicationID uuid.UUID, name string) (*entities.ApplicationPermission, error) {
+	permission, err := r.Store.GetPermissionByName(ctx, pgstore.GetPermissionByNameParams{
+		ApplicationID: applicationID,
+		Name:          name,
+	})
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPermission(permission), nil
+}
+
+func (r PermissionRepository) ListPermissionsFromApplication(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationPermission, error) {
+	permissions, err := r.Store.ListPermissionsFromApplication(ctx, applicationID)
+
+	if err != nil && err != ErrNoRows {
+		return nil, err
+	}
+
+	return mapPermissions(permissions), nil
+}
+
+func (r PermissionRepository) ListRolePermissions(ctx context.Context, roleID uuid.UUID) ([]entities.ApplicationPermission, error) {
+	permissions, err := r.Store.ListRolePermissions(ctx, roleID)
+
+	if err != nil && err != ErrNoRows {
+		return nil, err
+	}
+
+	return mapPermissions(permissions), nil
+}
+
+// ReplaceRolePermissions sets the permissions attached to a role.
+func (r PermissionRepository) ReplaceRolePermissions(ctx context.Context, roleID uuid.UUID, permissionIDs []uuid.UUID) error {
+	if err := r.Store.RemoveRolePermissions(ctx, roleID); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	for _, permissionID := range permissionIDs {
+		err := r.Store.AddRolePermission(ctx, pgstore.AddRolePermissionParams{
+			RoleID:       roleID,
+			PermissionID: permissionID,
+			CreatedAt:    pgtype.Timestamp{Time: now, Valid: true},
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetUserPermissionsFromApplication returns the distinct permission names the
+// user holds in the application through their roles.
+func (r PermissionRepository) GetUserPermissionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]string, error) {
+	return r.Store.GetUserPermissionsFromApplication(ctx, pgstore.GetUserPermissionsFromApplicationParams{
+		UserID:        userID,
+		ApplicationID: applicationID,
+	})
+}
+
+// GetApplicationClientPermissions returns the distinct permission names of the
+// roles assigned to the application's client_credentials grant.
+func (r PermissionRepository) GetApplicationClientPermissions(ctx context.Context, applicationID uuid.UUID) ([]string, error) {
+	return r.Store.GetApplicationClientPermissions(ctx, applicationID)
+}
+
+func mapPermission(permission pgstore.ApplicationPermission) *entities.ApplicationPermission {
+	return &entities.ApplicationPermission{
+		ID:            permission.ID,
+		ApplicationID: permission.ApplicationID,
+		Name:          permission.Name,
+		Description:   permission.Description,
+		CreatedAt:     permission.CreatedAt.Time,
+		UpdatedAt:     permission.UpdatedAt,
+	}
+}
+
+func mapPermissions(permissions []pgstore.ApplicationPermission) []entities.ApplicationPermission {
+	result := make([]entities.ApplicationPermission, 0, len(permissions))
+
+	for _, permission := range permissions {
+		result = append(result, *mapPermission(permission))
+	}
+
+	return result
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IRedirectURIRepository defines all operations related to the ApplicationRedirectURI entity.
+type IRedirectURIRepository interface {
+	ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error)
+	ReplaceRedirectURIs(ctx context.Context, applicationID uuid.UUID, kind string, redirectURIs []entities.ApplicationRedirectURI) error
+}
+
+// RedirectURIRepository is the shared implementation for ApplicationRedirectURI-related DB operations.
+type RedirectURIRepository struct {
+	Store *pgstore.Queries
+}
+
+// ListRedirectURIs returns the redirect URIs of every kind registered by the application.
+func (r RedirectURIRepository) ListRedirectURIs(ctx context.Context, applicationID uuid.UUID) ([]entities.ApplicationRedirectURI, error) {
+	rows, err := r.Store.ListApplicationRedirectUris(ctx, applicationID)
+
+	if err != nil && err != ErrNoRows {
+		return nil, err
+	}
+
+	redirectURIs := make([]entities.ApplicationRedirectURI, 0, len(rows))
+
+	for _, row := range rows {
+		redirectURIs = append(redirectURIs, entities.ApplicationRedirectURI{
+			ID:            row.ID,
+			ApplicationID: row.ApplicationID,
+			URI:           row.Uri,
+			Kind:          row.Kind,
+			CreatedAt:     row.CreatedAt.Time,
+		})
+	}
+
+	return redirectURIs, nil
+}
+
+// ListApplicationsWithoutLoginRedirectURI returns the active applications that have no login redirect URI.
+func (r RedirectURIRepository) ListApplicationsWithoutLoginRedirectURI(ctx context.Context) ([]entities.Application, error) {
+	rows, err := r.Store.ListActiveApplicationsWithoutLoginRedirectUri(ctx)
+
+	if err != nil && err != ErrNoRows {
+		return nil, err
+	}
+
+	applications := make([]entities.Application, 0, len(rows))
+
+	for _, row := range rows {
+		applications = append(applications, entities.Application{
+			ID:       row.ID,
+			TenantID: row.TenantID,
+			Name:     row.Name,
+			IsActive: true,
+		})
+	}
+
+	return applications, nil
+}
+
+// ReplaceRedirectURIs swaps the application's redirect URIs of the given kind for the provided list.
+func (r RedirectURIRepository) ReplaceRedirectURIs(ctx context.Context, applicationID uuid.UUID, kind string, redirectURIs []entities.ApplicationRedirectURI) error {
+	err := r.Store.RemoveApplicationRedirectUris(ctx, pgstore.RemoveApplicationRedirectUrisParams{
+		ApplicationID: applicationID,
+		Kind:          kind,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, redirectURI := range redirectURIs {
+		err := r.Store.AddApplicationRedirectUri(ctx, pgstore.AddApplicationRedirectUriParams{
+			ID:            redirectURI.ID,
+			ApplicationID: applicationID,
+			Uri:           redirectURI.URI,
+			Kind:          kind,
+			CreatedAt:     pgtype.Timestamp{Time: redirectURI.CreatedAt, Valid: true},
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/gate-keeper/internal/domain/entities"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
@@ -14,6 +15,12 @@ type IRefreshTokenRepository interface {
 	AddRefreshToken(ctx context.Context, refreshToken *entities.RefreshToken) (*entities.RefreshToken, error)
 	RevokeRefreshTokenFromUser(ctx context.Context, userID uuid.UUID) error
 	RevokeRefreshTokenByID(ctx context.Context, sessionID uuid.UUID) error
+	GetRefreshTokenByID(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error)
+	GetRefreshTokenByIDForUpdate(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error)
+	MarkRefreshTokenUsed(ctx context.Context, refreshToken *entities.RefreshToken) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeRefreshTokensFromApplication(ctx context.Context, userID, applicationID uuid.UUID) error
+	RevokeRefreshTokensFromSession(ctx context.Context, userID, sessionID uuid.UUID) error
 }
 
 // RefreshTokenRepository is the shared implementation for RefreshToken-related DB operations.
@@ -23,28 +30,115 @@ type RefreshTokenRepository struct {
 
 func (r RefreshTokenRepository) AddRefreshToken(ctx context.Context, refreshToken *entities.RefreshToken) (*entities.RefreshToken, error) {
 	err := r.Store.AddRefreshToken(ctx, pgstore.AddRefreshTokenParams{
-		UserID:    refreshToken.UserID,
-		ID:        refreshToken.ID,
-		ExpiresAt: pgtype.Timestamp{Time: refreshToken.ExpiresAt, Valid: true},
-		CreatedAt: pgtype.Timestamp{Time: refreshToken.CreatedAt, Valid: true},
+		UserID:        refreshToken.UserID,
+		ID:            refreshToken.ID,
+		ExpiresAt:     pgtype.Timestamp{Time: refreshToken.ExpiresAt, Valid: true},
+		CreatedAt:     pgtype.Timestamp{Time: refreshToken.CreatedAt, Valid: true},
+		FamilyID:      refreshToken.FamilyID,
+		ApplicationID: refreshToken.ApplicationID,
+		SessionID:     refreshToken.SessionID,
+		Scope:         refreshToken.Scope,
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &entities.RefreshToken{
-		ID:        refreshToken.ID,
-		UserID:    refreshToken.UserID,
-		ExpiresAt: refreshToken.ExpiresAt,
-		CreatedAt: refreshToken.CreatedAt,
-	}, nil
+	return refreshToken, nil
 }
 
+// RevokeRefreshTokenFromUser marks every refresh token of the user revoked.
+// The tokens are kept so a replayed one is still recognized.
 func (r RefreshTokenRepository) RevokeRefreshTokenFromUser(ctx context.Context, userID uuid.UUID) error {
-	return r.Store.RevokeRefreshTokenFromUser(ctx, userID)
+	now := time.Now().UTC()
+
+	return r.Store.RevokeRefreshTokenFromUser(ctx, pgstore.RevokeRefreshTokenFromUserParams{
+		RevokedAt: &now,
+		UserID:    userID,
+	})
 }
 
 func (r RefreshTokenRepository) RevokeRefreshTokenByID(ctx context.Context, sessionID uuid.UUID) error {
 	return r.Store.RevokeRefreshTokenByID(ctx, sessionID)
 }
+
+func (r RefreshTokenRepository) GetRefreshTokenByID(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error) {
+	refreshToken, err := r.Store.GetRefreshTokenByID(ctx, id)
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mapRefreshToken(refreshToken), nil
+}
+
+// GetRefreshTokenByIDForUpdate locks the token row so concurrent redemptions
+// of the same token are serialized.
+func (r RefreshTokenRepository) GetRefreshTokenByIDForUpdate(ctx context.Context, id uuid.UUID) (*entities.RefreshToken, error) {
+	refreshToken, err := r.Store.GetRefreshTokenByIDForUpdate(ctx, id)
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mapRefreshToken(refreshToken), nil
+}
+
+func (r RefreshTokenRepository) MarkRefreshTokenUsed(ctx context.Context, refreshToken *entities.RefreshToken) error {
+	return r.Store.MarkRefreshTokenUsed(ctx, pgstore.MarkRefreshTokenUsedParams{
+		UsedAt: refreshToken.UsedAt,
+		ID:     refreshToken.ID,
+	})
+}
+
+func (r RefreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	now := time.Now().UTC()
+
+	return r.Store.RevokeRefreshTokenFamily(ctx, pgstore.RevokeRefreshTokenFamilyParams{
+		RevokedAt: &now,
+		FamilyID:  familyID,
+	})
+}
+
+func (r RefreshTokenRepository) RevokeRefreshTokensFromApplication(ctx context.Context, userID, applicationID uuid.UUID) error {
+	now := time.Now().UTC()
+
+	return r.Store.RevokeRefreshTokensFromApplication(ctx, pgstore.RevokeRefreshTokensFromApplicationParams{
+		RevokedAt:     &now,
+		UserID:        userID,
+		ApplicationID: &applicationID,
+	})
+}
+
+func (r RefreshTokenRepository) RevokeRefreshTokensFromSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	now := time.Now().UTC()
+
+	return r.Store.RevokeRefreshTokensFromSession(ctx, pgstore.RevokeRefreshTokensFromSessionParams{
+		RevokedAt: &now,
+		UserID:    userID,
+		SessionID: &sessionID,
+	})
+}
+
+func mapRefreshToken(refreshToken pgstore.RefreshToken) *entities.RefreshToken {
+	return &entities.RefreshToken{
+		ID:            refreshToken.ID,
+		UserID:        refreshToken.UserID,
+		FamilyID:      refreshToken.FamilyID,
+		ApplicationID: refreshToken.ApplicationID,
+		SessionID:     refreshToken.SessionID,
+		Scope:         refreshToken.Scope,
+		ExpiresAt:     refreshToken.ExpiresAt.Time,
+		CreatedAt:     refreshToken.CreatedAt.Time,
+		UsedAt:        refreshToken.UsedAt,
+		RevokedAt:     refreshToken.RevokedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IRevokedAccessTokenRepository defines all operations related to the access token denylist.
+type IRevokedAccessTokenRepository interface {
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// RevokedAccessTokenRepository is the shared implementation for access token denylist DB operations.
+type RevokedAccessTokenRepository struct {
+	Store *pgstore.Queries
+}
+
+// RevokeAccessToken denies the given jti until expiresAt, pruning entries whose tokens already expired.
+func (r RevokedAccessTokenRepository) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if err := r.Store.DeleteExpiredRevokedAccessTokens(ctx); err != nil {
+		return err
+	}
+
+	return r.Store.AddRevokedAccessToken(ctx, pgstore.AddRevokedAccessTokenParams{
+		Jti:       jti,
+		ExpiresAt: pgtype.Timestamp{Time: expiresAt, Valid: true},
+		RevokedAt: pgtype.Timestamp{Time: time.Now().UTC(), Valid: true},
+	})
+}
+
+func (r RevokedAccessTokenRepository) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	return r.Store.CheckIfAccessTokenRevoked(ctx, jti)
+}
@@ -13,8 +13,10 @@ import (
 type IRoleRepository interface {
 	AddRole(ctx context.Context, newRole *entities.ApplicationRole) error
 	RemoveRole(ctx context.Context, roleID uuid.UUID) error
+	GetRoleByID(ctx context.Context, roleID, applicationID uuid.UUID) (*entities.ApplicationRole, error)
 	ListRolesFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationRole, error)
 	GetRolesByUserID(ctx context.Context, userID uuid.UUID) ([]entities.ApplicationRole, error)
+	GetUserRolesFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]entities.ApplicationRole, error)
 	AddUserRole(ctx context.Context, newUserRole *entities.UserRole) error
 	RemoveUserRole(ctx context.Context, userRole *entities.UserRole) error
 }
@@ -39,6 +41,31 @@ func (r RoleRepository) RemoveRole(ctx context.Context, roleID uuid.UUID) error
 	return r.Store.RemoveRole(ctx, roleID)
 }
 
+// GetRoleByID returns nil when the role does not exist in the application.
+func (r RoleRepository) GetRoleByID(ctx context.Context, roleID, applicationID uuid.UUID) (*entities.ApplicationRole, error) {
+	role, err := r.Store.GetRoleByID(ctx, pgstore.GetRoleByIDParams{
+		ID:            roleID,
+		ApplicationID: applicationID,
+	})
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.ApplicationRole{
+		ID:            role.ID,
+		ApplicationID: role.ApplicationID,
+		Name:          role.Name,
+		Description:   role.Description,
+		CreatedAt:     role.CreatedAt.Time,
+		UpdatedAt:     role.UpdatedAt,
+	}, nil
+}
+
 func (r RoleRepository) ListRolesFromApplication(ctx context.Context, applicationID uuid.UUID) (*[]entities.ApplicationRole, error) {
 	roles, err := r.Store.ListRolesFromApplication(ctx, applicationID)
 
@@ -78,6 +105,27 @@ func (r RoleRepository) GetRolesByUserID(ctx context.Context, userID uuid.UUID)
 	return applicationRoles, nil
 }
 
+func (r RoleRepository) GetUserRolesFromApplication(ctx context.Context, userID, applicationID uuid.UUID) ([]entities.ApplicationRole, error) {
+	roles, err := r.Store.GetUserRolesFromApplication(ctx, pgstore.GetUserRolesFromApplicationParams{
+		UserID:        userID,
+		ApplicationID: applicationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	applicationRoles := make([]entities.ApplicationRole, 0, len(roles))
+	for _, role := range roles {
+		applicationRoles = append(applicationRoles, entities.ApplicationRole{
+			ID:            role.ID,
+			ApplicationID: applicationID,
+			Name:          role.Name,
+		})
+	}
+
+	return applicationRoles, nil
+}
+
 func (r RoleRepository) AddUserRole(ctx context.Context, newUserRole *entities.UserRole) error {
 	return r.Store.AddUserRole(ctx, pgstore.AddUserRoleParams{
 		UserID:    newUserRole.UserID,
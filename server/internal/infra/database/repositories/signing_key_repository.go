@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ISigningKeyRepository defines all operations related to the SigningKey entity.
+type ISigningKeyRepository interface {
+	AddSigningKey(ctx context.Context, key *entities.SigningKey) error
+	UpdateSigningKeyStatus(ctx context.Context, key *entities.SigningKey) error
+	DeleteExpiredSigningKeys(ctx context.Context) error
+	ListPublishedSigningKeys(ctx context.Context) ([]*entities.SigningKey, error)
+	ListSigningKeysByTenant(ctx context.Context, tenantID uuid.UUID) ([]*entities.SigningKey, error)
+	TryLockSigningKeyRotation(ctx context.Context) (bool, error)
+}
+
+// SigningKeyRepository is the shared implementation for SigningKey-related DB operations.
+type SigningKeyRepository struct {
+	Store *pgstore.Queries
+}
+
+func (r SigningKeyRepository) AddSigningKey(ctx context.Context, key *entities.SigningKey) error {
+	return r.Store.AddSigningKey(ctx, pgstore.AddSigningKeyParams{
+		ID:          key.ID,
+		TenantID:    key.TenantID,
+		Kid:         key.KeyID,
+		Algorithm:   key.Algorithm,
+		PrivateKey:  key.PrivateKey,
+		Status:      key.Status,
+		CreatedAt:   pgtype.Timestamp{Time: key.CreatedAt, Valid: true},
+		ActivatedAt: key.ActivatedAt,
+		RetiredAt:   key.RetiredAt,
+		ExpiresAt:   key.ExpiresAt,
+	})
+}
+
+func (r SigningKeyRepository) UpdateSigningKeyStatus(ctx context.Context, key *entities.SigningKey) error {
+	return r.Store.UpdateSigningKeyStatus(ctx, pgstore.UpdateSigningKeyStatusParams{
+		ID:          key.ID,
+		Status:      key.Status,
+		ActivatedAt: key.ActivatedAt,
+		RetiredAt:   key.RetiredAt,
+		ExpiresAt:   key.ExpiresAt,
+	})
+}
+
+func (r SigningKeyRepository) DeleteExpiredSigningKeys(ctx context.Context) error {
+	return r.Store.DeleteExpiredSigningKeys(ctx)
+}
+
+// TryLockSigningKeyRotation takes the cluster-wide rotation lock for the
+// current transaction, reporting false when another node already holds it.
+func (r SigningKeyRepository) TryLockSigningKeyRotation(ctx context.Context) (bool, error) {
+	return r.Store.TryLockSigningKeyRotation(ctx)
+}
+
+func (r SigningKeyRepository) ListPublishedSigningKeys(ctx context.Context) ([]*entities.SigningKey, error) {
+	rows, err := r.Store.ListPublishedSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSigningKeys(rows), nil
+}
+
+func (r SigningKeyRepository) ListSigningKeysByTenant(ctx context.Context, tenantID uuid.UUID) ([]*entities.SigningKey, error) {
+	rows, err := r.Store.ListSigningKeysByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSigningKeys(rows), nil
+}
+
+func mapSigningKeys(rows []pgstore.SigningKey) []*entities.SigningKey {
+	keys := make([]*entities.SigningKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, &entities.SigningKey{
+			ID:          row.ID,
+			TenantID:    row.TenantID,
+			KeyID:       row.Kid,
+			Algorithm:   row.Algorithm,
+			PrivateKey:  row.PrivateKey,
+			Status:      row.Status,
+			CreatedAt:   row.CreatedAt.Time,
+			ActivatedAt: row.ActivatedAt,
+			RetiredAt:   row.RetiredAt,
+			ExpiresAt:   row.ExpiresAt,
+		})
+	}
+
+	return keys
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/gate-keeper/internal/domain/entities"
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
@@ -31,32 +32,85 @@ func (r TenantRepository) GetTenantByID(ctx context.Context, id uuid.UUID) (*ent
 	}
 
 	return &entities.Tenant{
-		ID:                 tenant.ID,
-		Name:               tenant.Name,
-		CreatedAt:          tenant.CreatedAt.Time,
-		UpdatedAt:          tenant.UpdatedAt,
-		Description:        tenant.Description,
-		PasswordHashSecret: tenant.PasswordHashSecret,
+		ID:                         tenant.ID,
+		Name:                       tenant.Name,
+		CreatedAt:                  tenant.CreatedAt.Time,
+		UpdatedAt:                  tenant.UpdatedAt,
+		Description:                tenant.Description,
+		PasswordHashSecret:         tenant.PasswordHashSecret,
+		PreviousPasswordHashSecret: tenant.PreviousPasswordHashSecret,
+		PasswordHashMemory:         int(tenant.PasswordHashMemory),
+		PasswordHashIterations:     int(tenant.PasswordHashIterations),
+		PasswordHashParallelism:    int(tenant.PasswordHashParallelism),
+		PasswordMinLength:          int(tenant.PasswordMinLength),
+		PasswordRequireUppercase:   tenant.PasswordRequireUppercase,
+		PasswordRequireLowercase:   tenant.PasswordRequireLowercase,
+		PasswordRequireDigit:       tenant.PasswordRequireDigit,
+		PasswordRequireSymbol:      tenant.PasswordRequireSymbol,
+		PasswordMaxAgeDays:         int(tenant.PasswordMaxAgeDays),
+		PasswordHistoryCount:       int(tenant.PasswordHistoryCount),
+		PasswordForbiddenWords:     tenant.PasswordForbiddenWords,
+		BreachedPasswordCheck:      tenant.BreachedPasswordCheck,
+		BreachedPasswordThreshold:  int(tenant.BreachedPasswordThreshold),
+		LockoutThreshold:           int(tenant.LockoutThreshold),
+		LockoutDuration:            time.Duration(tenant.LockoutDurationSeconds) * time.Second,
+		LockoutMaxDuration:         time.Duration(tenant.LockoutMaxDurationSeconds) * time.Second,
+		LockoutNotifyUser:          tenant.LockoutNotifyUser,
 	}, nil
 }
 
 func (r TenantRepository) AddTenant(ctx context.Context, tenant *entities.Tenant) error {
 	return r.Store.AddTenant(ctx, pgstore.AddTenantParams{
-		ID:                 tenant.ID,
-		Name:               tenant.Name,
-		Description:        tenant.Description,
-		PasswordHashSecret: tenant.PasswordHashSecret,
-		CreatedAt:          pgtype.Timestamp{Time: tenant.CreatedAt, Valid: true},
+		ID:                        tenant.ID,
+		Name:                      tenant.Name,
+		Description:               tenant.Description,
+		PasswordHashSecret:        tenant.PasswordHashSecret,
+		PasswordHashMemory:        int32(tenant.PasswordHashMemory),
+		PasswordHashIterations:    int32(tenant.PasswordHashIterations),
+		PasswordHashParallelism:   int32(tenant.PasswordHashParallelism),
+		PasswordMinLength:         int32(tenant.PasswordMinLength),
+		PasswordRequireUppercase:  tenant.PasswordRequireUppercase,
+		PasswordRequireLowercase:  tenant.PasswordRequireLowercase,
+		PasswordRequireDigit:      tenant.PasswordRequireDigit,
+		PasswordRequireSymbol:     tenant.PasswordRequireSymbol,
+		PasswordMaxAgeDays:        int32(tenant.PasswordMaxAgeDays),
+		PasswordHistoryCount:      int32(tenant.PasswordHistoryCount),
+		PasswordForbiddenWords:    forbiddenWordsOrEmpty(tenant.PasswordForbiddenWords),
+		BreachedPasswordCheck:     tenant.BreachedPasswordCheck,
+		BreachedPasswordThreshold: int32(tenant.BreachedPasswordThreshold),
+		LockoutThreshold:          int32(tenant.LockoutThreshold),
+		LockoutDurationSeconds:    int32(tenant.LockoutDuration / time.Second),
+		LockoutMaxDurationSeconds: int32(tenant.LockoutMaxDuration / time.Second),
+		LockoutNotifyUser:         tenant.LockoutNotifyUser,
+		CreatedAt:                 pgtype.Timestamp{Time: tenant.CreatedAt, Valid: true},
 	})
 }
 
 func (r TenantRepository) UpdateTenant(ctx context.Context, tenant *entities.Tenant) error {
 	return r.Store.UpdateTenant(ctx, pgstore.UpdateTenantParams{
-		ID:                 tenant.ID,
-		Name:               tenant.Name,
-		Description:        tenant.Description,
-		PasswordHashSecret: tenant.PasswordHashSecret,
-		UpdatedAt:          tenant.UpdatedAt,
+		ID:                         tenant.ID,
+		Name:                       tenant.Name,
+		Description:                tenant.Description,
+		PasswordHashSecret:         tenant.PasswordHashSecret,
+		PreviousPasswordHashSecret: tenant.PreviousPasswordHashSecret,
+		PasswordHashMemory:         int32(tenant.PasswordHashMemory),
+		PasswordHashIterations:     int32(tenant.PasswordHashIterations),
+		PasswordHashParallelism:    int32(tenant.PasswordHashParallelism),
+		PasswordMinLength:          int32(tenant.PasswordMinLength),
+		PasswordRequireUppercase:   tenant.PasswordRequireUppercase,
+		PasswordRequireLowercase:   tenant.PasswordRequireLowercase,
+		PasswordRequireDigit:       tenant.PasswordRequireDigit,
+		PasswordRequireSymbol:      tenant.PasswordRequireSymbol,
+		PasswordMaxAgeDays:         int32(tenant.PasswordMaxAgeDays),
+		PasswordHistoryCount:       int32(tenant.PasswordHistoryCount),
+		PasswordForbiddenWords:     forbiddenWordsOrEmpty(tenant.PasswordForbiddenWords),
+		BreachedPasswordCheck:      tenant.BreachedPasswordCheck,
+		BreachedPasswordThreshold:  int32(tenant.BreachedPasswordThreshold),
+		LockoutThreshold:           int32(tenant.LockoutThreshold),
+		LockoutDurationSeconds:     int32(tenant.LockoutDuration / time.Second),
+		LockoutMaxDurationSeconds:  int32(tenant.LockoutMaxDuration / time.Second),
+		LockoutNotifyUser:          tenant.LockoutNotifyUser,
+		UpdatedAt:                  tenant.UpdatedAt,
 	})
 }
 
@@ -74,14 +128,42 @@ func (r TenantRepository) ListTenants(ctx context.Context) (*[]entities.Tenant,
 	var tenantList []entities.Tenant
 	for _, tenant := range tenants {
 		tenantList = append(tenantList, entities.Tenant{
-			ID:                 tenant.ID,
-			Name:               tenant.Name,
-			CreatedAt:          tenant.CreatedAt.Time,
-			UpdatedAt:          tenant.UpdatedAt,
-			Description:        tenant.Description,
-			PasswordHashSecret: tenant.PasswordHashSecret,
+			ID:                         tenant.ID,
+			Name:                       tenant.Name,
+			CreatedAt:                  tenant.CreatedAt.Time,
+			UpdatedAt:                  tenant.UpdatedAt,
+			Description:                tenant.Description,
+			PasswordHashSecret:         tenant.PasswordHashSecret,
+			PreviousPasswordHashSecret: tenant.PreviousPasswordHashSecret,
+			PasswordHashMemory:         int(tenant.PasswordHashMemory),
+			PasswordHashIterations:     int(tenant.PasswordHashIterations),
+			PasswordHashParallelism:    int(tenant.PasswordHashParallelism),
+			PasswordMinLength:          int(tenant.PasswordMinLength),
+			PasswordRequireUppercase:   tenant.PasswordRequireUppercase,
+			PasswordRequireLowercase:   tenant.PasswordRequireLowercase,
+			PasswordRequireDigit:       tenant.PasswordRequireDigit,
+			PasswordRequireSymbol:      tenant.PasswordRequireSymbol,
+			PasswordMaxAgeDays:         int(tenant.PasswordMaxAgeDays),
+			PasswordHistoryCount:       int(tenant.PasswordHistoryCount),
+			PasswordForbiddenWords:     tenant.PasswordForbiddenWords,
+			BreachedPasswordCheck:      tenant.BreachedPasswordCheck,
+			BreachedPasswordThreshold:  int(tenant.BreachedPasswordThreshold),
+			LockoutThreshold:           int(tenant.LockoutThreshold),
+			LockoutDuration:            time.Duration(tenant.LockoutDurationSeconds) * time.Second,
+			LockoutMaxDuration:         time.Duration(tenant.LockoutMaxDurationSeconds) * time.Second,
+			LockoutNotifyUser:          tenant.LockoutNotifyUser,
 		})
 	}
 
 	return &tenantList, nil
 }
+
+// forbiddenWordsOrEmpty keeps a nil slice from being stored as NULL in the
+// NOT NULL password_forbidden_words column.
+func forbiddenWordsOrEmpty(words []string) []string {
+	if words == nil {
+		return []string{}
+	}
+
+	return words
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
@@ -31,6 +32,37 @@ type ParamsRs[TRequest any, TResponse any, TService any] struct {
 
 var ErrNoRows = pgx.ErrNoRows
 
+// CommitError makes WithTransaction and WithTransactionRs commit what the
+// handler wrote before failing with Err, e.g. the counter of a failed login.
+type CommitError struct {
+	Err error
+}
+
+func (e *CommitError) Error() string { return e.Err.Error() }
+
+func (e *CommitError) Unwrap() error { return e.Err }
+
+// CommitAndReturn wraps err so the handler's transaction is committed anyway.
+func CommitAndReturn(err error) error {
+	return &CommitError{Err: err}
+}
+
+// commitAndPanic commits tx when err asks for it and fails the request with
+// the wrapped error. Other errors are left to the caller.
+func commitAndPanic(ctx context.Context, tx pgx.Tx, err error) {
+	var commitErr *CommitError
+
+	if !errors.As(err, &commitErr) {
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		panic(err)
+	}
+
+	panic(commitErr.Err)
+}
+
 func WithTransaction[Request any, TService any](ctx context.Context, params Params[Request, TService]) error {
 	conn, err := params.DbPool.Acquire(ctx) // get the current connection from pool
 
@@ -51,6 +83,8 @@ func WithTransaction[Request any, TService any](ctx context.Context, params Para
 	fn := instance
 
 	if err := fn.Handler(ctx, params.Request); err != nil && err != ErrNoRows {
+		commitAndPanic(ctx, tx, err)
+
 		tx.Rollback(ctx)
 		slog.Error("Transaction error, rolling back...", err.Error(), nil)
 
@@ -82,6 +116,8 @@ func WithTransactionRs[TRequest any, TResponse any, TService any](ctx context.Co
 	response, err := fn.Handler(ctx, params.Request)
 
 	if err != nil && err != ErrNoRows {
+		commitAndPanic(ctx, tx, err)
+
 		tx.Rollback(ctx)
 		slog.Error("Transaction error, rolling back...", err.Error(), nil)
 
@@ -38,6 +38,7 @@ func (r UserCredentialsRepository) GetUserCredentialsByUserID(ctx context.Contex
 		PasswordAlgorithm: userCredentials.PasswordAlgorithm,
 		PasswordHash:      userCredentials.PasswordHash,
 		ShouldChangePass:  userCredentials.ShouldChangePass,
+		PasswordChangedAt: userCredentials.PasswordChangedAt.Time,
 		CreatedAt:         userCredentials.CreatedAt.Time,
 		UpdatedAt:         userCredentials.UpdatedAt,
 	}, nil
@@ -50,6 +51,7 @@ func (r UserCredentialsRepository) AddUserCredentials(ctx context.Context, userC
 		PasswordAlgorithm: userCredentials.PasswordAlgorithm,
 		PasswordHash:      userCredentials.PasswordHash,
 		ShouldChangePass:  userCredentials.ShouldChangePass,
+		PasswordChangedAt: pgtype.Timestamp{Time: userCredentials.PasswordChangedAt, Valid: true},
 		CreatedAt:         pgtype.Timestamp{Time: userCredentials.CreatedAt, Valid: true},
 		UpdatedAt:         userCredentials.UpdatedAt,
 	})
@@ -61,6 +63,7 @@ func (r UserCredentialsRepository) UpdateUserCredentials(ctx context.Context, us
 		PasswordHash:      userCredentials.PasswordHash,
 		PasswordAlgorithm: userCredentials.PasswordAlgorithm,
 		ShouldChangePass:  userCredentials.ShouldChangePass,
+		PasswordChangedAt: pgtype.Timestamp{Time: userCredentials.PasswordChangedAt, Valid: true},
 		UpdatedAt:         userCredentials.UpdatedAt,
 	})
 }
@@ -0,0 +1,162 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IUserImportJobRepository defines all operations related to the UserImportJob entity.
+type IUserImportJobRepository interface {
+	AddUserImportJob(ctx context.Context, job *entities.UserImportJob) (bool, error)
+	UpdateUserImportJob(ctx context.Context, job *entities.UserImportJob) error
+	TouchUserImportJob(ctx context.Context, jobID uuid.UUID, now time.Time) error
+	FailInterruptedUserImportJobs(ctx context.Context, now, staleBefore time.Time) error
+	GetUserImportJobByID(ctx context.Context, tenantID, jobID uuid.UUID) (*entities.UserImportJob, error)
+	GetUserImportJobByIdempotencyKey(ctx context.Context, tenantID uuid.UUID, idempotencyKey string) (*entities.UserImportJob, error)
+}
+
+// UserImportJobRepository is the shared implementation for UserImportJob-related DB operations.
+type UserImportJobRepository struct {
+	Store *pgstore.Queries
+}
+
+// AddUserImportJob stores the job unless the tenant already has one with the
+// same idempotency key, reporting whether it was added.
+func (r UserImportJobRepository) AddUserImportJob(ctx context.Context, job *entities.UserImportJob) (bool, error) {
+	_, err := r.Store.AddUserImportJob(ctx, pgstore.AddUserImportJobParams{
+		ID:             job.ID,
+		TenantID:       job.TenantID,
+		IdempotencyKey: job.IdempotencyKey,
+		Format:         job.Format,
+		DryRun:         job.DryRun,
+		Status:         job.Status,
+		TotalRows:      int32(job.TotalRows),
+		CreatedAt:      pgtype.Timestamp{Time: job.CreatedAt, Valid: true},
+	})
+
+	if err == ErrNoRows {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// UpdateUserImportJob saves the job's progress, which also refreshes its heartbeat.
+func (r UserImportJobRepository) UpdateUserImportJob(ctx context.Context, job *entities.UserImportJob) error {
+	storedErrors := make([]userImportRowError, 0, len(job.Errors))
+	for _, rowError := range job.Errors {
+		storedErrors = append(storedErrors, userImportRowError(rowError))
+	}
+
+	rowErrors, err := json.Marshal(storedErrors)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	return r.Store.UpdateUserImportJob(ctx, pgstore.UpdateUserImportJobParams{
+		ID:            job.ID,
+		Status:        job.Status,
+		ProcessedRows: int32(job.ProcessedRows),
+		ImportedRows:  int32(job.ImportedRows),
+		SkippedRows:   int32(job.SkippedRows),
+		FailedRows:    int32(job.FailedRows),
+		Errors:        rowErrors,
+		CompletedAt:   job.CompletedAt,
+		HeartbeatAt:   &now,
+	})
+}
+
+// TouchUserImportJob refreshes the heartbeat of a job this instance is processing.
+func (r UserImportJobRepository) TouchUserImportJob(ctx context.Context, jobID uuid.UUID, now time.Time) error {
+	return r.Store.TouchUserImportJob(ctx, pgstore.TouchUserImportJobParams{
+		HeartbeatAt: &now,
+		ID:          jobID,
+	})
+}
+
+// FailInterruptedUserImportJobs fails the pending and running jobs whose
+// heartbeat is older than staleBefore.
+func (r UserImportJobRepository) FailInterruptedUserImportJobs(ctx context.Context, now, staleBefore time.Time) error {
+	return r.Store.FailInterruptedUserImportJobs(ctx, pgstore.FailInterruptedUserImportJobsParams{
+		CompletedAt: &now,
+		StaleBefore: pgtype.Timestamp{Time: staleBefore, Valid: true},
+	})
+}
+
+func (r UserImportJobRepository) GetUserImportJobByID(ctx context.Context, tenantID, jobID uuid.UUID) (*entities.UserImportJob, error) {
+	job, err := r.Store.GetUserImportJobByID(ctx, pgstore.GetUserImportJobByIDParams{
+		ID:       jobID,
+		TenantID: tenantID,
+	})
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mapUserImportJob(job)
+}
+
+func (r UserImportJobRepository) GetUserImportJobByIdempotencyKey(ctx context.Context, tenantID uuid.UUID, idempotencyKey string) (*entities.UserImportJob, error) {
+	job, err := r.Store.GetUserImportJobByIdempotencyKey(ctx, pgstore.GetUserImportJobByIdempotencyKeyParams{
+		TenantID:       tenantID,
+		IdempotencyKey: idempotencyKey,
+	})
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mapUserImportJob(job)
+}
+
+// userImportRowError is how a row error is stored in the errors JSONB column.
+type userImportRowError struct {
+	Row   int    `json:"row"`
+	Email string `json:"email,omitempty"`
+	Error string `json:"error"`
+}
+
+func mapUserImportJob(row pgstore.UserImportJob) (*entities.UserImportJob, error) {
+	storedErrors := []userImportRowError{}
+	if err := json.Unmarshal(row.Errors, &storedErrors); err != nil {
+		return nil, err
+	}
+
+	rowErrors := make([]entities.UserImportRowError, 0, len(storedErrors))
+	for _, rowError := range storedErrors {
+		rowErrors = append(rowErrors, entities.UserImportRowError(rowError))
+	}
+
+	return &entities.UserImportJob{
+		ID:             row.ID,
+		TenantID:       row.TenantID,
+		IdempotencyKey: row.IdempotencyKey,
+		Format:         row.Format,
+		DryRun:         row.DryRun,
+		Status:         row.Status,
+		TotalRows:      int(row.TotalRows),
+		ProcessedRows:  int(row.ProcessedRows),
+		ImportedRows:   int(row.ImportedRows),
+		SkippedRows:    int(row.SkippedRows),
+		FailedRows:     int(row.FailedRows),
+		Errors:         rowErrors,
+		CreatedAt:      row.CreatedAt.Time,
+		CompletedAt:    row.CompletedAt,
+	}, nil
+}
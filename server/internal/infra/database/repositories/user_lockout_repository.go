@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/gate-keeper/internal/domain/entities"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IUserLockoutRepository defines all operations related to the UserLockout entity.
+type IUserLockoutRepository interface {
+	GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error)
+	UpsertUserLockout(ctx context.Context, lockout *entities.UserLockout) error
+	RemoveUserLockout(ctx context.Context, userID uuid.UUID) error
+}
+
+// UserLockoutRepository is the shared implementation for UserLockout-related DB operations.
+type UserLockoutRepository struct {
+	Store *pgstore.Queries
+}
+
+// GetUserLockoutByUserID returns nil when the user has no failed logins.
+func (r UserLockoutRepository) GetUserLockoutByUserID(ctx context.Context, userID uuid.UUID) (*entities.UserLockout, error) {
+	lockout, err := r.Store.GetUserLockoutByUserID(ctx, userID)
+
+	if err == ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.UserLockout{
+		UserID:         lockout.UserID,
+		ApplicationID:  lockout.ApplicationID,
+		FailedAttempts: int(lockout.FailedAttempts),
+		LockoutCount:   int(lockout.LockoutCount),
+		LastFailedAt:   lockout.LastFailedAt,
+		LockedUntil:    lockout.LockedUntil,
+		UpdatedAt:      lockout.UpdatedAt.Time,
+	}, nil
+}
+
+func (r UserLockoutRepository) UpsertUserLockout(ctx context.Context, lockout *entities.UserLockout) error {
+	return r.Store.UpsertUserLockout(ctx, pgstore.UpsertUserLockoutParams{
+		UserID:         lockout.UserID,
+		ApplicationID:  lockout.ApplicationID,
+		FailedAttempts: int32(lockout.FailedAttempts),
+		LockoutCount:   int32(lockout.LockoutCount),
+		LastFailedAt:   lockout.LastFailedAt,
+		LockedUntil:    lockout.LockedUntil,
+		UpdatedAt:      pgtype.Timestamp{Time: lockout.UpdatedAt, Valid: true},
+	})
+}
+
+func (r UserLockoutRepository) RemoveUserLockout(ctx context.Context, userID uuid.UUID) error {
+	return r.Store.RemoveUserLockout(ctx, userID)
+}
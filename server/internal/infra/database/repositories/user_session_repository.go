@@ -12,10 +12,12 @@ import (
 // IUserSessionRepository defines all operations related to UserSession entities.
 type IUserSessionRepository interface {
 	AddUserSession(ctx context.Context, session *entities.UserSession) error
+	IsUserSessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error)
 	GetActiveUserSessions(ctx context.Context, userID uuid.UUID) ([]*entities.UserSession, error)
 	GetUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) (*entities.UserSession, error)
 	RevokeUserSessionByID(ctx context.Context, sessionID, userID uuid.UUID) error
 	RevokeAllUserSessions(ctx context.Context, userID uuid.UUID) error
+	RevokeUserSessionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) error
 	UpdateUserSessionLastActive(ctx context.Context, sessionID uuid.UUID) error
 }
 
@@ -39,6 +41,11 @@ func (r UserSessionRepository) AddUserSession(ctx context.Context, session *enti
 	})
 }
 
+// IsUserSessionActive reports whether the session exists and was not revoked.
+func (r UserSessionRepository) IsUserSessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	return r.Store.CheckIfUserSessionActive(ctx, sessionID)
+}
+
 func (r UserSessionRepository) GetActiveUserSessions(ctx context.Context, userID uuid.UUID) ([]*entities.UserSession, error) {
 	rows, err := r.Store.GetActiveUserSessions(ctx, userID)
 	if err != nil {
@@ -101,6 +108,13 @@ func (r UserSessionRepository) RevokeAllUserSessions(ctx context.Context, userID
 	return r.Store.RevokeAllUserSessions(ctx, userID)
 }
 
+func (r UserSessionRepository) RevokeUserSessionsFromApplication(ctx context.Context, userID, applicationID uuid.UUID) error {
+	return r.Store.RevokeUserSessionsFromApplication(ctx, pgstore.RevokeUserSessionsFromApplicationParams{
+		UserID:        userID,
+		ApplicationID: applicationID,
+	})
+}
+
 func (r UserSessionRepository) UpdateUserSessionLastActive(ctx context.Context, sessionID uuid.UUID) error {
 	return r.Store.UpdateUserSessionLastActive(ctx, sessionID)
 }
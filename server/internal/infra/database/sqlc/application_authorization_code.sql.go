@@ -24,7 +24,9 @@ INSERT INTO
         code_challenge,
         code_challenge_method,
         nonce,
-        scope
+        scope,
+        ip_address,
+        user_agent
     )
 VALUES
     (
@@ -37,7 +39,9 @@ VALUES
         $7,
         $8,
         $9,
-        $10
+        $10,
+        $11,
+        $12
     )
 `
 
@@ -52,6 +56,8 @@ type AddAuthorizationCodeParams struct {
 	CodeChallengeMethod string           `db:"code_challenge_method"`
 	Nonce               *string          `db:"nonce"`
 	Scope               *string          `db:"scope"`
+	IpAddress           *string          `db:"ip_address"`
+	UserAgent           *string          `db:"user_agent"`
 }
 
 // ----------------------------------COMMANDS--------------------------------------
@@ -68,6 +74,8 @@ func (q *Queries) AddAuthorizationCode(ctx context.Context, arg AddAuthorization
 		arg.CodeChallengeMethod,
 		arg.Nonce,
 		arg.Scope,
+		arg.IpAddress,
+		arg.UserAgent,
 	)
 	return err
 }
@@ -83,7 +91,9 @@ SELECT
     code_challenge,
     code_challenge_method,
     nonce,
-    scope
+    scope,
+    ip_address,
+    user_agent
 FROM
     application_authorization_code
 WHERE
@@ -106,6 +116,8 @@ func (q *Queries) GetAuthorizationCodeById(ctx context.Context, id uuid.UUID) (A
 		&i.CodeChallengeMethod,
 		&i.Nonce,
 		&i.Scope,
+		&i.IpAddress,
+		&i.UserAgent,
 	)
 	return i, err
 }
@@ -57,6 +57,41 @@ func (q *Queries) AddRole(ctx context.Context, arg AddRoleParams) error {
 	return err
 }
 
+const getRoleByID = `-- name: GetRoleByID :one
+SELECT
+    id,
+    application_id,
+    name,
+    description,
+    created_at,
+    updated_at
+FROM
+    application_role
+WHERE
+    id = $1
+    AND application_id = $2
+`
+
+type GetRoleByIDParams struct {
+	ID            uuid.UUID `db:"id"`
+	ApplicationID uuid.UUID `db:"application_id"`
+}
+
+// ----------------------------------QUERIES---------------------------------------
+func (q *Queries) GetRoleByID(ctx context.Context, arg GetRoleByIDParams) (ApplicationRole, error) {
+	row := q.db.QueryRow(ctx, getRoleByID, arg.ID, arg.ApplicationID)
+	var i ApplicationRole
+	err := row.Scan(
+		&i.ID,
+		&i.ApplicationID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const listRolesFromApplication = `-- name: ListRolesFromApplication :many
 SELECT
     id,
@@ -71,7 +106,6 @@ WHERE
     application_id = $1
 `
 
-// ----------------------------------QUERIES---------------------------------------
 // List Roles from Application
 func (q *Queries) ListRolesFromApplication(ctx context.Context, applicationID uuid.UUID) ([]ApplicationRole, error) {
 	rows, err := q.db.Query(ctx, listRolesFromApplication, applicationID)
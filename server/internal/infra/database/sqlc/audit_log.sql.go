@@ -7,6 +7,7 @@ package pgstore
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -16,6 +17,7 @@ const addAuditLog = `-- name: AddAuditLog :exec
 INSERT INTO
   audit_log (
     id,
+    tenant_id,
     user_id,
     application_id,
     event_type,
@@ -23,7 +25,10 @@ INSERT INTO
     user_agent,
     result,
     details,
-    created_at
+    created_at,
+    chain_sequence,
+    previous_hash,
+    hash
   )
 VALUES
   (
@@ -35,26 +40,35 @@ VALUES
     $6,
     $7,
     $8,
-    $9
+    $9,
+    $10,
+    $11,
+    $12,
+    $13
   )
 `
 
 type AddAuditLogParams struct {
 	ID            uuid.UUID        `db:"id"`
-	UserID        uuid.UUID        `db:"user_id"`
-	ApplicationID uuid.UUID        `db:"application_id"`
+	TenantID      uuid.UUID        `db:"tenant_id"`
+	UserID        *uuid.UUID       `db:"user_id"`
+	ApplicationID *uuid.UUID       `db:"application_id"`
 	EventType     string           `db:"event_type"`
 	IpAddress     string           `db:"ip_address"`
 	UserAgent     string           `db:"user_agent"`
 	Result        string           `db:"result"`
 	Details       *string          `db:"details"`
 	CreatedAt     pgtype.Timestamp `db:"created_at"`
+	ChainSequence int64            `db:"chain_sequence"`
+	PreviousHash  string           `db:"previous_hash"`
+	Hash          string           `db:"hash"`
 }
 
 // ----------------------------------COMMANDS--------------------------------------
 func (q *Queries) AddAuditLog(ctx context.Context, arg AddAuditLogParams) error {
 	_, err := q.db.Exec(ctx, addAuditLog,
 		arg.ID,
+		arg.TenantID,
 		arg.UserID,
 		arg.ApplicationID,
 		arg.EventType,
@@ -63,10 +77,39 @@ func (q *Queries) AddAuditLog(ctx context.Context, arg AddAuditLogParams) error
 		arg.Result,
 		arg.Details,
 		arg.CreatedAt,
+		arg.ChainSequence,
+		arg.PreviousHash,
+		arg.Hash,
 	)
 	return err
 }
 
+const getAuditLogChainHead = `-- name: GetAuditLogChainHead :one
+SELECT
+  tenant_id,
+  chain_sequence,
+  audit_log_id,
+  hash,
+  updated_at
+FROM
+  audit_log_chain_head
+WHERE
+  tenant_id = $1
+`
+
+func (q *Queries) GetAuditLogChainHead(ctx context.Context, tenantID uuid.UUID) (AuditLogChainHead, error) {
+	row := q.db.QueryRow(ctx, getAuditLogChainHead, tenantID)
+	var i AuditLogChainHead
+	err := row.Scan(
+		&i.TenantID,
+		&i.ChainSequence,
+		&i.AuditLogID,
+		&i.Hash,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getAuditLogsByUserID = `-- name: GetAuditLogsByUserID :many
 SELECT
   id,
@@ -77,7 +120,11 @@ SELECT
   user_agent,
   result,
   details,
-  created_at
+  created_at,
+  tenant_id,
+  chain_sequence,
+  previous_hash,
+  hash
 FROM
   audit_log
 WHERE
@@ -114,6 +161,247 @@ func (q *Queries) GetAuditLogsByUserID(ctx context.Context, arg GetAuditLogsByUs
 			&i.Result,
 			&i.Details,
 			&i.CreatedAt,
+			&i.TenantID,
+			&i.ChainSequence,
+			&i.PreviousHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditLogChain = `-- name: ListAuditLogChain :many
+SELECT
+  id,
+  user_id,
+  application_id,
+  event_type,
+  ip_address,
+  user_agent,
+  result,
+  details,
+  created_at,
+  tenant_id,
+  chain_sequence,
+  previous_hash,
+  hash
+FROM
+  audit_log
+WHERE
+  tenant_id = $1
+  AND chain_sequence > $2
+ORDER BY
+  chain_sequence ASC
+LIMIT
+  $3
+`
+
+type ListAuditLogChainParams struct {
+	TenantID      uuid.UUID `db:"tenant_id"`
+	AfterSequence int64     `db:"after_sequence"`
+	Limit         int32     `db:"limit"`
+}
+
+// Chained entries of a tenant in chain order, starting after after_sequence
+func (q *Queries) ListAuditLogChain(ctx context.Context, arg ListAuditLogChainParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditLogChain,
+		arg.TenantID,
+		arg.AfterSequence,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ApplicationID,
+			&i.EventType,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Result,
+			&i.Details,
+			&i.CreatedAt,
+			&i.TenantID,
+			&i.ChainSequence,
+			&i.PreviousHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditLogChainHeadsToCheckpoint = `-- name: ListAuditLogChainHeadsToCheckpoint :many
+SELECT
+  h.tenant_id,
+  h.chain_sequence,
+  h.audit_log_id,
+  h.hash,
+  h.updated_at
+FROM
+  audit_log_chain_head h
+WHERE
+  h.chain_sequence > COALESCE(
+    (
+      SELECT
+        MAX(c.chain_sequence)
+      FROM
+        audit_log_checkpoint c
+      WHERE
+        c.tenant_id = h.tenant_id
+    ),
+    0
+  )
+`
+
+// Chain heads that moved since the last checkpoint of their tenant
+func (q *Queries) ListAuditLogChainHeadsToCheckpoint(ctx context.Context) ([]AuditLogChainHead, error) {
+	rows, err := q.db.Query(ctx, listAuditLogChainHeadsToCheckpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLogChainHead
+	for rows.Next() {
+		var i AuditLogChainHead
+		if err := rows.Scan(
+			&i.TenantID,
+			&i.ChainSequence,
+			&i.AuditLogID,
+			&i.Hash,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantAuditLogs = `-- name: ListTenantAuditLogs :many
+SELECT
+  al.id,
+  al.user_id,
+  al.application_id,
+  al.event_type,
+  al.ip_address,
+  al.user_agent,
+  al.result,
+  al.details,
+  al.created_at,
+  al.tenant_id,
+  al.chain_sequence,
+  al.previous_hash,
+  al.hash
+FROM
+  audit_log al
+WHERE
+  al.tenant_id = $1
+  AND (
+    $2 :: uuid IS NULL
+    OR al.user_id = $2
+  )
+  AND (
+    $3 :: uuid IS NULL
+    OR al.application_id = $3
+  )
+  AND (
+    $4 :: text IS NULL
+    OR al.event_type = $4
+  )
+  AND (
+    $5 :: text IS NULL
+    OR al.result = $5
+  )
+  AND (
+    $6 :: text IS NULL
+    OR al.ip_address = $6
+  )
+  AND (
+    $7 :: timestamp IS NULL
+    OR al.created_at >= $7
+  )
+  AND (
+    $8 :: timestamp IS NULL
+    OR al.created_at < $8
+  )
+  AND (
+    $9 :: uuid IS NULL
+    OR al.id < $9
+  )
+ORDER BY
+  al.id DESC
+LIMIT
+  $10
+`
+
+type ListTenantAuditLogsParams struct {
+	TenantID      uuid.UUID  `db:"tenant_id"`
+	UserID        *uuid.UUID `db:"user_id"`
+	ApplicationID *uuid.UUID `db:"application_id"`
+	EventType     *string    `db:"event_type"`
+	Result        *string    `db:"result"`
+	IpAddress     *string    `db:"ip_address"`
+	CreatedFrom   *time.Time `db:"created_from"`
+	CreatedTo     *time.Time `db:"created_to"`
+	BeforeID      *uuid.UUID `db:"before_id"`
+	Limit         int32      `db:"limit"`
+}
+
+// Keyset-paged audit log of a tenant, newest first; null filters match every entry
+func (q *Queries) ListTenantAuditLogs(ctx context.Context, arg ListTenantAuditLogsParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, listTenantAuditLogs,
+		arg.TenantID,
+		arg.UserID,
+		arg.ApplicationID,
+		arg.EventType,
+		arg.Result,
+		arg.IpAddress,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.BeforeID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ApplicationID,
+			&i.EventType,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Result,
+			&i.Details,
+			&i.CreatedAt,
+			&i.TenantID,
+			&i.ChainSequence,
+			&i.PreviousHash,
+			&i.Hash,
 		); err != nil {
 			return nil, err
 		}
@@ -124,3 +412,85 @@ func (q *Queries) GetAuditLogsByUserID(ctx context.Context, arg GetAuditLogsByUs
 	}
 	return items, nil
 }
+
+const lockAuditLogChainHead = `-- name: LockAuditLogChainHead :one
+INSERT INTO
+  audit_log_chain_head (
+    tenant_id,
+    chain_sequence,
+    audit_log_id,
+    hash,
+    updated_at
+  )
+VALUES
+  (
+    $1,
+    0,
+    NULL,
+    $2,
+    $3
+  ) ON CONFLICT (tenant_id) DO
+UPDATE
+SET
+  tenant_id = EXCLUDED.tenant_id RETURNING tenant_id,
+  chain_sequence,
+  audit_log_id,
+  hash,
+  updated_at
+`
+
+type LockAuditLogChainHeadParams struct {
+	TenantID    uuid.UUID        `db:"tenant_id"`
+	GenesisHash string           `db:"genesis_hash"`
+	UpdatedAt   pgtype.Timestamp `db:"updated_at"`
+}
+
+// Starts the chain of the tenant when it has none, and locks its head until
+// the transaction ends
+func (q *Queries) LockAuditLogChainHead(ctx context.Context, arg LockAuditLogChainHeadParams) (AuditLogChainHead, error) {
+	row := q.db.QueryRow(ctx, lockAuditLogChainHead,
+		arg.TenantID,
+		arg.GenesisHash,
+		arg.UpdatedAt,
+	)
+	var i AuditLogChainHead
+	err := row.Scan(
+		&i.TenantID,
+		&i.ChainSequence,
+		&i.AuditLogID,
+		&i.Hash,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateAuditLogChainHead = `-- name: UpdateAuditLogChainHead :exec
+UPDATE
+  audit_log_chain_head
+SET
+  chain_sequence = $1,
+  audit_log_id = $2,
+  hash = $3,
+  updated_at = $4
+WHERE
+  tenant_id = $5
+`
+
+type UpdateAuditLogChainHeadParams struct {
+	ChainSequence int64            `db:"chain_sequence"`
+	AuditLogID    *uuid.UUID       `db:"audit_log_id"`
+	Hash          string           `db:"hash"`
+	UpdatedAt     pgtype.Timestamp `db:"updated_at"`
+	TenantID      uuid.UUID        `db:"tenant_id"`
+}
+
+func (q *Queries) UpdateAuditLogChainHead(ctx context.Context, arg UpdateAuditLogChainHeadParams) error {
+	_, err := q.db.Exec(ctx, updateAuditLogChainHead,
+		arg.ChainSequence,
+		arg.AuditLogID,
+		arg.Hash,
+		arg.UpdatedAt,
+		arg.TenantID,
+	)
+	return err
+}
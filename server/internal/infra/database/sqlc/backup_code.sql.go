@@ -142,3 +142,29 @@ func (q *Queries) MarkBackupCodeUsed(ctx context.Context, id uuid.UUID) error {
 	_, err := q.db.Exec(ctx, markBackupCodeUsed, id)
 	return err
 }
+
+const useBackupCode = `-- name: UseBackupCode :one
+UPDATE
+  backup_code
+SET
+  is_used = TRUE,
+  used_at = NOW()
+WHERE
+  user_id = $1
+  AND code_hash = $2
+  AND is_used = FALSE
+RETURNING
+  id
+`
+
+type UseBackupCodeParams struct {
+	UserID   uuid.UUID `db:"user_id"`
+	CodeHash string    `db:"code_hash"`
+}
+
+func (q *Queries) UseBackupCode(ctx context.Context, arg UseBackupCodeParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, useBackupCode, arg.UserID, arg.CodeHash)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
@@ -67,6 +67,39 @@ func (q *Queries) DeleteMfaEmailCode(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const getLatestMfaEmailCodeByMfaMethodID = `-- name: GetLatestMfaEmailCodeByMfaMethodID :one
+SELECT
+    id,
+    mfa_method_id,
+    token,
+    created_at,
+    expires_at,
+    verified
+FROM
+    mfa_email_code
+WHERE
+    mfa_method_id = $1
+ORDER BY
+    created_at DESC
+LIMIT
+    1
+`
+
+// ----------------------------------QUERIES--------------------------------------
+func (q *Queries) GetLatestMfaEmailCodeByMfaMethodID(ctx context.Context, mfaMethodID uuid.UUID) (MfaEmailCode, error) {
+	row := q.db.QueryRow(ctx, getLatestMfaEmailCodeByMfaMethodID, mfaMethodID)
+	var i MfaEmailCode
+	err := row.Scan(
+		&i.ID,
+		&i.MfaMethodID,
+		&i.Token,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.Verified,
+	)
+	return i, err
+}
+
 const getMfaEmailCodeByToken = `-- name: GetMfaEmailCodeByToken :one
 SELECT
     id,
@@ -87,7 +120,6 @@ type GetMfaEmailCodeByTokenParams struct {
 	Token       string    `db:"token"`
 }
 
-// ----------------------------------QUERIES--------------------------------------
 func (q *Queries) GetMfaEmailCodeByToken(ctx context.Context, arg GetMfaEmailCodeByTokenParams) (MfaEmailCode, error) {
 	row := q.db.QueryRow(ctx, getMfaEmailCodeByToken, arg.MfaMethodID, arg.Token)
 	var i MfaEmailCode
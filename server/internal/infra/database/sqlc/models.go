@@ -40,6 +40,21 @@ type ApplicationAuthorizationCode struct {
 	CodeChallengeMethod string           `db:"code_challenge_method"`
 	Nonce               *string          `db:"nonce"`
 	Scope               *string          `db:"scope"`
+	IpAddress           *string          `db:"ip_address"`
+	UserAgent           *string          `db:"user_agent"`
+}
+
+type ApplicationClientCredential struct {
+	ApplicationID uuid.UUID        `db:"application_id"`
+	Scopes        []string         `db:"scopes"`
+	CreatedAt     pgtype.Timestamp `db:"created_at"`
+	UpdatedAt     *time.Time       `db:"updated_at"`
+}
+
+type ApplicationClientRole struct {
+	ApplicationID uuid.UUID        `db:"application_id"`
+	RoleID        uuid.UUID        `db:"role_id"`
+	CreatedAt     pgtype.Timestamp `db:"created_at"`
 }
 
 type ApplicationMailConfig struct {
@@ -65,6 +80,23 @@ type ApplicationOauthProvider struct {
 	Enabled       bool             `db:"enabled"`
 }
 
+type ApplicationPermission struct {
+	ID            uuid.UUID        `db:"id"`
+	ApplicationID uuid.UUID        `db:"application_id"`
+	Name          string           `db:"name"`
+	Description   *string          `db:"description"`
+	CreatedAt     pgtype.Timestamp `db:"created_at"`
+	UpdatedAt     *time.Time       `db:"updated_at"`
+}
+
+type ApplicationRedirectUri struct {
+	ID            uuid.UUID        `db:"id"`
+	ApplicationID uuid.UUID        `db:"application_id"`
+	Uri           string           `db:"uri"`
+	Kind          string           `db:"kind"`
+	CreatedAt     pgtype.Timestamp `db:"created_at"`
+}
+
 type ApplicationRole struct {
 	ID            uuid.UUID        `db:"id"`
 	ApplicationID uuid.UUID        `db:"application_id"`
@@ -87,14 +119,51 @@ type ApplicationSecret struct {
 
 type AuditLog struct {
 	ID            uuid.UUID        `db:"id"`
-	UserID        uuid.UUID        `db:"user_id"`
-	ApplicationID uuid.UUID        `db:"application_id"`
+	UserID        *uuid.UUID       `db:"user_id"`
+	ApplicationID *uuid.UUID       `db:"application_id"`
 	EventType     string           `db:"event_type"`
 	IpAddress     string           `db:"ip_address"`
 	UserAgent     string           `db:"user_agent"`
 	Result        string           `db:"result"`
 	Details       *string          `db:"details"`
 	CreatedAt     pgtype.Timestamp `db:"created_at"`
+	TenantID      uuid.UUID        `db:"tenant_id"`
+	ChainSequence int64            `db:"chain_sequence"`
+	PreviousHash  string           `db:"previous_hash"`
+	Hash          string           `db:"hash"`
+}
+
+type AuditLogChainHead struct {
+	TenantID      uuid.UUID        `db:"tenant_id"`
+	ChainSequence int64            `db:"chain_sequence"`
+	AuditLogID    *uuid.UUID       `db:"audit_log_id"`
+	Hash          string           `db:"hash"`
+	UpdatedAt     pgtype.Timestamp `db:"updated_at"`
+}
+
+type AuditLogCheckpoint struct {
+	ID            uuid.UUID        `db:"id"`
+	TenantID      uuid.UUID        `db:"tenant_id"`
+	ChainSequence int64            `db:"chain_sequence"`
+	AuditLogID    uuid.UUID        `db:"audit_log_id"`
+	Hash          string           `db:"hash"`
+	Kid           string           `db:"kid"`
+	Signature     string           `db:"signature"`
+	CreatedAt     pgtype.Timestamp `db:"created_at"`
+}
+
+type AuthorizationRequest struct {
+	ID                  uuid.UUID        `db:"id"`
+	ApplicationID       uuid.UUID        `db:"application_id"`
+	RedirectUri         string           `db:"redirect_uri"`
+	ResponseType        string           `db:"response_type"`
+	Scope               string           `db:"scope"`
+	State               *string          `db:"state"`
+	Nonce               *string          `db:"nonce"`
+	CodeChallenge       string           `db:"code_challenge"`
+	CodeChallengeMethod string           `db:"code_challenge_method"`
+	ExpiresAt           pgtype.Timestamp `db:"expires_at"`
+	CreatedAt           pgtype.Timestamp `db:"created_at"`
 }
 
 type AuthorizationSession struct {
@@ -225,6 +294,13 @@ type MfaTotpSecretValidation struct {
 	ExpiresAt   pgtype.Timestamp `db:"expires_at"`
 }
 
+type PasswordHistory struct {
+	ID           uuid.UUID        `db:"id"`
+	UserID       uuid.UUID        `db:"user_id"`
+	PasswordHash string           `db:"password_hash"`
+	CreatedAt    pgtype.Timestamp `db:"created_at"`
+}
+
 type PasswordResetToken struct {
 	ID        uuid.UUID        `db:"id"`
 	UserID    uuid.UUID        `db:"user_id"`
@@ -233,13 +309,49 @@ type PasswordResetToken struct {
 	ExpiresAt pgtype.Timestamp `db:"expires_at"`
 }
 
-type RefreshToken struct {
-	ID        uuid.UUID        `db:"id"`
+type PlatformAdmin struct {
 	UserID    uuid.UUID        `db:"user_id"`
-	ExpiresAt pgtype.Timestamp `db:"expires_at"`
 	CreatedAt pgtype.Timestamp `db:"created_at"`
 }
 
+type RefreshToken struct {
+	ID            uuid.UUID        `db:"id"`
+	UserID        uuid.UUID        `db:"user_id"`
+	ExpiresAt     pgtype.Timestamp `db:"expires_at"`
+	CreatedAt     pgtype.Timestamp `db:"created_at"`
+	FamilyID      uuid.UUID        `db:"family_id"`
+	ApplicationID *uuid.UUID       `db:"application_id"`
+	SessionID     *uuid.UUID       `db:"session_id"`
+	Scope         *string          `db:"scope"`
+	UsedAt        *time.Time       `db:"used_at"`
+	RevokedAt     *time.Time       `db:"revoked_at"`
+}
+
+type RevokedAccessToken struct {
+	Jti       string           `db:"jti"`
+	ExpiresAt pgtype.Timestamp `db:"expires_at"`
+	RevokedAt pgtype.Timestamp `db:"revoked_at"`
+}
+
+type RolePermission struct {
+	RoleID       uuid.UUID        `db:"role_id"`
+	PermissionID uuid.UUID        `db:"permission_id"`
+	CreatedAt    pgtype.Timestamp `db:"created_at"`
+}
+
+type SigningKey struct {
+	ID          uuid.UUID        `db:"id"`
+	TenantID    uuid.UUID        `db:"tenant_id"`
+	Kid         string           `db:"kid"`
+	Algorithm   string           `db:"algorithm"`
+	PrivateKey  string           `db:"private_key"`
+	Status      string           `db:"status"`
+	CreatedAt   pgtype.Timestamp `db:"created_at"`
+	ActivatedAt *time.Time       `db:"activated_at"`
+	RetiredAt   *time.Time       `db:"retired_at"`
+	ExpiresAt   *time.Time       `db:"expires_at"`
+}
+
 type StepUpToken struct {
 	ID            uuid.UUID        `db:"id"`
 	UserID        uuid.UUID        `db:"user_id"`
@@ -251,12 +363,36 @@ type StepUpToken struct {
 }
 
 type Tenant struct {
-	ID                 uuid.UUID        `db:"id"`
-	Name               string           `db:"name"`
-	Description        *string          `db:"description"`
-	PasswordHashSecret string           `db:"password_hash_secret"`
-	CreatedAt          pgtype.Timestamp `db:"created_at"`
-	UpdatedAt          *time.Time       `db:"updated_at"`
+	ID                         uuid.UUID        `db:"id"`
+	Name                       string           `db:"name"`
+	Description                *string          `db:"description"`
+	PasswordHashSecret         string           `db:"password_hash_secret"`
+	PreviousPasswordHashSecret string           `db:"previous_password_hash_secret"`
+	PasswordHashMemory         int32            `db:"password_hash_memory"`
+	PasswordHashIterations     int32            `db:"password_hash_iterations"`
+	PasswordHashParallelism    int32            `db:"password_hash_parallelism"`
+	PasswordMinLength          int32            `db:"password_min_length"`
+	PasswordRequireUppercase   bool             `db:"password_require_uppercase"`
+	PasswordRequireLowercase   bool             `db:"password_require_lowercase"`
+	PasswordRequireDigit       bool             `db:"password_require_digit"`
+	PasswordRequireSymbol      bool             `db:"password_require_symbol"`
+	PasswordMaxAgeDays         int32            `db:"password_max_age_days"`
+	PasswordHistoryCount       int32            `db:"password_history_count"`
+	PasswordForbiddenWords     []string         `db:"password_forbidden_words"`
+	BreachedPasswordCheck      bool             `db:"breached_password_check"`
+	BreachedPasswordThreshold  int32            `db:"breached_password_threshold"`
+	LockoutThreshold           int32            `db:"lockout_threshold"`
+	LockoutDurationSeconds     int32            `db:"lockout_duration_seconds"`
+	LockoutMaxDurationSeconds  int32            `db:"lockout_max_duration_seconds"`
+	LockoutNotifyUser          bool             `db:"lockout_notify_user"`
+	CreatedAt                  pgtype.Timestamp `db:"created_at"`
+	UpdatedAt                  *time.Time       `db:"updated_at"`
+}
+
+type TenantAdmin struct {
+	TenantID  uuid.UUID        `db:"tenant_id"`
+	UserID    uuid.UUID        `db:"user_id"`
+	CreatedAt pgtype.Timestamp `db:"created_at"`
 }
 
 type TenantUser struct {
@@ -278,6 +414,35 @@ type UserCredential struct {
 	ShouldChangePass  bool             `db:"should_change_pass"`
 	UpdatedAt         *time.Time       `db:"updated_at"`
 	CreatedAt         pgtype.Timestamp `db:"created_at"`
+	PasswordChangedAt pgtype.Timestamp `db:"password_changed_at"`
+}
+
+type UserImportJob struct {
+	ID             uuid.UUID        `db:"id"`
+	TenantID       uuid.UUID        `db:"tenant_id"`
+	IdempotencyKey string           `db:"idempotency_key"`
+	Format         string           `db:"format"`
+	DryRun         bool             `db:"dry_run"`
+	Status         string           `db:"status"`
+	TotalRows      int32            `db:"total_rows"`
+	ProcessedRows  int32            `db:"processed_rows"`
+	ImportedRows   int32            `db:"imported_rows"`
+	SkippedRows    int32            `db:"skipped_rows"`
+	FailedRows     int32            `db:"failed_rows"`
+	Errors         []byte           `db:"errors"`
+	CreatedAt      pgtype.Timestamp `db:"created_at"`
+	CompletedAt    *time.Time       `db:"completed_at"`
+	HeartbeatAt    *time.Time       `db:"heartbeat_at"`
+}
+
+type UserLockout struct {
+	UserID         uuid.UUID        `db:"user_id"`
+	ApplicationID  uuid.UUID        `db:"application_id"`
+	FailedAttempts int32            `db:"failed_attempts"`
+	LockoutCount   int32            `db:"lockout_count"`
+	LastFailedAt   *time.Time       `db:"last_failed_at"`
+	LockedUntil    *time.Time       `db:"locked_until"`
+	UpdatedAt      pgtype.Timestamp `db:"updated_at"`
 }
 
 type UserProfile struct {
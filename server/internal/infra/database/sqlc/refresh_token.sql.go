@@ -7,6 +7,7 @@ package pgstore
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -18,7 +19,11 @@ INSERT INTO
         id,
         user_id,
         expires_at,
-        created_at
+        created_at,
+        family_id,
+        application_id,
+        session_id,
+        scope
     )
 VALUES
     (
@@ -28,15 +33,27 @@ VALUES
         -- user_id
         $3,
         -- expires_at
-        $4 -- created_at
+        $4,
+        -- created_at
+        $5,
+        -- family_id
+        $6,
+        -- application_id
+        $7,
+        -- session_id
+        $8 -- scope
     )
 `
 
 type AddRefreshTokenParams struct {
-	ID        uuid.UUID        `db:"id"`
-	UserID    uuid.UUID        `db:"user_id"`
-	ExpiresAt pgtype.Timestamp `db:"expires_at"`
-	CreatedAt pgtype.Timestamp `db:"created_at"`
+	ID            uuid.UUID        `db:"id"`
+	UserID        uuid.UUID        `db:"user_id"`
+	ExpiresAt     pgtype.Timestamp `db:"expires_at"`
+	CreatedAt     pgtype.Timestamp `db:"created_at"`
+	FamilyID      uuid.UUID        `db:"family_id"`
+	ApplicationID *uuid.UUID       `db:"application_id"`
+	SessionID     *uuid.UUID       `db:"session_id"`
+	Scope         *string          `db:"scope"`
 }
 
 // ----------------------------------COMMANDS--------------------------------------
@@ -46,16 +63,98 @@ func (q *Queries) AddRefreshToken(ctx context.Context, arg AddRefreshTokenParams
 		arg.UserID,
 		arg.ExpiresAt,
 		arg.CreatedAt,
+		arg.FamilyID,
+		arg.ApplicationID,
+		arg.SessionID,
+		arg.Scope,
 	)
 	return err
 }
 
+const getRefreshTokenByID = `-- name: GetRefreshTokenByID :one
+SELECT
+    id,
+    user_id,
+    expires_at,
+    created_at,
+    family_id,
+    application_id,
+    session_id,
+    scope,
+    used_at,
+    revoked_at
+FROM
+    refresh_token
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetRefreshTokenByID(ctx context.Context, id uuid.UUID) (RefreshToken, error) {
+	row := q.db.QueryRow(ctx, getRefreshTokenByID, id)
+	var i RefreshToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.FamilyID,
+		&i.ApplicationID,
+		&i.SessionID,
+		&i.Scope,
+		&i.UsedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getRefreshTokenByIDForUpdate = `-- name: GetRefreshTokenByIDForUpdate :one
+SELECT
+    id,
+    user_id,
+    expires_at,
+    created_at,
+    family_id,
+    application_id,
+    session_id,
+    scope,
+    used_at,
+    revoked_at
+FROM
+    refresh_token
+WHERE
+    id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetRefreshTokenByIDForUpdate(ctx context.Context, id uuid.UUID) (RefreshToken, error) {
+	row := q.db.QueryRow(ctx, getRefreshTokenByIDForUpdate, id)
+	var i RefreshToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.FamilyID,
+		&i.ApplicationID,
+		&i.SessionID,
+		&i.Scope,
+		&i.UsedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
 const getRefreshTokensByTenantUser = `-- name: GetRefreshTokensByTenantUser :many
 SELECT
     rt.id,
     rt.user_id,
     rt.expires_at,
-    rt.created_at
+    rt.created_at,
+    rt.family_id,
+    rt.application_id,
+    rt.session_id,
+    rt.scope,
+    rt.used_at,
+    rt.revoked_at
 FROM
     refresh_token rt
     INNER JOIN tenant_user au ON au.id = rt.user_id
@@ -85,6 +184,12 @@ func (q *Queries) GetRefreshTokensByTenantUser(ctx context.Context, arg GetRefre
 			&i.UserID,
 			&i.ExpiresAt,
 			&i.CreatedAt,
+			&i.FamilyID,
+			&i.ApplicationID,
+			&i.SessionID,
+			&i.Scope,
+			&i.UsedAt,
+			&i.RevokedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -101,7 +206,13 @@ SELECT
     id,
     user_id,
     expires_at,
-    created_at
+    created_at,
+    family_id,
+    application_id,
+    session_id,
+    scope,
+    used_at,
+    revoked_at
 FROM
     refresh_token
 WHERE
@@ -123,6 +234,12 @@ func (q *Queries) GetRefreshTokensFromUser(ctx context.Context, userID uuid.UUID
 			&i.UserID,
 			&i.ExpiresAt,
 			&i.CreatedAt,
+			&i.FamilyID,
+			&i.ApplicationID,
+			&i.SessionID,
+			&i.Scope,
+			&i.UsedAt,
+			&i.RevokedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -134,6 +251,25 @@ func (q *Queries) GetRefreshTokensFromUser(ctx context.Context, userID uuid.UUID
 	return items, nil
 }
 
+const markRefreshTokenUsed = `-- name: MarkRefreshTokenUsed :exec
+UPDATE
+    refresh_token
+SET
+    used_at = $1
+WHERE
+    id = $2
+`
+
+type MarkRefreshTokenUsedParams struct {
+	UsedAt *time.Time `db:"used_at"`
+	ID     uuid.UUID  `db:"id"`
+}
+
+func (q *Queries) MarkRefreshTokenUsed(ctx context.Context, arg MarkRefreshTokenUsedParams) error {
+	_, err := q.db.Exec(ctx, markRefreshTokenUsed, arg.UsedAt, arg.ID)
+	return err
+}
+
 const revokeRefreshTokenByID = `-- name: RevokeRefreshTokenByID :exec
 DELETE FROM
     refresh_token
@@ -146,14 +282,86 @@ func (q *Queries) RevokeRefreshTokenByID(ctx context.Context, id uuid.UUID) erro
 	return err
 }
 
+const revokeRefreshTokenFamily = `-- name: RevokeRefreshTokenFamily :exec
+UPDATE
+    refresh_token
+SET
+    revoked_at = $1
+WHERE
+    family_id = $2
+    AND revoked_at IS NULL
+`
+
+type RevokeRefreshTokenFamilyParams struct {
+	RevokedAt *time.Time `db:"revoked_at"`
+	FamilyID  uuid.UUID  `db:"family_id"`
+}
+
+func (q *Queries) RevokeRefreshTokenFamily(ctx context.Context, arg RevokeRefreshTokenFamilyParams) error {
+	_, err := q.db.Exec(ctx, revokeRefreshTokenFamily, arg.RevokedAt, arg.FamilyID)
+	return err
+}
+
 const revokeRefreshTokenFromUser = `-- name: RevokeRefreshTokenFromUser :exec
-DELETE FROM
+UPDATE
     refresh_token
+SET
+    revoked_at = $1
 WHERE
-    user_id = $1
+    user_id = $2
+    AND revoked_at IS NULL
+`
+
+type RevokeRefreshTokenFromUserParams struct {
+	RevokedAt *time.Time `db:"revoked_at"`
+	UserID    uuid.UUID  `db:"user_id"`
+}
+
+func (q *Queries) RevokeRefreshTokenFromUser(ctx context.Context, arg RevokeRefreshTokenFromUserParams) error {
+	_, err := q.db.Exec(ctx, revokeRefreshTokenFromUser, arg.RevokedAt, arg.UserID)
+	return err
+}
+
+const revokeRefreshTokensFromApplication = `-- name: RevokeRefreshTokensFromApplication :exec
+UPDATE
+    refresh_token
+SET
+    revoked_at = $1
+WHERE
+    user_id = $2
+    AND application_id = $3
+    AND revoked_at IS NULL
+`
+
+type RevokeRefreshTokensFromApplicationParams struct {
+	RevokedAt     *time.Time `db:"revoked_at"`
+	UserID        uuid.UUID  `db:"user_id"`
+	ApplicationID *uuid.UUID `db:"application_id"`
+}
+
+func (q *Queries) RevokeRefreshTokensFromApplication(ctx context.Context, arg RevokeRefreshTokensFromApplicationParams) error {
+	_, err := q.db.Exec(ctx, revokeRefreshTokensFromApplication, arg.RevokedAt, arg.UserID, arg.ApplicationID)
+	return err
+}
+
+const revokeRefreshTokensFromSession = `-- name: RevokeRefreshTokensFromSession :exec
+UPDATE
+    refresh_token
+SET
+    revoked_at = $1
+WHERE
+    user_id = $2
+    AND session_id = $3
+    AND revoked_at IS NULL
 `
 
-func (q *Queries) RevokeRefreshTokenFromUser(ctx context.Context, userID uuid.UUID) error {
-	_, err := q.db.Exec(ctx, revokeRefreshTokenFromUser, userID)
+type RevokeRefreshTokensFromSessionParams struct {
+	RevokedAt *time.Time `db:"revoked_at"`
+	UserID    uuid.UUID  `db:"user_id"`
+	SessionID *uuid.UUID `db:"session_id"`
+}
+
+func (q *Queries) RevokeRefreshTokensFromSession(ctx context.Context, arg RevokeRefreshTokensFromSessionParams) error {
+	_, err := q.db.Exec(ctx, revokeRefreshTokensFromSession, arg.RevokedAt, arg.UserID, arg.SessionID)
 	return err
 }
@@ -20,6 +20,23 @@ INSERT INTO
         name,
         description,
         password_hash_secret,
+        password_hash_memory,
+        password_hash_iterations,
+        password_hash_parallelism,
+        password_min_length,
+        password_require_uppercase,
+        password_require_lowercase,
+        password_require_digit,
+        password_require_symbol,
+        password_max_age_days,
+        password_history_count,
+        password_forbidden_words,
+        breached_password_check,
+        breached_password_threshold,
+        lockout_threshold,
+        lockout_duration_seconds,
+        lockout_max_duration_seconds,
+        lockout_notify_user,
         created_at
     )
 VALUES
@@ -32,16 +49,67 @@ VALUES
         -- description
         $4,
         -- password_hash_secret
-        $5 -- created_at
+        $5,
+        -- password_hash_memory
+        $6,
+        -- password_hash_iterations
+        $7,
+        -- password_hash_parallelism
+        $8,
+        -- password_min_length
+        $9,
+        -- password_require_uppercase
+        $10,
+        -- password_require_lowercase
+        $11,
+        -- password_require_digit
+        $12,
+        -- password_require_symbol
+        $13,
+        -- password_max_age_days
+        $14,
+        -- password_history_count
+        $15,
+        -- password_forbidden_words
+        $16,
+        -- breached_password_check
+        $17,
+        -- breached_password_threshold
+        $18,
+        -- lockout_threshold
+        $19,
+        -- lockout_duration_seconds
+        $20,
+        -- lockout_max_duration_seconds
+        $21,
+        -- lockout_notify_user
+        $22 -- created_at
     )
 `
 
 type AddTenantParams struct {
-	ID                 uuid.UUID        `db:"id"`
-	Name               string           `db:"name"`
-	Description        *string          `db:"description"`
-	PasswordHashSecret string           `db:"password_hash_secret"`
-	CreatedAt          pgtype.Timestamp `db:"created_at"`
+	ID                        uuid.UUID        `db:"id"`
+	Name                      string           `db:"name"`
+	Description               *string          `db:"description"`
+	PasswordHashSecret        string           `db:"password_hash_secret"`
+	PasswordHashMemory        int32            `db:"password_hash_memory"`
+	PasswordHashIterations    int32            `db:"password_hash_iterations"`
+	PasswordHashParallelism   int32            `db:"password_hash_parallelism"`
+	PasswordMinLength         int32            `db:"password_min_length"`
+	PasswordRequireUppercase  bool             `db:"password_require_uppercase"`
+	PasswordRequireLowercase  bool             `db:"password_require_lowercase"`
+	PasswordRequireDigit      bool             `db:"password_require_digit"`
+	PasswordRequireSymbol     bool             `db:"password_require_symbol"`
+	PasswordMaxAgeDays        int32            `db:"password_max_age_days"`
+	PasswordHistoryCount      int32            `db:"password_history_count"`
+	PasswordForbiddenWords    []string         `db:"password_forbidden_words"`
+	BreachedPasswordCheck     bool             `db:"breached_password_check"`
+	BreachedPasswordThreshold int32            `db:"breached_password_threshold"`
+	LockoutThreshold          int32            `db:"lockout_threshold"`
+	LockoutDurationSeconds    int32            `db:"lockout_duration_seconds"`
+	LockoutMaxDurationSeconds int32            `db:"lockout_max_duration_seconds"`
+	LockoutNotifyUser         bool             `db:"lockout_notify_user"`
+	CreatedAt                 pgtype.Timestamp `db:"created_at"`
 }
 
 // ----------------------------------COMMANDS--------------------------------------
@@ -51,6 +119,23 @@ func (q *Queries) AddTenant(ctx context.Context, arg AddTenantParams) error {
 		arg.Name,
 		arg.Description,
 		arg.PasswordHashSecret,
+		arg.PasswordHashMemory,
+		arg.PasswordHashIterations,
+		arg.PasswordHashParallelism,
+		arg.PasswordMinLength,
+		arg.PasswordRequireUppercase,
+		arg.PasswordRequireLowercase,
+		arg.PasswordRequireDigit,
+		arg.PasswordRequireSymbol,
+		arg.PasswordMaxAgeDays,
+		arg.PasswordHistoryCount,
+		arg.PasswordForbiddenWords,
+		arg.BreachedPasswordCheck,
+		arg.BreachedPasswordThreshold,
+		arg.LockoutThreshold,
+		arg.LockoutDurationSeconds,
+		arg.LockoutMaxDurationSeconds,
+		arg.LockoutNotifyUser,
 		arg.CreatedAt,
 	)
 	return err
@@ -62,6 +147,24 @@ SELECT
     name,
     description,
     password_hash_secret,
+    previous_password_hash_secret,
+    password_hash_memory,
+    password_hash_iterations,
+    password_hash_parallelism,
+    password_min_length,
+    password_require_uppercase,
+    password_require_lowercase,
+    password_require_digit,
+    password_require_symbol,
+    password_max_age_days,
+    password_history_count,
+    password_forbidden_words,
+    breached_password_check,
+    breached_password_threshold,
+    lockout_threshold,
+    lockout_duration_seconds,
+    lockout_max_duration_seconds,
+    lockout_notify_user,
     created_at,
     updated_at
 FROM
@@ -79,6 +182,24 @@ func (q *Queries) GetTenantByID(ctx context.Context, tenantID uuid.UUID) (Tenant
 		&i.Name,
 		&i.Description,
 		&i.PasswordHashSecret,
+		&i.PreviousPasswordHashSecret,
+		&i.PasswordHashMemory,
+		&i.PasswordHashIterations,
+		&i.PasswordHashParallelism,
+		&i.PasswordMinLength,
+		&i.PasswordRequireUppercase,
+		&i.PasswordRequireLowercase,
+		&i.PasswordRequireDigit,
+		&i.PasswordRequireSymbol,
+		&i.PasswordMaxAgeDays,
+		&i.PasswordHistoryCount,
+		&i.PasswordForbiddenWords,
+		&i.BreachedPasswordCheck,
+		&i.BreachedPasswordThreshold,
+		&i.LockoutThreshold,
+		&i.LockoutDurationSeconds,
+		&i.LockoutMaxDurationSeconds,
+		&i.LockoutNotifyUser,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -91,6 +212,24 @@ SELECT
     name,
     description,
     password_hash_secret,
+    previous_password_hash_secret,
+    password_hash_memory,
+    password_hash_iterations,
+    password_hash_parallelism,
+    password_min_length,
+    password_require_uppercase,
+    password_require_lowercase,
+    password_require_digit,
+    password_require_symbol,
+    password_max_age_days,
+    password_history_count,
+    password_forbidden_words,
+    breached_password_check,
+    breached_password_threshold,
+    lockout_threshold,
+    lockout_duration_seconds,
+    lockout_max_duration_seconds,
+    lockout_notify_user,
     created_at,
     updated_at
 FROM
@@ -113,6 +252,24 @@ func (q *Queries) ListTenants(ctx context.Context) ([]Tenant, error) {
 			&i.Name,
 			&i.Description,
 			&i.PasswordHashSecret,
+			&i.PreviousPasswordHashSecret,
+			&i.PasswordHashMemory,
+			&i.PasswordHashIterations,
+			&i.PasswordHashParallelism,
+			&i.PasswordMinLength,
+			&i.PasswordRequireUppercase,
+			&i.PasswordRequireLowercase,
+			&i.PasswordRequireDigit,
+			&i.PasswordRequireSymbol,
+			&i.PasswordMaxAgeDays,
+			&i.PasswordHistoryCount,
+			&i.PasswordForbiddenWords,
+			&i.BreachedPasswordCheck,
+			&i.BreachedPasswordThreshold,
+			&i.LockoutThreshold,
+			&i.LockoutDurationSeconds,
+			&i.LockoutMaxDurationSeconds,
+			&i.LockoutNotifyUser,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -145,17 +302,53 @@ SET
     name = $1,
     description = $2,
     password_hash_secret = $3,
-    updated_at = $4
+    previous_password_hash_secret = $4,
+    password_hash_memory = $5,
+    password_hash_iterations = $6,
+    password_hash_parallelism = $7,
+    password_min_length = $8,
+    password_require_uppercase = $9,
+    password_require_lowercase = $10,
+    password_require_digit = $11,
+    password_require_symbol = $12,
+    password_max_age_days = $13,
+    password_history_count = $14,
+    password_forbidden_words = $15,
+    breached_password_check = $16,
+    breached_password_threshold = $17,
+    lockout_threshold = $18,
+    lockout_duration_seconds = $19,
+    lockout_max_duration_seconds = $20,
+    lockout_notify_user = $21,
+    updated_at = $22
 WHERE
-    id = $5
+    id = $23
 `
 
 type UpdateTenantParams struct {
-	Name               string     `db:"name"`
-	Description        *string    `db:"description"`
-	PasswordHashSecret string     `db:"password_hash_secret"`
-	UpdatedAt          *time.Time `db:"updated_at"`
-	ID                 uuid.UUID  `db:"id"`
+	Name                       string     `db:"name"`
+	Description                *string    `db:"description"`
+	PasswordHashSecret         string     `db:"password_hash_secret"`
+	PreviousPasswordHashSecret string     `db:"previous_password_hash_secret"`
+	PasswordHashMemory         int32      `db:"password_hash_memory"`
+	PasswordHashIterations     int32      `db:"password_hash_iterations"`
+	PasswordHashParallelism    int32      `db:"password_hash_parallelism"`
+	PasswordMinLength          int32      `db:"password_min_length"`
+	PasswordRequireUppercase   bool       `db:"password_require_uppercase"`
+	PasswordRequireLowercase   bool       `db:"password_require_lowercase"`
+	PasswordRequireDigit       bool       `db:"password_require_digit"`
+	PasswordRequireSymbol      bool       `db:"password_require_symbol"`
+	PasswordMaxAgeDays         int32      `db:"password_max_age_days"`
+	PasswordHistoryCount       int32      `db:"password_history_count"`
+	PasswordForbiddenWords     []string   `db:"password_forbidden_words"`
+	BreachedPasswordCheck      bool       `db:"breached_password_check"`
+	BreachedPasswordThreshold  int32      `db:"breached_password_threshold"`
+	LockoutThreshold           int32      `db:"lockout_threshold"`
+	LockoutDurationSeconds     int32      `db:"lockout_duration_seconds"`
+	LockoutMaxDurationSeconds  int32      `db:"lockout_max_duration_seconds"`
+	LockoutNotifyUser          bool       `db:"lockout_notify_user"`
+	UpdatedAt                  *time.Time `db:"updated_at"`
+	ID                         uuid.UUID  `db:"id"`
 }
 
 func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) error {
@@ -163,6 +356,24 @@ func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) erro
 		arg.Name,
 		arg.Description,
 		arg.PasswordHashSecret,
+		arg.PreviousPasswordHashSecret,
+		arg.PasswordHashMemory,
+		arg.PasswordHashIterations,
+		arg.PasswordHashParallelism,
+		arg.PasswordMinLength,
+		arg.PasswordRequireUppercase,
+		arg.PasswordRequireLowercase,
+		arg.PasswordRequireDigit,
+		arg.PasswordRequireSymbol,
+		arg.PasswordMaxAgeDays,
+		arg.PasswordHistoryCount,
+		arg.PasswordForbiddenWords,
+		arg.BreachedPasswordCheck,
+		arg.BreachedPasswordThreshold,
+		arg.LockoutThreshold,
+		arg.LockoutDurationSeconds,
+		arg.LockoutMaxDurationSeconds,
+		arg.LockoutNotifyUser,
 		arg.UpdatedAt,
 		arg.ID,
 	)
@@ -316,6 +316,115 @@ func (q *Queries) IsUserExistsById(ctx context.Context, id uuid.UUID) (bool, err
 	return exists, err
 }
 
+const listTenantUsersForExport = `-- name: ListTenantUsersForExport :many
+SELECT
+    au.id,
+    au.email,
+    au.is_active,
+    au.is_email_confirmed,
+    up.display_name,
+    up.first_name,
+    up.last_name,
+    up.phone_number,
+    up.address,
+    up.photo_url,
+    COALESCE(r.roles, '{}' :: text []) :: text [] AS roles,
+    COALESCE(ei.external_identities, '{}' :: text []) :: text [] AS external_identities
+FROM
+    "tenant_user" au
+    LEFT JOIN "user_profile" up ON up.user_id = au.id
+    LEFT JOIN LATERAL (
+        SELECT
+            array_agg(
+                ar.application_id :: text || ':' || ar.name
+                ORDER BY
+                    ar.application_id,
+                    ar.name
+            ) AS roles
+        FROM
+            "user_role" ur
+            JOIN "application_role" ar ON ar.id = ur.role_id
+        WHERE
+            ur.user_id = au.id
+    ) r ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT
+            array_agg(
+                aop.application_id :: text || ':' || aop.name || ':' || e.provider_user_id
+                ORDER BY
+                    aop.application_id,
+                    aop.name
+            ) AS external_identities
+        FROM
+            "external_identity" e
+            JOIN "application_oauth_provider" aop ON aop.id = e.application_oauth_provider_id
+        WHERE
+            e.user_id = au.id
+    ) ei ON TRUE
+WHERE
+    au.tenant_id = $1
+    AND au.id > $2
+ORDER BY
+    au.id
+LIMIT
+    $3
+`
+
+type ListTenantUsersForExportParams struct {
+	TenantID uuid.UUID `db:"tenant_id"`
+	AfterID  uuid.UUID `db:"after_id"`
+	Limit    int32     `db:"limit"`
+}
+
+type ListTenantUsersForExportRow struct {
+	ID                 uuid.UUID `db:"id"`
+	Email              string    `db:"email"`
+	IsActive           bool      `db:"is_active"`
+	IsEmailConfirmed   bool      `db:"is_email_confirmed"`
+	DisplayName        *string   `db:"display_name"`
+	FirstName          *string   `db:"first_name"`
+	LastName           *string   `db:"last_name"`
+	PhoneNumber        *string   `db:"phone_number"`
+	Address            *string   `db:"address"`
+	PhotoUrl           *string   `db:"photo_url"`
+	Roles              []string  `db:"roles"`
+	ExternalIdentities []string  `db:"external_identities"`
+}
+
+// Keyset-paged users of a tenant with their profile, roles and external identities
+func (q *Queries) ListTenantUsersForExport(ctx context.Context, arg ListTenantUsersForExportParams) ([]ListTenantUsersForExportRow, error) {
+	rows, err := q.db.Query(ctx, listTenantUsersForExport, arg.TenantID, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTenantUsersForExportRow
+	for rows.Next() {
+		var i ListTenantUsersForExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.IsActive,
+			&i.IsEmailConfirmed,
+			&i.DisplayName,
+			&i.FirstName,
+			&i.LastName,
+			&i.PhoneNumber,
+			&i.Address,
+			&i.PhotoUrl,
+			&i.Roles,
+			&i.ExternalIdentities,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE
     "tenant_user"
@@ -21,6 +21,7 @@ INSERT INTO
         password_hash,
         password_algorithm,
         should_change_pass,
+        password_changed_at,
         created_at,
         updated_at
     )
@@ -32,7 +33,8 @@ VALUES
         $4,
         $5,
         $6,
-        $7
+        $7,
+        $8
     )
 `
 
@@ -42,6 +44,7 @@ type AddUserCredentialsParams struct {
 	PasswordHash      string           `db:"password_hash"`
 	PasswordAlgorithm string           `db:"password_algorithm"`
 	ShouldChangePass  bool             `db:"should_change_pass"`
+	PasswordChangedAt pgtype.Timestamp `db:"password_changed_at"`
 	CreatedAt         pgtype.Timestamp `db:"created_at"`
 	UpdatedAt         *time.Time       `db:"updated_at"`
 }
@@ -54,6 +57,7 @@ func (q *Queries) AddUserCredentials(ctx context.Context, arg AddUserCredentials
 		arg.PasswordHash,
 		arg.PasswordAlgorithm,
 		arg.ShouldChangePass,
+		arg.PasswordChangedAt,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -67,6 +71,7 @@ SELECT
     password_hash,
     password_algorithm,
     should_change_pass,
+    password_changed_at,
     created_at,
     updated_at
 FROM
@@ -81,6 +86,7 @@ type GetUserCredentialsByUserIDRow struct {
 	PasswordHash      string           `db:"password_hash"`
 	PasswordAlgorithm string           `db:"password_algorithm"`
 	ShouldChangePass  bool             `db:"should_change_pass"`
+	PasswordChangedAt pgtype.Timestamp `db:"password_changed_at"`
 	CreatedAt         pgtype.Timestamp `db:"created_at"`
 	UpdatedAt         *time.Time       `db:"updated_at"`
 }
@@ -95,6 +101,7 @@ func (q *Queries) GetUserCredentialsByUserID(ctx context.Context, userID uuid.UU
 		&i.PasswordHash,
 		&i.PasswordAlgorithm,
 		&i.ShouldChangePass,
+		&i.PasswordChangedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -120,17 +127,19 @@ SET
     password_hash = $1,
     password_algorithm = $2,
     should_change_pass = $3,
-    updated_at = $4
+    password_changed_at = $4,
+    updated_at = $5
 WHERE
-    user_id = $5
+    user_id = $6
 `
 
 type UpdateUserCredentialsParams struct {
-	PasswordHash      string     `db:"password_hash"`
-	PasswordAlgorithm string     `db:"password_algorithm"`
-	ShouldChangePass  bool       `db:"should_change_pass"`
-	UpdatedAt         *time.Time `db:"updated_at"`
-	UserID            uuid.UUID  `db:"user_id"`
+	PasswordHash      string           `db:"password_hash"`
+	PasswordAlgorithm string           `db:"password_algorithm"`
+	ShouldChangePass  bool             `db:"should_change_pass"`
+	PasswordChangedAt pgtype.Timestamp `db:"password_changed_at"`
+	UpdatedAt         *time.Time       `db:"updated_at"`
+	UserID            uuid.UUID        `db:"user_id"`
 }
 
 func (q *Queries) UpdateUserCredentials(ctx context.Context, arg UpdateUserCredentialsParams) error {
@@ -138,6 +147,7 @@ func (q *Queries) UpdateUserCredentials(ctx context.Context, arg UpdateUserCrede
 		arg.PasswordHash,
 		arg.PasswordAlgorithm,
 		arg.ShouldChangePass,
+		arg.PasswordChangedAt,
 		arg.UpdatedAt,
 		arg.UserID,
 	)
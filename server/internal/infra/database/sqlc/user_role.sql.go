@@ -74,6 +74,50 @@ func (q *Queries) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]GetUser
 	return items, nil
 }
 
+const getUserRolesFromApplication = `-- name: GetUserRolesFromApplication :many
+SELECT
+    r.id AS id,
+    r.name AS name
+FROM
+    user_role AS ur
+    INNER JOIN application_role AS r ON ur.role_id = r.id
+WHERE
+    ur.user_id = $1
+    AND r.application_id = $2
+ORDER BY
+    r.name
+`
+
+type GetUserRolesFromApplicationParams struct {
+	UserID        uuid.UUID `db:"user_id"`
+	ApplicationID uuid.UUID `db:"application_id"`
+}
+
+type GetUserRolesFromApplicationRow struct {
+	ID   uuid.UUID `db:"id"`
+	Name string    `db:"name"`
+}
+
+func (q *Queries) GetUserRolesFromApplication(ctx context.Context, arg GetUserRolesFromApplicationParams) ([]GetUserRolesFromApplicationRow, error) {
+	rows, err := q.db.Query(ctx, getUserRolesFromApplication, arg.UserID, arg.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserRolesFromApplicationRow
+	for rows.Next() {
+		var i GetUserRolesFromApplicationRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const removeUserRole = `-- name: RemoveUserRole :exec
 DELETE FROM
     user_role
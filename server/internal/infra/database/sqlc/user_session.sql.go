@@ -71,6 +71,27 @@ func (q *Queries) AddUserSession(ctx context.Context, arg AddUserSessionParams)
 	return err
 }
 
+const checkIfUserSessionActive = `-- name: CheckIfUserSessionActive :one
+SELECT
+  EXISTS (
+    SELECT
+      1
+    FROM
+      user_session
+    WHERE
+      id = $1
+      AND is_revoked = FALSE
+  )
+`
+
+// ----------------------------------QUERIES--------------------------------------
+func (q *Queries) CheckIfUserSessionActive(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, checkIfUserSessionActive, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const getActiveUserSessions = `-- name: GetActiveUserSessions :many
 SELECT
   id,
@@ -93,7 +114,6 @@ ORDER BY
   last_active_at DESC
 `
 
-// ----------------------------------QUERIES--------------------------------------
 func (q *Queries) GetActiveUserSessions(ctx context.Context, userID uuid.UUID) ([]UserSession, error) {
 	rows, err := q.db.Query(ctx, getActiveUserSessions, userID)
 	if err != nil {
@@ -202,6 +222,27 @@ func (q *Queries) RevokeUserSessionByID(ctx context.Context, arg RevokeUserSessi
 	return err
 }
 
+const revokeUserSessionsFromApplication = `-- name: RevokeUserSessionsFromApplication :exec
+UPDATE
+  user_session
+SET
+  is_revoked = TRUE
+WHERE
+  user_id = $1
+  AND application_id = $2
+  AND is_revoked = FALSE
+`
+
+type RevokeUserSessionsFromApplicationParams struct {
+	UserID        uuid.UUID `db:"user_id"`
+	ApplicationID uuid.UUID `db:"application_id"`
+}
+
+func (q *Queries) RevokeUserSessionsFromApplication(ctx context.Context, arg RevokeUserSessionsFromApplicationParams) error {
+	_, err := q.db.Exec(ctx, revokeUserSessionsFromApplication, arg.UserID, arg.ApplicationID)
+	return err
+}
+
 const updateUserSessionLastActive = `-- name: UpdateUserSessionLastActive :exec
 UPDATE
   user_session
@@ -0,0 +1,23 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// FailInterruptedUserImports marks as failed the pending or running user
+// imports whose heartbeat stopped, because the instance processing them went
+// down. Imports run in-process, so nothing will resume them; clients see the
+// failure and submit the file again. Imports other instances are still
+// processing keep refreshing their heartbeat and are left alone.
+func FailInterruptedUserImports(ctx context.Context, pool *pgxpool.Pool) error {
+	jobs := repositories.UserImportJobRepository{Store: pgstore.New(pool)}
+	now := time.Now().UTC()
+
+	return jobs.FailInterruptedUserImportJobs(ctx, now, now.Add(-constants.UserImportHeartbeatTimeout))
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	gomail "gopkg.in/mail.v2"
@@ -16,6 +17,7 @@ type IMailService interface {
 	SendEmailConfirmationEmail(ctx context.Context, to, userName, token string) error
 	SendMfaEmail(ctx context.Context, to, userName, token string) error
 	SendForgotPasswordEmail(ctx context.Context, to, userName, token string, passwordResetID, applicationID uuid.UUID) error
+	SendAccountLockedEmail(ctx context.Context, to, userName string, lockedUntil time.Time) error
 }
 
 type MailService struct{}
@@ -117,6 +119,25 @@ func (ms *MailService) SendMfaEmail(ctx context.Context, to, userName, token str
 	return nil
 }
 
+func (ms *MailService) SendAccountLockedEmail(ctx context.Context, to, userName string, lockedUntil time.Time) error {
+	accountLockedTemplate, err := readFileAsString("./internal/infra/mail-service/account-locked-template.html")
+
+	if err != nil {
+		return err
+	}
+
+	replacedString := strings.Replace(accountLockedTemplate, "{{$name}}", userName, -1)
+	replacedString = strings.Replace(replacedString, "{{$locked-until}}", lockedUntil.UTC().Format("2006-01-02 15:04 MST"), -1)
+
+	ms.sendMail(ctx, SendMailParams{
+		To:      to,
+		Subject: "Account Locked",
+		Body:    replacedString,
+	})
+
+	return nil
+}
+
 func readFileAsString(filePath string) (string, error) {
 	// Open the file
 	file, err := os.Open(filePath)
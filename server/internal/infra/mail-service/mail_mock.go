@@ -3,6 +3,7 @@ package mailservice
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -36,3 +37,9 @@ func (ms *MailServiceMock) SendForgotPasswordEmail(ctx context.Context, to, user
 
 	return nil
 }
+
+func (ms *MailServiceMock) SendAccountLockedEmail(ctx context.Context, to, userName string, lockedUntil time.Time) error {
+	fmt.Printf("Mail sent successfully to: %v, from: %v, subject: %v", to, ms.From, ms.Subject)
+
+	return nil
+}
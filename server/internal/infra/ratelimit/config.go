@@ -11,3 +11,11 @@ type Config struct {
 	// KeyPrefix is prepended to every Redis key to avoid collisions.
 	KeyPrefix string
 }
+
+// Policy is a rate limit applied to one or more routes. Routes sharing a
+// KeyPrefix share their counters.
+type Policy struct {
+	Config
+	// Key selects whose requests are counted together.
+	Key KeyFunc
+}
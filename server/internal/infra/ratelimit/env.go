@@ -0,0 +1,30 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// StoreFromEnv returns the Store configured in the environment: a RedisStore
+// shared by every instance when REDIS_ADDR is set (with the optional
+// REDIS_PASSWORD), and a MemoryStore otherwise.
+func StoreFromEnv(ctx context.Context) (Store, error) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		return NewMemoryStore(), nil
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: os.Getenv("REDIS_PASSWORD"),
+	})
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("ratelimit: connecting to redis at %s: %w", addr, err)
+	}
+
+	return NewRedisStore(client), nil
+}
@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gate-keeper/internal/infra/clientip"
+)
+
+// maxKeyBodySize bounds how much of a request body key functions read.
+const maxKeyBodySize = 1 << 20
+
+// KeyFunc returns the subject whose requests are counted together, e.g. a
+// client IP or an e-mail address. It returns an empty string when the request
+// doesn't carry the value.
+type KeyFunc func(r *http.Request) string
+
+// Keys combines key functions, so that requests are only counted together
+// when every part matches (e.g. the same IP trying the same e-mail).
+func Keys(fns ...KeyFunc) KeyFunc {
+	return func(r *http.Request) string {
+		parts := make([]string, len(fns))
+		for i, fn := range fns {
+			parts[i] = fn(r)
+		}
+		return strings.Join(parts, "|")
+	}
+}
+
+// KeyIP keys requests by client IP address.
+func KeyIP(r *http.Request) string {
+	return clientip.RealIP(r)
+}
+
+// KeyEmail keys requests by the "email" field of a JSON body, normalised so
+// that changing its case doesn't bypass the limit.
+func KeyEmail(r *http.Request) string {
+	return strings.ToLower(strings.TrimSpace(jsonField(r, "email")))
+}
+
+// KeyClientID keys requests by OAuth client: the client_secret_basic user,
+// the client_id form field, or the clientId field of a JSON body.
+func KeyClientID(r *http.Request) string {
+	if username, _, ok := r.BasicAuth(); ok {
+		return username
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/x-www-form-urlencoded" {
+		// ParseForm keeps the parsed body on the request for the handler.
+		if err := r.ParseForm(); err != nil {
+			return ""
+		}
+		return r.PostForm.Get("client_id")
+	}
+
+	return jsonField(r, "clientId")
+}
+
+// jsonField reads a string field of a JSON request body and restores the body
+// so the handler can decode it again.
+func jsonField(r *http.Request, field string) string {
+	if r.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxKeyBodySize))
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return ""
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return ""
+	}
+
+	var value string
+	if err := json.Unmarshal(fields[field], &value); err != nil {
+		return ""
+	}
+
+	return value
+}
@@ -20,6 +20,12 @@ func New(cfg Config, store Store) *Limiter {
 // The key is scoped with the configured KeyPrefix before being passed to the
 // Store, so callers can pass raw values such as an IP address.
 func (l *Limiter) Allow(ctx context.Context, key string) (bool, error) {
+	result, err := l.Take(ctx, key)
+	return result.Allowed, err
+}
+
+// Take is like Allow but also reports the remaining quota of the key.
+func (l *Limiter) Take(ctx context.Context, key string) (Result, error) {
 	scoped := fmt.Sprintf("%s:%s", l.cfg.KeyPrefix, key)
-	return l.store.Allow(ctx, scoped, l.cfg.Requests, l.cfg.Window)
+	return l.store.Take(ctx, scoped, l.cfg.Requests, l.cfg.Window)
 }
@@ -18,9 +18,12 @@ func newMockStore() *mockStore {
 	return &mockStore{counters: make(map[string]int)}
 }
 
-func (m *mockStore) Allow(_ context.Context, key string, limit int, _ time.Duration) (bool, error) {
+func (m *mockStore) Take(_ context.Context, key string, limit int, window time.Duration) (ratelimit.Result, error) {
+	if m.counters[key] >= limit {
+		return ratelimit.Result{Allowed: false, Limit: limit, ResetAfter: window}, nil
+	}
 	m.counters[key]++
-	return m.counters[key] <= limit, nil
+	return ratelimit.Result{Allowed: true, Limit: limit, Remaining: limit - m.counters[key], ResetAfter: window}, nil
 }
 
 func TestLimiter_AllowsUpToLimit(t *testing.T) {
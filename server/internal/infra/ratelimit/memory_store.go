@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memorySweepInterval is how often MemoryStore drops keys whose window has
+// fully expired.
+const memorySweepInterval = time.Minute
+
+// memoryWindow holds the timestamps of the requests counted in a key's
+// current window, oldest first.
+type memoryWindow struct {
+	hits   []time.Time
+	window time.Duration
+}
+
+// MemoryStore implements Store in process memory with the same sliding-window
+// semantics as RedisStore. Counters are not shared between instances, so it
+// only suits single-node deployments.
+type MemoryStore struct {
+	mu        sync.Mutex
+	windows   map[string]*memoryWindow
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		windows:   make(map[string]*memoryWindow),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// Take counts the request and returns the key's remaining quota.
+func (s *MemoryStore) Take(_ context.Context, key string, limit int, window time.Duration) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	w, ok := s.windows[key]
+	if !ok {
+		w = &memoryWindow{}
+		s.windows[key] = w
+	}
+	w.window = window
+
+	oldest := now.Add(-window)
+	expired := 0
+	for expired < len(w.hits) && !w.hits[expired].After(oldest) {
+		expired++
+	}
+	w.hits = w.hits[expired:]
+
+	allowed := len(w.hits) < limit
+	if allowed {
+		w.hits = append(w.hits, now)
+	}
+
+	resetAfter := window
+	if len(w.hits) > 0 {
+		resetAfter = w.hits[0].Add(window).Sub(now)
+	}
+
+	return Result{
+		Allowed:    allowed,
+		Limit:      limit,
+		Remaining:  max(limit-len(w.hits), 0),
+		ResetAfter: resetAfter,
+	}, nil
+}
+
+// sweep drops the keys whose most recent request left the window, so keys
+// that stop sending requests don't accumulate. Callers must hold mu.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, w := range s.windows {
+		if len(w.hits) == 0 || !w.hits[len(w.hits)-1].Add(w.window).After(now) {
+			delete(s.windows, key)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func newTestMemoryStore(now *time.Time) *MemoryStore {
+	store := NewMemoryStore()
+	store.now = func() time.Time { return *now }
+	store.lastSweep = *now
+	return store
+}
+
+func TestMemoryStore_SlidingWindow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := newTestMemoryStore(&now)
+	ctx := context.Background()
+
+	for i := 1; i <= 2; i++ {
+		result, _ := store.Take(ctx, "key", 2, time.Minute)
+		if !result.Allowed || result.Remaining != 2-i {
+			t.Fatalf("request %d: expected allowed with %d remaining, got %+v", i, 2-i, result)
+		}
+		now = now.Add(20 * time.Second)
+	}
+
+	result, _ := store.Take(ctx, "key", 2, time.Minute)
+	if result.Allowed {
+		t.Fatal("expected third request to be denied")
+	}
+	if result.ResetAfter != 20*time.Second {
+		t.Fatalf("expected the first request to leave the window in 20s, got %s", result.ResetAfter)
+	}
+
+	// Once the first request leaves the window a slot frees up.
+	now = now.Add(20 * time.Second)
+	result, _ = store.Take(ctx, "key", 2, time.Minute)
+	if !result.Allowed || result.Remaining != 0 {
+		t.Fatalf("expected allowed with 0 remaining, got %+v", result)
+	}
+}
+
+func TestMemoryStore_SweepsExpiredKeys(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := newTestMemoryStore(&now)
+	ctx := context.Background()
+
+	store.Take(ctx, "short", 1, time.Second) //nolint:errcheck
+	store.Take(ctx, "long", 1, time.Hour)    //nolint:errcheck
+
+	now = now.Add(2 * memorySweepInterval)
+	store.Take(ctx, "other", 1, time.Second) //nolint:errcheck
+
+	if _, ok := store.windows["short"]; ok {
+		t.Fatal("expected expired key to be swept")
+	}
+	if _, ok := store.windows["long"]; !ok {
+		t.Fatal("expected key still within its window to be kept")
+	}
+}
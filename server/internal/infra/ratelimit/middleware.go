@@ -2,22 +2,30 @@ package ratelimit
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 )
 
-// Middleware returns a chi-compatible HTTP middleware that rate-limits requests
-// by client IP address. When a client exceeds the configured limit the handler
-// responds with HTTP 429 Too Many Requests and a JSON error body.
+// Middleware returns a chi-compatible HTTP middleware that rate-limits
+// requests by the subject key returns. Every response carries the
+// RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset and RateLimit-Policy
+// headers. When a client exceeds the limit, Retry-After is set and limited
+// writes the response; a nil limited responds with HTTP 429 Too Many Requests
+// and a JSON error body.
 //
 // On store errors the request is allowed through (fail-open) to avoid blocking
 // legitimate traffic due to transient Redis issues.
-func Middleware(limiter *Limiter) func(http.Handler) http.Handler {
+func Middleware(limiter *Limiter, key KeyFunc, limited http.Handler) func(http.Handler) http.Handler {
+	if limited == nil {
+		limited = http.HandlerFunc(tooManyRequests)
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ip := RealIP(r)
-			key := Hash(ip)
-
-			allowed, err := limiter.Allow(r.Context(), key)
+			result, err := limiter.Take(r.Context(), Hash(key(r)))
 			if err != nil {
 				// Fail open: let the request proceed rather than denying
 				// legitimate traffic on a storage error.
@@ -25,12 +33,16 @@ func Middleware(limiter *Limiter) func(http.Handler) http.Handler {
 				return
 			}
 
-			if !allowed {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusTooManyRequests)
-				json.NewEncoder(w).Encode(map[string]string{ //nolint:errcheck
-					"error": "too many requests",
-				})
+			reset := strconv.Itoa(ceilSeconds(result.ResetAfter))
+
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			w.Header().Set("RateLimit-Reset", reset)
+			w.Header().Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limiter.cfg.Requests, ceilSeconds(limiter.cfg.Window)))
+
+			if !result.Allowed {
+				w.Header().Set("Retry-After", reset)
+				limited.ServeHTTP(w, r)
 				return
 			}
 
@@ -38,3 +50,21 @@ func Middleware(limiter *Limiter) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// PolicyMiddleware is Middleware for a Policy.
+func PolicyMiddleware(store Store, policy Policy, limited http.Handler) func(http.Handler) http.Handler {
+	return Middleware(New(policy.Config, store), policy.Key, limited)
+}
+
+func tooManyRequests(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(w).Encode(map[string]string{ //nolint:errcheck
+		"error": "too many requests",
+	})
+}
+
+// ceilSeconds rounds d up to whole seconds, as the headers can't carry less.
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,93 @@
+package ratelimit_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/infra/ratelimit"
+)
+
+func TestMiddleware_SetsHeadersAndDenies(t *testing.T) {
+	limiter := ratelimit.New(ratelimit.Config{Requests: 1, Window: time.Minute, KeyPrefix: "test"}, ratelimit.NewMemoryStore())
+	handler := ratelimit.Middleware(limiter, ratelimit.KeyIP, nil)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, request)
+
+	if first.Code != http.StatusNoContent {
+		t.Fatalf("expected first request to pass, got %d", first.Code)
+	}
+	if first.Header().Get("RateLimit-Limit") != "1" || first.Header().Get("RateLimit-Remaining") != "0" {
+		t.Fatalf("unexpected rate limit headers: %v", first.Header())
+	}
+	if first.Header().Get("RateLimit-Policy") != "1;w=60" {
+		t.Fatalf("unexpected policy header %q", first.Header().Get("RateLimit-Policy"))
+	}
+
+	second := httptest.NewRecorder()
+	handler.ServeHTTP(second, request)
+
+	if second.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected second request to be limited, got %d", second.Code)
+	}
+	if second.Header().Get("Retry-After") != "60" {
+		t.Fatalf("expected Retry-After of 60s, got %q", second.Header().Get("Retry-After"))
+	}
+}
+
+func TestKeyEmail_RestoresBody(t *testing.T) {
+	body := `{"email": " User@Example.com ", "password": "secret"}`
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+
+	if key := ratelimit.KeyEmail(request); key != "user@example.com" {
+		t.Fatalf("unexpected key %q", key)
+	}
+
+	restored, _ := io.ReadAll(request.Body)
+	if string(restored) != body {
+		t.Fatalf("expected body to be restored, got %q", restored)
+	}
+}
+
+func TestKeyClientID(t *testing.T) {
+	form := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(url.Values{"client_id": {"form-client"}}.Encode()))
+	form.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if key := ratelimit.KeyClientID(form); key != "form-client" {
+		t.Fatalf("unexpected form key %q", key)
+	}
+	if form.PostForm.Get("client_id") != "form-client" {
+		t.Fatal("expected the parsed form to stay available to the handler")
+	}
+
+	basic := httptest.NewRequest(http.MethodPost, "/", nil)
+	basic.SetBasicAuth("basic-client", "secret")
+
+	if key := ratelimit.KeyClientID(basic); key != "basic-client" {
+		t.Fatalf("unexpected basic auth key %q", key)
+	}
+
+	jsonBody := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"clientId": "json-client"}`))
+
+	if key := ratelimit.KeyClientID(jsonBody); key != "json-client" {
+		t.Fatalf("unexpected JSON key %q", key)
+	}
+}
+
+func TestKeys_CombinesParts(t *testing.T) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email": "a@b.c"}`))
+	request.RemoteAddr = "10.0.0.1:1234"
+
+	if key := ratelimit.Keys(ratelimit.KeyIP, ratelimit.KeyEmail)(request); key != "10.0.0.1|a@b.c" {
+		t.Fatalf("unexpected key %q", key)
+	}
+}
@@ -16,7 +16,8 @@ import (
 // ARGV[1] – max allowed requests (limit)
 // ARGV[2] – window size in milliseconds
 // ARGV[3] – current time in milliseconds
-// Returns 1 if the request is allowed, 0 if it exceeds the limit.
+// Returns {allowed (1 or 0), remaining requests, milliseconds until the oldest
+// entry leaves the window}.
 const slidingWindowScript = `
 local key    = KEYS[1]
 local limit  = tonumber(ARGV[1])
@@ -26,14 +27,24 @@ local oldest = now - window
 
 redis.call('ZREMRANGEBYSCORE', key, '-inf', oldest)
 
-local count = tonumber(redis.call('ZCARD', key))
+local count   = tonumber(redis.call('ZCARD', key))
+local allowed = 0
 if count < limit then
     local member = tostring(now) .. '-' .. tostring(redis.call('INCR', key .. ':seq'))
     redis.call('ZADD', key, now, member)
     redis.call('PEXPIRE', key, window)
-    return 1
+    redis.call('PEXPIRE', key .. ':seq', window)
+    count   = count + 1
+    allowed = 1
 end
-return 0
+
+local reset = window
+local first = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if first[2] then
+    reset = tonumber(first[2]) + window - now
+end
+
+return {allowed, limit - count, reset}
 `
 
 // RedisStore implements Store using Redis sorted sets for a sliding-window
@@ -51,15 +62,24 @@ func NewRedisStore(client *redis.Client) *RedisStore {
 	}
 }
 
-// Allow returns true when the request key is within the rate limit.
-func (s *RedisStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+// Take counts the request and returns the key's remaining quota.
+func (s *RedisStore) Take(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
 	now := time.Now().UnixMilli()
 	windowMs := window.Milliseconds()
 
-	result, err := s.script.Run(ctx, s.client, []string{key}, limit, windowMs, now).Int()
+	values, err := s.script.Run(ctx, s.client, []string{key}, limit, windowMs, now).Int64Slice()
 	if err != nil {
-		return false, fmt.Errorf("ratelimit: redis script: %w", err)
+		return Result{}, fmt.Errorf("ratelimit: redis script: %w", err)
+	}
+
+	if len(values) != 3 {
+		return Result{}, fmt.Errorf("ratelimit: unexpected redis script result %v", values)
 	}
 
-	return result == 1, nil
+	return Result{
+		Allowed:    values[0] == 1,
+		Limit:      limit,
+		Remaining:  int(values[1]),
+		ResetAfter: time.Duration(values[2]) * time.Millisecond,
+	}, nil
 }
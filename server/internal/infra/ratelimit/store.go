@@ -5,10 +5,24 @@ import (
 	"time"
 )
 
+// Result describes the state of a key's quota after a request was counted.
+type Result struct {
+	// Allowed is true when the request is within the limit.
+	Allowed bool
+	// Limit is the maximum number of requests allowed within the window.
+	Limit int
+	// Remaining is the number of requests still allowed within the window.
+	Remaining int
+	// ResetAfter is how long until the oldest request in the window expires
+	// and frees up a slot.
+	ResetAfter time.Duration
+}
+
 // Store is the backend storage interface for the rate limiter.
 type Store interface {
-	// Allow returns true when the request is within the allowed limit.
+	// Take counts a request against key and reports whether it is allowed.
 	// key uniquely identifies the subject (e.g. hashed IP), limit is the
 	// maximum number of requests, and window is the sliding time window.
-	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+	// Denied requests are not counted.
+	Take(ctx context.Context, key string, limit int, window time.Duration) (Result, error)
 }
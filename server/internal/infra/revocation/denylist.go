@@ -0,0 +1,87 @@
+package revocation
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Denylist answers whether an access token was revoked before it expired
+// (RFC 7009). Tokens are identified by their jti claim, and the session they
+// were issued for by their sid claim.
+type Denylist interface {
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+	IsSessionRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error)
+}
+
+var (
+	denylistMu sync.RWMutex
+	denylist   Denylist
+)
+
+// SetDenylist installs the process-wide Denylist.
+func SetDenylist(d Denylist) {
+	denylistMu.Lock()
+	defer denylistMu.Unlock()
+	denylist = d
+}
+
+// IsRevoked consults the process-wide Denylist. When none was installed no
+// token is considered revoked.
+func IsRevoked(ctx context.Context, jti string) (bool, error) {
+	denylistMu.RLock()
+	d := denylist
+	denylistMu.RUnlock()
+
+	if d == nil || jti == "" {
+		return false, nil
+	}
+
+	return d.IsRevoked(ctx, jti)
+}
+
+// IsSessionRevoked consults the process-wide Denylist for the session a token
+// was issued for. Tokens without a session are never considered revoked.
+func IsSessionRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	denylistMu.RLock()
+	d := denylist
+	denylistMu.RUnlock()
+
+	if d == nil || sessionID == uuid.Nil {
+		return false, nil
+	}
+
+	return d.IsSessionRevoked(ctx, sessionID)
+}
+
+// DatabaseDenylist reads the revoked_access_token and user_session tables.
+type DatabaseDenylist struct {
+	pool *pgxpool.Pool
+}
+
+func NewDatabaseDenylist(pool *pgxpool.Pool) *DatabaseDenylist {
+	return &DatabaseDenylist{pool: pool}
+}
+
+func (d *DatabaseDenylist) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	repository := repositories.RevokedAccessTokenRepository{Store: pgstore.New(d.pool)}
+
+	return repository.IsAccessTokenRevoked(ctx, jti)
+}
+
+// IsSessionRevoked treats a session that no longer exists, for instance after
+// its user was deleted, as revoked.
+func (d *DatabaseDenylist) IsSessionRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	repository := repositories.UserSessionRepository{Store: pgstore.New(d.pool)}
+
+	active, err := repository.IsUserSessionActive(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	return !active, nil
+}
@@ -0,0 +1,152 @@
+package signing
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// reloadCooldown bounds how often an unknown kid may trigger a reload, so a
+// flood of forged tokens cannot turn into a flood of database queries.
+const reloadCooldown = 30 * time.Second
+
+// DatabaseKeyProvider serves per-tenant keys persisted in the signing_key
+// table from an in-memory cache. The env KeySet acts as fallback: it signs for
+// tenants that have no key yet and keeps verifying tokens issued before
+// rotation was enabled.
+type DatabaseKeyProvider struct {
+	pool     *pgxpool.Pool
+	fallback *KeySet
+
+	mu        sync.RWMutex
+	active    map[uuid.UUID]*Key
+	keys      map[string]*Key
+	published []*Key
+	loadedAt  time.Time
+}
+
+func NewDatabaseKeyProvider(pool *pgxpool.Pool, fallback *KeySet) *DatabaseKeyProvider {
+	return &DatabaseKeyProvider{
+		pool:     pool,
+		fallback: fallback,
+		active:   map[uuid.UUID]*Key{},
+		keys:     map[string]*Key{},
+	}
+}
+
+// Reload replaces the cache with the keys currently published in the database.
+func (p *DatabaseKeyProvider) Reload(ctx context.Context) error {
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	repository := repositories.SigningKeyRepository{Store: pgstore.New(conn)}
+
+	rows, err := repository.ListPublishedSigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	active := map[uuid.UUID]*Key{}
+	keys := map[string]*Key{}
+	published := make([]*Key, 0, len(rows))
+
+	for _, row := range rows {
+		key, err := parseSigningKey(row)
+		if err != nil {
+			slog.ErrorContext(ctx, "Skipping unreadable signing key", "kid", row.KeyID, "error", err)
+			continue
+		}
+
+		keys[key.ID] = key
+		published = append(published, key)
+
+		if row.Status == constants.SigningKeyStatusActive {
+			active[row.TenantID] = key
+		}
+	}
+
+	p.mu.Lock()
+	p.active, p.keys, p.published, p.loadedAt = active, keys, published, time.Now()
+	p.mu.Unlock()
+
+	return nil
+}
+
+func (p *DatabaseKeyProvider) SigningKey(tenantID uuid.UUID) (*Key, error) {
+	p.mu.RLock()
+	key, ok := p.active[tenantID]
+	p.mu.RUnlock()
+
+	if ok {
+		return key, nil
+	}
+
+	return p.fallback.SigningKey(tenantID)
+}
+
+func (p *DatabaseKeyProvider) Lookup(kid string) (*Key, bool) {
+	if key, ok := p.lookupCached(kid); ok {
+		return key, true
+	}
+
+	// A peer node may have rotated since our last reload.
+	p.mu.RLock()
+	stale := time.Since(p.loadedAt) > reloadCooldown
+	p.mu.RUnlock()
+
+	if stale && kid != "" {
+		if err := p.Reload(context.Background()); err != nil {
+			slog.Error("Failed to reload signing keys", "error", err)
+		}
+		if key, ok := p.lookupCached(kid); ok {
+			return key, true
+		}
+	}
+
+	return p.fallback.Lookup(kid)
+}
+
+func (p *DatabaseKeyProvider) lookupCached(kid string) (*Key, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *DatabaseKeyProvider) PublicKeys() []*Key {
+	p.mu.RLock()
+	keys := append([]*Key{}, p.published...)
+	p.mu.RUnlock()
+
+	return append(keys, p.fallback.PublicKeys()...)
+}
+
+func parseSigningKey(row *entities.SigningKey) (*Key, error) {
+	privateKey, err := ParsePrivateKeyPEM([]byte(row.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKey(row.KeyID, row.Algorithm, privateKey)
+}
+
+// Reload refreshes the process-wide provider if it is backed by storage.
+// Call it after committing a rotation so this node picks it up immediately.
+func Reload(ctx context.Context) error {
+	if reloader, ok := Current().(interface{ Reload(context.Context) error }); ok {
+		return reloader.Reload(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported JWS algorithms (RFC 7518 / RFC 8037).
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// Key is a single signing key. Asymmetric keys carry both halves so the same
+// value can sign tokens and be published through the JWKS endpoint; HMAC keys
+// carry the shared secret in PrivateKey and are never published.
+type Key struct {
+	// ID is the value stamped in the "kid" header of every token signed with this key.
+	ID        string
+	Algorithm string
+	// PrivateKey is *rsa.PrivateKey, *ecdsa.PrivateKey, ed25519.PrivateKey or []byte (HS256).
+	PrivateKey any
+	// PublicKey is *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey or nil (HS256).
+	PublicKey crypto.PublicKey
+}
+
+// NewKey builds a Key from a private key, validating that the key type matches
+// the requested algorithm. When id is empty the RFC 7638 thumbprint is used.
+func NewKey(id, algorithm string, privateKey any) (*Key, error) {
+	key := &Key{ID: id, Algorithm: algorithm, PrivateKey: privateKey}
+
+	switch algorithm {
+	case AlgorithmHS256:
+		secret, ok := privateKey.([]byte)
+		if !ok || len(secret) == 0 {
+			return nil, fmt.Errorf("signing: %s expects a non-empty []byte secret", algorithm)
+		}
+	case AlgorithmRS256:
+		rsaKey, ok := privateKey.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("signing: %s expects an RSA private key", algorithm)
+		}
+		if rsaKey.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("signing: RSA keys must be at least 2048 bits")
+		}
+		key.PublicKey = &rsaKey.PublicKey
+	case AlgorithmES256:
+		ecKey, ok := privateKey.(*ecdsa.PrivateKey)
+		if !ok || ecKey.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("signing: %s expects a P-256 ECDSA private key", algorithm)
+		}
+		key.PublicKey = &ecKey.PublicKey
+	case AlgorithmEdDSA:
+		edKey, ok := privateKey.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("signing: %s expects an Ed25519 private key", algorithm)
+		}
+		key.PublicKey = edKey.Public()
+	default:
+		return nil, fmt.Errorf("signing: unsupported algorithm %q", algorithm)
+	}
+
+	if key.ID == "" {
+		thumbprint, err := key.Thumbprint()
+		if err != nil {
+			return nil, err
+		}
+		key.ID = thumbprint
+	}
+
+	return key, nil
+}
+
+// GenerateKey creates a fresh asymmetric key for the given algorithm.
+func GenerateKey(algorithm string) (*Key, error) {
+	var privateKey any
+	var err error
+
+	switch algorithm {
+	case AlgorithmRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	case AlgorithmES256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("signing: cannot generate keys for algorithm %q", algorithm)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKey("", algorithm, privateKey)
+}
+
+// SigningMethod returns the jwt signing method matching the key algorithm.
+func (k *Key) SigningMethod() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// VerificationKey returns the value the jwt parser needs to verify a signature.
+func (k *Key) VerificationKey() any {
+	if k.Algorithm == AlgorithmHS256 {
+		return k.PrivateKey
+	}
+	return k.PublicKey
+}
+
+// IsSymmetric reports whether the key is a shared secret that must never be published.
+func (k *Key) IsSymmetric() bool {
+	return k.Algorithm == AlgorithmHS256
+}
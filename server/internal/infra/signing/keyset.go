@@ -0,0 +1,153 @@
+package signing
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KeyProvider resolves the keys used to sign and verify tokens.
+type KeyProvider interface {
+	// SigningKey returns the key new tokens of the tenant must be signed with.
+	SigningKey(tenantID uuid.UUID) (*Key, error)
+	// Lookup returns the verification key for a "kid" header. An empty kid
+	// resolves to the legacy HS256 key, if one is configured, so tokens issued
+	// before kid stamping keep validating until they expire.
+	Lookup(kid string) (*Key, bool)
+	// PublicKeys returns every asymmetric key that must be published in JWKS.
+	PublicKeys() []*Key
+}
+
+// KeySet is a static KeyProvider: one active signing key plus any number of
+// verification-only keys.
+type KeySet struct {
+	active      *Key
+	legacy      *Key
+	legacyUntil time.Time
+	keys        map[string]*Key
+}
+
+// NewKeySet creates a KeySet that signs with active. Extra keys are accepted
+// for verification only.
+func NewKeySet(active *Key, verificationKeys ...*Key) *KeySet {
+	set := &KeySet{active: active, keys: map[string]*Key{active.ID: active}}
+
+	for _, key := range verificationKeys {
+		set.keys[key.ID] = key
+	}
+
+	if active.IsSymmetric() {
+		set.legacy = active
+	}
+
+	return set
+}
+
+// WithLegacySecret registers an HS256 key that verifies tokens carrying no kid
+// until the given time. It is never published nor used for signing.
+func (s *KeySet) WithLegacySecret(key *Key, until time.Time) *KeySet {
+	s.legacy = key
+	s.legacyUntil = until
+	return s
+}
+
+// legacyKey returns the key for kid-less tokens. A symmetric active key stays
+// valid for as long as it signs; a legacy secret only until its deadline.
+func (s *KeySet) legacyKey() (*Key, bool) {
+	if s.legacy == nil {
+		return nil, false
+	}
+
+	if s.legacy != s.active && !time.Now().Before(s.legacyUntil) {
+		return nil, false
+	}
+
+	return s.legacy, true
+}
+
+func (s *KeySet) SigningKey(tenantID uuid.UUID) (*Key, error) {
+	return s.active, nil
+}
+
+func (s *KeySet) Lookup(kid string) (*Key, bool) {
+	if kid == "" {
+		return s.legacyKey()
+	}
+
+	if s.legacy != nil && kid == s.legacy.ID {
+		return s.legacyKey()
+	}
+
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+func (s *KeySet) PublicKeys() []*Key {
+	keys := make([]*Key, 0, len(s.keys))
+
+	for _, key := range s.keys {
+		if !key.IsSymmetric() {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+var (
+	providerMu sync.RWMutex
+	provider   KeyProvider
+)
+
+// SetProvider installs the process-wide KeyProvider.
+func SetProvider(p KeyProvider) {
+	providerMu.Lock()
+	defer providerMu.Unlock()
+	provider = p
+}
+
+// Current returns the process-wide KeyProvider. When none was installed it
+// falls back to an HS256 key derived from JWT_SECRET, read on every call so the
+// secret can be swapped without restarting (this is what unit tests rely on).
+func Current() KeyProvider {
+	providerMu.RLock()
+	p := provider
+	providerMu.RUnlock()
+
+	if p != nil {
+		return p
+	}
+
+	return secretProvider{secret: os.Getenv("JWT_SECRET")}
+}
+
+// secretProvider is the zero-configuration provider backed by JWT_SECRET.
+type secretProvider struct {
+	secret string
+}
+
+func (p secretProvider) key() (*Key, error) {
+	if p.secret == "" {
+		return nil, fmt.Errorf("signing: no signing key configured")
+	}
+	return NewKey("", AlgorithmHS256, []byte(p.secret))
+}
+
+func (p secretProvider) SigningKey(tenantID uuid.UUID) (*Key, error) {
+	return p.key()
+}
+
+func (p secretProvider) Lookup(kid string) (*Key, bool) {
+	key, err := p.key()
+	if err != nil || (kid != "" && kid != key.ID) {
+		return nil, false
+	}
+	return key, true
+}
+
+func (p secretProvider) PublicKeys() []*Key {
+	return nil
+}
@@ -0,0 +1,122 @@
+package signing
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// LoadFromEnv builds a KeySet from the environment:
+//
+//   - JWT_SIGNING_ALGORITHM: RS256, ES256, EdDSA or HS256 (default).
+//   - JWT_SIGNING_KEY_FILE:  PEM-encoded private key (PKCS#8, PKCS#1 or SEC 1).
+//     When omitted for an asymmetric algorithm an ephemeral key is generated,
+//     which is only suitable for development since tokens die with the process.
+//   - JWT_SIGNING_KEY_ID:    optional kid; defaults to the RFC 7638 thumbprint.
+//   - JWT_SECRET:            HS256 secret used with the HS256 algorithm.
+//   - JWT_LEGACY_SECRET_UNTIL: optional RFC 3339 time. With an asymmetric
+//     algorithm, JWT_SECRET is kept as a verification-only key for tokens
+//     without a kid until then, so tokens issued before the switch remain
+//     valid until they expire. Without it the old secret is not trusted.
+func LoadFromEnv() (*KeySet, error) {
+	algorithm := os.Getenv("JWT_SIGNING_ALGORITHM")
+	if algorithm == "" {
+		algorithm = AlgorithmHS256
+	}
+
+	secret := os.Getenv("JWT_SECRET")
+
+	if algorithm == AlgorithmHS256 {
+		key, err := NewKey(os.Getenv("JWT_SIGNING_KEY_ID"), AlgorithmHS256, []byte(secret))
+		if err != nil {
+			return nil, err
+		}
+		return NewKeySet(key), nil
+	}
+
+	var key *Key
+	var err error
+
+	if path := os.Getenv("JWT_SIGNING_KEY_FILE"); path != "" {
+		key, err = LoadKeyFile(path, algorithm, os.Getenv("JWT_SIGNING_KEY_ID"))
+	} else {
+		slog.Warn("JWT_SIGNING_KEY_FILE not set, generating an ephemeral signing key", "algorithm", algorithm)
+		key, err = GenerateKey(algorithm)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	set := NewKeySet(key)
+
+	until := os.Getenv("JWT_LEGACY_SECRET_UNTIL")
+	if until == "" {
+		return set, nil
+	}
+
+	deadline, err := time.Parse(time.RFC3339, until)
+	if err != nil {
+		return nil, fmt.Errorf("signing: invalid JWT_LEGACY_SECRET_UNTIL: %w", err)
+	}
+
+	if secret == "" || !time.Now().Before(deadline) {
+		slog.Warn("JWT_LEGACY_SECRET_UNTIL ignored, legacy HS256 tokens are no longer accepted", "until", until)
+		return set, nil
+	}
+
+	legacy, err := NewKey("", AlgorithmHS256, []byte(secret))
+	if err != nil {
+		return nil, err
+	}
+	set.WithLegacySecret(legacy, deadline)
+
+	return set, nil
+}
+
+// LoadKeyFile reads a PEM private key from disk.
+func LoadKeyFile(path, algorithm, id string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("signing: reading key file: %w", err)
+	}
+
+	privateKey, err := ParsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKey(id, algorithm, privateKey)
+}
+
+// ParsePrivateKeyPEM decodes the first PEM block holding a private key.
+func ParsePrivateKeyPEM(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("signing: no PEM block found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("signing: unsupported PEM block type %q", block.Type)
+	}
+}
+
+// MarshalPrivateKeyPEM encodes a private key as a PKCS#8 PEM block.
+func MarshalPrivateKeyPEM(privateKey any) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
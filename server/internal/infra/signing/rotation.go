@@ -0,0 +1,167 @@
+package signing
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/google/uuid"
+)
+
+// MinimumOverlap is the longest a token signed by a key can stay acceptable:
+// 15 minutes of access-token lifetime plus the 30-minute leeway granted by
+// the refresh endpoint. Retired keys are never unpublished sooner than this.
+const MinimumOverlap = 45 * time.Minute
+
+// RotationPolicy controls the lifecycle of persisted signing keys.
+type RotationPolicy struct {
+	// Enabled switches token signing from the static env key to per-tenant keys.
+	Enabled bool
+	// Algorithm used for newly generated keys.
+	Algorithm string
+	// Interval is how long a key stays active before it is rotated out.
+	Interval time.Duration
+	// Overlap is how long a retired key keeps being published in JWKS.
+	Overlap time.Duration
+}
+
+// PolicyFromEnv reads JWT_KEY_ROTATION_ENABLED, JWT_KEY_ROTATION_INTERVAL and
+// JWT_KEY_OVERLAP (Go durations). New keys use JWT_SIGNING_ALGORITHM when it is
+// asymmetric and RS256 otherwise.
+func PolicyFromEnv() RotationPolicy {
+	policy := RotationPolicy{
+		Enabled:   os.Getenv("JWT_KEY_ROTATION_ENABLED") == "true",
+		Algorithm: os.Getenv("JWT_SIGNING_ALGORITHM"),
+		Interval:  envDuration("JWT_KEY_ROTATION_INTERVAL", 30*24*time.Hour),
+		Overlap:   envDuration("JWT_KEY_OVERLAP", 24*time.Hour),
+	}
+
+	if policy.Algorithm == "" || policy.Algorithm == AlgorithmHS256 {
+		policy.Algorithm = AlgorithmRS256
+	}
+
+	if policy.Overlap < MinimumOverlap {
+		slog.Warn("JWT_KEY_OVERLAP is shorter than the token lifetime, raising it", "overlap", policy.Overlap, "minimum", MinimumOverlap)
+		policy.Overlap = MinimumOverlap
+	}
+
+	return policy
+}
+
+func envDuration(name string, fallback time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		slog.Warn("invalid duration, using default", "variable", name, "value", value, "default", fallback)
+		return fallback
+	}
+
+	return duration
+}
+
+// KeyStore is the persistence needed to rotate a tenant's keys.
+type KeyStore interface {
+	AddSigningKey(ctx context.Context, key *entities.SigningKey) error
+	UpdateSigningKeyStatus(ctx context.Context, key *entities.SigningKey) error
+	ListSigningKeysByTenant(ctx context.Context, tenantID uuid.UUID) ([]*entities.SigningKey, error)
+}
+
+// RotateOptions tunes a single rotation run.
+type RotateOptions struct {
+	// Force rotates even if the active key has not reached the policy interval.
+	Force bool
+	// RevokePrevious withdraws the outgoing key from JWKS immediately instead of
+	// keeping it published for the overlap window. Use when a key is compromised.
+	RevokePrevious bool
+}
+
+// RotateTenantKeys brings a tenant's keys in line with the policy:
+//
+//  1. A "next" key always exists, so relying parties can cache it before use.
+//  2. If there is no active key the next key is promoted.
+//  3. If the active key is older than the interval (or Force is set) it is
+//     retired (or revoked) and the next key is promoted.
+//
+// It returns the key that is active once the run completes.
+func RotateTenantKeys(ctx context.Context, store KeyStore, tenantID uuid.UUID, policy RotationPolicy, opts RotateOptions) (*entities.SigningKey, error) {
+	now := time.Now().UTC()
+
+	keys, err := store.ListSigningKeysByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var active, next *entities.SigningKey
+	for _, key := range keys {
+		switch key.Status {
+		case constants.SigningKeyStatusActive:
+			active = key
+		case constants.SigningKeyStatusNext:
+			next = key
+		}
+	}
+
+	if next == nil {
+		if next, err = addNextKey(ctx, store, tenantID, policy); err != nil {
+			return nil, err
+		}
+	}
+
+	due := active == nil || opts.Force ||
+		(active.ActivatedAt != nil && now.Sub(*active.ActivatedAt) >= policy.Interval)
+
+	if !due {
+		return active, nil
+	}
+
+	if active != nil {
+		if opts.RevokePrevious {
+			active.Revoke(now)
+		} else {
+			active.Retire(now, policy.Overlap)
+		}
+
+		if err := store.UpdateSigningKeyStatus(ctx, active); err != nil {
+			return nil, err
+		}
+	}
+
+	next.Activate(now)
+	if err := store.UpdateSigningKeyStatus(ctx, next); err != nil {
+		return nil, err
+	}
+
+	if _, err := addNextKey(ctx, store, tenantID, policy); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Signing key rotated", "tenant_id", tenantID, "kid", next.KeyID)
+
+	return next, nil
+}
+
+func addNextKey(ctx context.Context, store KeyStore, tenantID uuid.UUID, policy RotationPolicy) (*entities.SigningKey, error) {
+	key, err := GenerateKey(policy.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPEM, err := MarshalPrivateKeyPEM(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signingKey := entities.NewSigningKey(tenantID, key.ID, key.Algorithm, string(privateKeyPEM))
+	if err := store.AddSigningKey(ctx, signingKey); err != nil {
+		return nil, err
+	}
+
+	return signingKey, nil
+}
@@ -0,0 +1,135 @@
+package signing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/domain/constants"
+	"github.com/gate-keeper/internal/domain/entities"
+	"github.com/gate-keeper/internal/infra/signing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryKeyStore is an in-memory KeyStore used in unit tests.
+type memoryKeyStore struct {
+	keys []*entities.SigningKey
+}
+
+func (m *memoryKeyStore) AddSigningKey(_ context.Context, key *entities.SigningKey) error {
+	m.keys = append(m.keys, key)
+	return nil
+}
+
+func (m *memoryKeyStore) UpdateSigningKeyStatus(_ context.Context, _ *entities.SigningKey) error {
+	return nil
+}
+
+func (m *memoryKeyStore) ListSigningKeysByTenant(_ context.Context, tenantID uuid.UUID) ([]*entities.SigningKey, error) {
+	keys := make([]*entities.SigningKey, 0)
+	for _, key := range m.keys {
+		if key.TenantID == tenantID {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (m *memoryKeyStore) byStatus(status string) []*entities.SigningKey {
+	keys := make([]*entities.SigningKey, 0)
+	for _, key := range m.keys {
+		if key.Status == status {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func testPolicy() signing.RotationPolicy {
+	return signing.RotationPolicy{
+		Enabled:   true,
+		Algorithm: signing.AlgorithmES256,
+		Interval:  24 * time.Hour,
+		Overlap:   time.Hour,
+	}
+}
+
+func TestRotateTenantKeys_BootstrapsActiveAndNext(t *testing.T) {
+	store := &memoryKeyStore{}
+	tenantID := uuid.New()
+
+	active, err := signing.RotateTenantKeys(context.Background(), store, tenantID, testPolicy(), signing.RotateOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, store.byStatus(constants.SigningKeyStatusActive), 1)
+	require.Len(t, store.byStatus(constants.SigningKeyStatusNext), 1)
+	assert.Equal(t, active.ID, store.byStatus(constants.SigningKeyStatusActive)[0].ID)
+}
+
+func TestRotateTenantKeys_NotDueKeepsActive(t *testing.T) {
+	store := &memoryKeyStore{}
+	tenantID := uuid.New()
+
+	first, err := signing.RotateTenantKeys(context.Background(), store, tenantID, testPolicy(), signing.RotateOptions{})
+	require.NoError(t, err)
+
+	second, err := signing.RotateTenantKeys(context.Background(), store, tenantID, testPolicy(), signing.RotateOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ID, second.ID)
+	assert.Len(t, store.keys, 2)
+}
+
+func TestRotateTenantKeys_DueRetiresWithOverlap(t *testing.T) {
+	store := &memoryKeyStore{}
+	tenantID := uuid.New()
+	policy := testPolicy()
+
+	first, err := signing.RotateTenantKeys(context.Background(), store, tenantID, policy, signing.RotateOptions{})
+	require.NoError(t, err)
+	pending := store.byStatus(constants.SigningKeyStatusNext)[0]
+
+	activatedAt := time.Now().UTC().Add(-policy.Interval - time.Minute)
+	first.ActivatedAt = &activatedAt
+
+	second, err := signing.RotateTenantKeys(context.Background(), store, tenantID, policy, signing.RotateOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, pending.ID, second.ID, "the pre-published next key is promoted")
+	assert.Equal(t, constants.SigningKeyStatusRetired, first.Status)
+	require.NotNil(t, first.ExpiresAt)
+	assert.WithinDuration(t, time.Now().UTC().Add(policy.Overlap), *first.ExpiresAt, 5*time.Second)
+	assert.Len(t, store.byStatus(constants.SigningKeyStatusNext), 1)
+}
+
+func TestRotateTenantKeys_EmergencyRevokesImmediately(t *testing.T) {
+	store := &memoryKeyStore{}
+	tenantID := uuid.New()
+
+	first, err := signing.RotateTenantKeys(context.Background(), store, tenantID, testPolicy(), signing.RotateOptions{})
+	require.NoError(t, err)
+
+	_, err = signing.RotateTenantKeys(context.Background(), store, tenantID, testPolicy(), signing.RotateOptions{Force: true, RevokePrevious: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, constants.SigningKeyStatusRevoked, first.Status)
+	require.NotNil(t, first.ExpiresAt)
+	assert.False(t, first.ExpiresAt.After(time.Now().UTC()))
+}
+
+func TestRotateTenantKeys_IsolatesTenants(t *testing.T) {
+	store := &memoryKeyStore{}
+	tenantA, tenantB := uuid.New(), uuid.New()
+
+	keyA, err := signing.RotateTenantKeys(context.Background(), store, tenantA, testPolicy(), signing.RotateOptions{})
+	require.NoError(t, err)
+	_, err = signing.RotateTenantKeys(context.Background(), store, tenantB, testPolicy(), signing.RotateOptions{})
+	require.NoError(t, err)
+
+	_, err = signing.RotateTenantKeys(context.Background(), store, tenantB, testPolicy(), signing.RotateOptions{Force: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, constants.SigningKeyStatusActive, keyA.Status)
+}
@@ -0,0 +1,125 @@
+package signing
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Scheduler periodically rotates every tenant's keys according to the policy,
+// purges keys whose overlap window has ended and refreshes the provider cache.
+type Scheduler struct {
+	pool     *pgxpool.Pool
+	provider *DatabaseKeyProvider
+	policy   RotationPolicy
+	tick     time.Duration
+}
+
+func NewScheduler(pool *pgxpool.Pool, provider *DatabaseKeyProvider, policy RotationPolicy, tick time.Duration) *Scheduler {
+	return &Scheduler{pool: pool, provider: provider, policy: policy, tick: tick}
+}
+
+// Start runs one pass synchronously, so keys exist before the server accepts
+// traffic, then keeps running in the background until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	if err := s.RunOnce(ctx); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.tick)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.RunOnce(ctx); err != nil {
+					slog.ErrorContext(ctx, "Signing key rotation failed", "error", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// RunOnce performs a single rotation pass. The pass holds a cluster-wide
+// advisory lock, so when several nodes share the database only one of them
+// rotates; the others skip the pass and just reload what it wrote. Each tenant
+// is rotated in its own transaction so a failing tenant neither blocks nor
+// rolls back the others.
+func (s *Scheduler) RunOnce(ctx context.Context) error {
+	conn, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	lock, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer lock.Rollback(ctx)
+
+	locked, err := repositories.SigningKeyRepository{Store: pgstore.New(lock)}.TryLockSigningKeyRotation(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !locked {
+		slog.DebugContext(ctx, "Signing key rotation is running on another node, skipping this pass")
+		return s.provider.Reload(ctx)
+	}
+
+	tenants, err := repositories.TenantRepository{Store: pgstore.New(s.pool)}.ListTenants(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, tenant := range *tenants {
+		if err := s.rotateTenant(ctx, tenant.ID); err != nil {
+			slog.ErrorContext(ctx, "Signing key rotation failed for tenant", "tenantID", tenant.ID, "error", err)
+			errs = append(errs, err)
+		}
+	}
+
+	if err := (repositories.SigningKeyRepository{Store: pgstore.New(s.pool)}).DeleteExpiredSigningKeys(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := lock.Commit(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := s.provider.Reload(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Scheduler) rotateTenant(ctx context.Context, tenantID uuid.UUID) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	keyRepository := repositories.SigningKeyRepository{Store: pgstore.New(tx)}
+
+	if _, err := RotateTenantKeys(ctx, keyRepository, tenantID, s.policy, RotateOptions{}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,116 @@
+package signing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gate-keeper/internal/infra/signing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateKey_SupportedAlgorithms(t *testing.T) {
+	for _, alg := range []string{signing.AlgorithmRS256, signing.AlgorithmES256, signing.AlgorithmEdDSA} {
+		key, err := signing.GenerateKey(alg)
+		require.NoError(t, err, alg)
+		assert.Equal(t, alg, key.Algorithm)
+		assert.NotEmpty(t, key.ID, "kid must default to the thumbprint")
+
+		jwk, err := key.PublicJWK()
+		require.NoError(t, err, alg)
+		assert.Equal(t, key.ID, jwk.Kid)
+		assert.Equal(t, "sig", jwk.Use)
+		assert.Equal(t, alg, jwk.Alg)
+	}
+}
+
+func TestGenerateKey_RejectsHMAC(t *testing.T) {
+	_, err := signing.GenerateKey(signing.AlgorithmHS256)
+	require.Error(t, err)
+}
+
+func TestNewKey_RejectsMismatchedKeyType(t *testing.T) {
+	rsaKey, err := signing.GenerateKey(signing.AlgorithmRS256)
+	require.NoError(t, err)
+
+	_, err = signing.NewKey("", signing.AlgorithmES256, rsaKey.PrivateKey)
+	require.Error(t, err)
+}
+
+func TestPEMRoundTrip_PreservesThumbprint(t *testing.T) {
+	for _, alg := range []string{signing.AlgorithmRS256, signing.AlgorithmES256, signing.AlgorithmEdDSA} {
+		key, err := signing.GenerateKey(alg)
+		require.NoError(t, err)
+
+		pemBytes, err := signing.MarshalPrivateKeyPEM(key.PrivateKey)
+		require.NoError(t, err)
+
+		parsed, err := signing.ParsePrivateKeyPEM(pemBytes)
+		require.NoError(t, err)
+
+		reloaded, err := signing.NewKey("", alg, parsed)
+		require.NoError(t, err)
+		assert.Equal(t, key.ID, reloaded.ID, alg)
+	}
+}
+
+func TestKeySet_PublicKeysExcludesSecrets(t *testing.T) {
+	active, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	legacy, err := signing.NewKey("", signing.AlgorithmHS256, []byte("legacy-secret"))
+	require.NoError(t, err)
+
+	set := signing.NewKeySet(active).WithLegacySecret(legacy, time.Now().Add(time.Hour))
+
+	public := set.PublicKeys()
+	require.Len(t, public, 1)
+	assert.Equal(t, active.ID, public[0].ID)
+
+	found, ok := set.Lookup("")
+	require.True(t, ok, "tokens without kid resolve to the legacy secret")
+	assert.Equal(t, legacy.ID, found.ID)
+
+	_, ok = set.Lookup("unknown")
+	assert.False(t, ok)
+}
+
+func TestKeySet_ExpiredLegacySecretIsRejected(t *testing.T) {
+	active, err := signing.GenerateKey(signing.AlgorithmES256)
+	require.NoError(t, err)
+	legacy, err := signing.NewKey("", signing.AlgorithmHS256, []byte("legacy-secret"))
+	require.NoError(t, err)
+
+	set := signing.NewKeySet(active).WithLegacySecret(legacy, time.Now().Add(-time.Second))
+
+	_, ok := set.Lookup("")
+	assert.False(t, ok)
+	_, ok = set.Lookup(legacy.ID)
+	assert.False(t, ok)
+}
+
+func TestLoadFromEnv_LegacySecretIsOptIn(t *testing.T) {
+	t.Setenv("JWT_SIGNING_ALGORITHM", signing.AlgorithmES256)
+	t.Setenv("JWT_SECRET", "legacy-secret")
+
+	set, err := signing.LoadFromEnv()
+	require.NoError(t, err)
+	_, ok := set.Lookup("")
+	assert.False(t, ok, "the old secret is not trusted unless JWT_LEGACY_SECRET_UNTIL is set")
+
+	t.Setenv("JWT_LEGACY_SECRET_UNTIL", time.Now().Add(time.Hour).Format(time.RFC3339))
+
+	set, err = signing.LoadFromEnv()
+	require.NoError(t, err)
+	_, ok = set.Lookup("")
+	assert.True(t, ok)
+}
+
+func TestCurrent_FallsBackToJWTSecret(t *testing.T) {
+	t.Setenv("JWT_SECRET", "fallback-secret")
+
+	key, err := signing.Current().SigningKey(uuid.Nil)
+	require.NoError(t, err)
+	assert.Equal(t, signing.AlgorithmHS256, key.Algorithm)
+	assert.Empty(t, signing.Current().PublicKeys())
+}
@@ -11,6 +11,9 @@ type contextKey string
 const (
 	UserIDKey        contextKey = "userId"
 	ApplicationIDKey contextKey = "applicationId"
+	PermissionsKey   contextKey = "permissions"
+	SessionIDKey     contextKey = "sessionId"
+	ClientIDKey      contextKey = "clientId"
 )
 
 // GetUserIDFromContext extracts the authenticated user's ID from the request context.
@@ -31,3 +34,24 @@ func GetApplicationIDFromContext(ctx context.Context) uuid.UUID {
 	}
 	return uuid.MustParse(appIDStr)
 }
+
+// GetPermissionsFromContext returns the permissions carried by the access token
+// the JWT middleware validated, or nil when the token carried none.
+func GetPermissionsFromContext(ctx context.Context) []string {
+	permissions, _ := ctx.Value(PermissionsKey).([]string)
+	return permissions
+}
+
+// GetSessionIDFromContext returns the session the access token was issued for,
+// or uuid.Nil when the token carried no sid claim.
+func GetSessionIDFromContext(ctx context.Context) uuid.UUID {
+	sessionID, _ := ctx.Value(SessionIDKey).(uuid.UUID)
+	return sessionID
+}
+
+// GetClientIDFromContext returns the application the access token was issued
+// to, or uuid.Nil when the token carried no client_id claim.
+func GetClientIDFromContext(ctx context.Context) uuid.UUID {
+	clientID, _ := ctx.Value(ClientIDKey).(uuid.UUID)
+	return clientID
+}
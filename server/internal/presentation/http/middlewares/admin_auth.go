@@ -0,0 +1,199 @@
+package http_middlewares
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/database/repositories"
+	pgstore "github.com/gate-keeper/internal/infra/database/sqlc"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DashboardTokenHandler is a middleware that only lets through user tokens
+// issued to the dashboard application, so a relying party holding a user's
+// access token, or a client_credentials token, can't call the admin API. It
+// must be mounted after JwtHandler.
+func DashboardTokenHandler(dashboardClientID string) func(http.Handler) http.Handler {
+	clientID, err := uuid.Parse(dashboardClientID)
+	if err != nil {
+		clientID = uuid.Nil
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			tokenClientID := http_router.GetClientIDFromContext(ctx)
+			userID := http_router.GetUserIDFromContext(ctx)
+
+			// Client tokens have the application itself as subject
+			if clientID == uuid.Nil || tokenClientID != clientID || userID == tokenClientID {
+				WriteJSONError(w, http.StatusForbidden, errors.ErrDashboardTokenRequired.Title, errors.ErrDashboardTokenRequired.Message, ctx)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// PlatformAdminHandler is a middleware that only lets platform administrators
+// through. It must be mounted after JwtHandler.
+func PlatformAdminHandler(pool *pgxpool.Pool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			userID := http_router.GetUserIDFromContext(ctx)
+
+			allowed, err := withQueries(ctx, pool, func(q *pgstore.Queries) (bool, error) {
+				return repositories.AdminRepository{Store: q}.IsPlatformAdmin(ctx, userID)
+			})
+
+			if err != nil {
+				WriteJSONError(w, http.StatusInternalServerError, "Internal Server Error", "Failed to check administrator", ctx)
+				return
+			}
+
+			if !allowed {
+				WriteJSONError(w, http.StatusForbidden, errors.ErrPlatformAdminRequired.Title, errors.ErrPlatformAdminRequired.Message, ctx)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// TenantAdminHandler is a middleware that only lets administrators of the
+// {tenantID} route parameter, or platform administrators, through. It must be
+// mounted after JwtHandler.
+func TenantAdminHandler(pool *pgxpool.Pool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			userID := http_router.GetUserIDFromContext(ctx)
+			tenantID, err := uuid.Parse(chi.URLParam(r, "tenantID"))
+
+			if err != nil {
+				WriteJSONError(w, http.StatusForbidden, errors.ErrAdminRequired.Title, errors.ErrAdminRequired.Message, ctx)
+				return
+			}
+
+			allowed, err := withQueries(ctx, pool, func(q *pgstore.Queries) (bool, error) {
+				admins := repositories.AdminRepository{Store: q}
+
+				isPlatformAdmin, err := admins.IsPlatformAdmin(ctx, userID)
+				if err != nil || isPlatformAdmin {
+					return isPlatformAdmin, err
+				}
+
+				return admins.IsTenantAdmin(ctx, tenantID, userID)
+			})
+
+			if err != nil {
+				WriteJSONError(w, http.StatusInternalServerError, "Internal Server Error", "Failed to check administrator", ctx)
+				return
+			}
+
+			if !allowed {
+				WriteJSONError(w, http.StatusForbidden, errors.ErrAdminRequired.Title, errors.ErrAdminRequired.Message, ctx)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ApplicationScopeHandler is a middleware that rejects requests whose
+// {applicationID} route parameter does not belong to the {tenantID} one, so
+// an administrator of one tenant can never reach another tenant's applications.
+// Applications of other tenants are reported as not found.
+func ApplicationScopeHandler(pool *pgxpool.Pool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			tenantID, tenantErr := uuid.Parse(chi.URLParam(r, "tenantID"))
+			applicationID, applicationErr := uuid.Parse(chi.URLParam(r, "applicationID"))
+
+			if tenantErr != nil || applicationErr != nil {
+				WriteJSONError(w, http.StatusNotFound, errors.ErrApplicationNotFound.Title, errors.ErrApplicationNotFound.Message, ctx)
+				return
+			}
+
+			inScope, err := withQueries(ctx, pool, func(q *pgstore.Queries) (bool, error) {
+				application, err := repositories.ApplicationRepository{Store: q}.GetApplicationByID(ctx, applicationID)
+				if err != nil || application == nil {
+					return false, err
+				}
+
+				return application.TenantID == tenantID, nil
+			})
+
+			if err != nil {
+				WriteJSONError(w, http.StatusInternalServerError, "Internal Server Error", "Failed to load application", ctx)
+				return
+			}
+
+			if !inScope {
+				WriteJSONError(w, http.StatusNotFound, errors.ErrApplicationNotFound.Title, errors.ErrApplicationNotFound.Message, ctx)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// TenantUserScopeHandler is a middleware that rejects requests whose {userID}
+// route parameter is not a user of the {tenantID} one. Users of other tenants
+// are reported as not found.
+func TenantUserScopeHandler(pool *pgxpool.Pool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			tenantID, tenantErr := uuid.Parse(chi.URLParam(r, "tenantID"))
+			userID, userErr := uuid.Parse(chi.URLParam(r, "userID"))
+
+			if tenantErr != nil || userErr != nil {
+				WriteJSONError(w, http.StatusNotFound, errors.ErrUserNotFound.Title, errors.ErrUserNotFound.Message, ctx)
+				return
+			}
+
+			inScope, err := withQueries(ctx, pool, func(q *pgstore.Queries) (bool, error) {
+				user, err := repositories.UserRepository{Store: q}.GetUserByID(ctx, userID)
+				if err != nil || user == nil {
+					return false, err
+				}
+
+				return user.TenantID == tenantID, nil
+			})
+
+			if err != nil {
+				WriteJSONError(w, http.StatusInternalServerError, "Internal Server Error", "Failed to load user", ctx)
+				return
+			}
+
+			if !inScope {
+				WriteJSONError(w, http.StatusNotFound, errors.ErrUserNotFound.Title, errors.ErrUserNotFound.Message, ctx)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// withQueries runs check on a pooled connection outside of any transaction.
+func withQueries(ctx context.Context, pool *pgxpool.Pool, check func(q *pgstore.Queries) (bool, error)) (bool, error) {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Release()
+
+	return check(pgstore.New(conn))
+}
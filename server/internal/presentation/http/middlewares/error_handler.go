@@ -48,6 +48,26 @@ func ErrorHandler(next http.Handler) http.Handler {
 					json.NewEncoder(w).Encode(e)
 					return
 
+				case *errors.PasswordPolicyError:
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusBadRequest)
+
+					json.NewEncoder(w).Encode(e)
+					return
+
+				case *errors.OAuthError:
+					w.Header().Set("Content-Type", "application/json")
+					w.Header().Set("Cache-Control", "no-store")
+					w.Header().Set("Pragma", "no-cache")
+
+					if e.Code == errors.OAuthInvalidClient {
+						w.Header().Set("WWW-Authenticate", `Basic realm="gatekeeper"`)
+					}
+
+					w.WriteHeader(e.Status)
+					json.NewEncoder(w).Encode(e)
+					return
+
 				case string:
 					title = "Internal Server Error"
 					message = e
@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 	"strings"
-	"time"
 
 	application_utils "github.com/gate-keeper/internal/features/utils"
 	http_router "github.com/gate-keeper/internal/presentation/http"
@@ -13,6 +12,7 @@ import (
 // JwtRefreshHandler validates a JWT with a 30-minute leeway for token expiration.
 // This allows the refresh endpoint to accept recently-expired tokens so that
 // clients can obtain a fresh access token without forcing a full re-login.
+// Revoked tokens are refused, so they can't be traded for unrevoked ones.
 func JwtRefreshHandler(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -32,19 +32,24 @@ func JwtRefreshHandler(next http.Handler) http.Handler {
 		}
 
 		jwtToken := jwtTokenParts[1]
-		isValid, userID, err := application_utils.ValidateTokenWithLeeway(jwtToken, 30*time.Minute)
+		claims, err := application_utils.ValidateRefreshableAccessToken(ctx, jwtToken)
 
 		if err != nil {
 			WriteJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error(), ctx)
 			return
 		}
 
-		if !isValid {
+		userID, ok := claims["sub"].(string)
+
+		if !ok {
 			WriteJSONError(w, http.StatusUnauthorized, "Unauthorized", "Invalid token", ctx)
 			return
 		}
 
+		// The session is checked by the refresh handler, which also records activity on it.
 		ctx = context.WithValue(ctx, http_router.UserIDKey, userID)
+		ctx = context.WithValue(ctx, http_router.SessionIDKey, application_utils.SessionIDFromClaims(claims))
+		ctx = context.WithValue(ctx, http_router.ClientIDKey, application_utils.ClientIDFromClaims(claims))
 		r = r.WithContext(ctx)
 
 		next.ServeHTTP(w, r)
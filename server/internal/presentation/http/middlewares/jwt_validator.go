@@ -28,22 +28,42 @@ func JwtHandler(next http.Handler) http.Handler {
 		}
 
 		jwtToken := jwtTokenParts[1]
-		isValid, userID, err := application_utils.ValidateToken(jwtToken)
+		claims, err := application_utils.ValidateAccessToken(ctx, jwtToken)
 
 		if err != nil {
 			WriteJSONError(w, http.StatusUnauthorized, "Unauthorized", err.Error(), ctx)
 			return
 		}
 
-		if !isValid {
+		userID, ok := claims["sub"].(string)
+
+		if !ok {
 			WriteJSONError(w, http.StatusUnauthorized, "Unauthorized", "Invalid token", ctx)
 			return
 		}
 
 		// inject UserId on the request context using the shared key
 		ctx = context.WithValue(ctx, http_router.UserIDKey, userID)
+		ctx = context.WithValue(ctx, http_router.PermissionsKey, permissionsFromClaims(claims))
+		ctx = context.WithValue(ctx, http_router.SessionIDKey, application_utils.SessionIDFromClaims(claims))
+		ctx = context.WithValue(ctx, http_router.ClientIDKey, application_utils.ClientIDFromClaims(claims))
 		r = r.WithContext(ctx)
 
 		next.ServeHTTP(w, r)
 	})
 }
+
+// permissionsFromClaims reads the permissions claim, which is decoded as a
+// []any of strings.
+func permissionsFromClaims(claims map[string]any) []string {
+	values, _ := claims["permissions"].([]any)
+	permissions := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if permission, ok := value.(string); ok {
+			permissions = append(permissions, permission)
+		}
+	}
+
+	return permissions
+}
@@ -0,0 +1,26 @@
+package http_middlewares
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/infra/ratelimit"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+)
+
+// RateLimitHandler is a middleware that enforces a rate limit policy and
+// answers requests over the limit with ErrRateLimitExceeded.
+func RateLimitHandler(store ratelimit.Store, policy ratelimit.Policy) func(http.Handler) http.Handler {
+	limited := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		WriteJSONError(w, errors.ErrRateLimitExceeded.Code, errors.ErrRateLimitExceeded.Title, errors.ErrRateLimitExceeded.Message, r.Context())
+	})
+
+	return ratelimit.PolicyMiddleware(store, policy, limited)
+}
+
+// RateLimitKeyUserID keys requests by the user the JWT middleware
+// authenticated, so it must run after JwtHandler.
+func RateLimitKeyUserID(r *http.Request) string {
+	userID, _ := r.Context().Value(http_router.UserIDKey).(string)
+	return userID
+}
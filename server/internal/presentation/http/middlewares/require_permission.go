@@ -0,0 +1,38 @@
+package http_middlewares
+
+import (
+	"net/http"
+
+	"github.com/gate-keeper/internal/domain/errors"
+	"github.com/gate-keeper/internal/domain/services"
+	http_router "github.com/gate-keeper/internal/presentation/http"
+	"github.com/google/uuid"
+)
+
+// RequirePermission is a middleware that only lets the request through when
+// the access token was issued to the given application and carries the given
+// permission. Permission names are only unique within an application, so a
+// token issued to another application is rejected even if it carries a
+// permission of the same name. It must be mounted after JwtHandler, which
+// exposes the token's client and permissions on the request context.
+//
+//	r.With(http_middlewares.RequirePermission(ordersApplicationID, "orders:write")).Post("/", endpoint.Http)
+func RequirePermission(applicationID uuid.UUID, permission string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if applicationID == uuid.Nil || http_router.GetClientIDFromContext(ctx) != applicationID {
+				WriteJSONError(w, http.StatusForbidden, errors.ErrPermissionDenied.Title, errors.ErrPermissionDenied.Message, ctx)
+				return
+			}
+
+			if !services.HasPermission(http_router.GetPermissionsFromContext(ctx), permission) {
+				WriteJSONError(w, http.StatusForbidden, errors.ErrPermissionDenied.Title, errors.ErrPermissionDenied.Message, ctx)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package http_router
+
+import (
+	"mime"
+	"net/http"
+	"net/url"
+
+	"github.com/gate-keeper/internal/domain/errors"
+)
+
+// ClientCredentials are the credentials a confidential client presented.
+type ClientCredentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// ParseOAuthForm reads an application/x-www-form-urlencoded OAuth request and
+// resolves the client credentials from either client_secret_basic or
+// client_secret_post (RFC 6749 §2.3.1). Failures are returned as *errors.OAuthError.
+func ParseOAuthForm(request *http.Request) (url.Values, ClientCredentials, error) {
+	mediaType, _, _ := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	if mediaType != "application/x-www-form-urlencoded" {
+		return nil, ClientCredentials{}, errors.NewOAuthError(errors.OAuthInvalidRequest, "Content-Type must be application/x-www-form-urlencoded")
+	}
+
+	if err := request.ParseForm(); err != nil {
+		return nil, ClientCredentials{}, errors.NewOAuthError(errors.OAuthInvalidRequest, "Malformed request body")
+	}
+
+	form := request.PostForm
+	credentials := ClientCredentials{
+		ClientID:     form.Get("client_id"),
+		ClientSecret: form.Get("client_secret"),
+	}
+
+	username, password, ok := request.BasicAuth()
+	if !ok {
+		return form, credentials, nil
+	}
+
+	// Only one authentication method may be used per request.
+	if credentials.ClientSecret != "" {
+		return nil, ClientCredentials{}, errors.NewOAuthError(errors.OAuthInvalidRequest, "Multiple client authentication methods were used")
+	}
+
+	// Credentials are form-encoded before being placed in the header.
+	clientID, errID := url.QueryUnescape(username)
+	clientSecret, errSecret := url.QueryUnescape(password)
+	if errID != nil || errSecret != nil {
+		return nil, ClientCredentials{}, errors.NewOAuthError(errors.OAuthInvalidClient, "Malformed client credentials")
+	}
+
+	if credentials.ClientID != "" && credentials.ClientID != clientID {
+		return nil, ClientCredentials{}, errors.NewOAuthError(errors.OAuthInvalidRequest, "client_id does not match the authenticated client")
+	}
+
+	return form, ClientCredentials{ClientID: clientID, ClientSecret: clientSecret}, nil
+}
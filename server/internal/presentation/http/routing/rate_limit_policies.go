@@ -0,0 +1,41 @@
+package routing
+
+import (
+	"time"
+
+	"github.com/gate-keeper/internal/infra/ratelimit"
+	http_middlewares "github.com/gate-keeper/internal/presentation/http/middlewares"
+)
+
+func rateLimitPolicy(name string, requests int, window time.Duration, key ratelimit.KeyFunc) ratelimit.Policy {
+	return ratelimit.Policy{
+		Config: ratelimit.Config{Requests: requests, Window: window, KeyPrefix: "ratelimit:" + name},
+		Key:    key,
+	}
+}
+
+// Credential endpoints are limited both per IP and e-mail, against guessing
+// one user's password, and per IP, against spraying many accounts.
+var (
+	loginRateLimit          = rateLimitPolicy("login", 10, time.Minute, ratelimit.Keys(ratelimit.KeyIP, ratelimit.KeyEmail))
+	loginIPRateLimit        = rateLimitPolicy("login-ip", 50, time.Minute, ratelimit.KeyIP)
+	verifyMfaRateLimit      = rateLimitPolicy("verify-mfa", 10, 5*time.Minute, ratelimit.Keys(ratelimit.KeyIP, ratelimit.KeyEmail))
+	verifyMfaIPRateLimit    = rateLimitPolicy("verify-mfa-ip", 50, 5*time.Minute, ratelimit.KeyIP)
+	reauthenticateRateLimit = rateLimitPolicy("reauthenticate", 10, 15*time.Minute, http_middlewares.RateLimitKeyUserID)
+)
+
+// Endpoints that send e-mails or create accounts.
+var (
+	forgotPasswordRateLimit     = rateLimitPolicy("forgot-password", 5, 15*time.Minute, ratelimit.Keys(ratelimit.KeyIP, ratelimit.KeyEmail))
+	resendConfirmationRateLimit = rateLimitPolicy("resend-confirmation", 5, 15*time.Minute, ratelimit.Keys(ratelimit.KeyIP, ratelimit.KeyEmail))
+	resetPasswordRateLimit      = rateLimitPolicy("reset-password", 10, 15*time.Minute, ratelimit.KeyIP)
+	signUpRateLimit             = rateLimitPolicy("sign-up", 20, time.Hour, ratelimit.KeyIP)
+)
+
+// Machine-to-machine and authenticated endpoints, which legitimate clients
+// call often.
+var (
+	tokenRateLimit    = rateLimitPolicy("token", 120, time.Minute, ratelimit.Keys(ratelimit.KeyIP, ratelimit.KeyClientID))
+	userinfoRateLimit = rateLimitPolicy("userinfo", 300, time.Minute, ratelimit.KeyIP)
+	accountRateLimit  = rateLimitPolicy("account", 300, time.Minute, http_middlewares.RateLimitKeyUserID)
+)
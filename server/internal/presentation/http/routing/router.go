@@ -2,6 +2,7 @@ package routing
 
 import (
 	"net/http"
+	"os"
 
 	configureoauthprovider "github.com/gate-keeper/internal/features/handlers/application-oauth-provider/configure-oauth-provider"
 	getproviderdatabyid "github.com/gate-keeper/internal/features/handlers/application-oauth-provider/get-provider-data-by-id"
@@ -10,17 +11,30 @@ import (
 	githublogin "github.com/gate-keeper/internal/features/handlers/application-oauth-provider/github-login"
 	googlecallback "github.com/gate-keeper/internal/features/handlers/application-oauth-provider/google-callback"
 	googlelogin "github.com/gate-keeper/internal/features/handlers/application-oauth-provider/google-login"
+	createpermission "github.com/gate-keeper/internal/features/handlers/application-permission/create-permission"
+	deletepermission "github.com/gate-keeper/internal/features/handlers/application-permission/delete-permission"
+	listpermissions "github.com/gate-keeper/internal/features/handlers/application-permission/list-permissions"
+	updatepermission "github.com/gate-keeper/internal/features/handlers/application-permission/update-permission"
 	createrole "github.com/gate-keeper/internal/features/handlers/application-role/create-role"
 	deleterole "github.com/gate-keeper/internal/features/handlers/application-role/delete-role"
+	listrolepermissions "github.com/gate-keeper/internal/features/handlers/application-role/list-role-permissions"
 	listroles "github.com/gate-keeper/internal/features/handlers/application-role/list-roles"
+	setrolepermissions "github.com/gate-keeper/internal/features/handlers/application-role/set-role-permissions"
 	createsecret "github.com/gate-keeper/internal/features/handlers/application-secret/create-secret"
 	deletesecret "github.com/gate-keeper/internal/features/handlers/application-secret/delete-secret"
+	configureclientcredentials "github.com/gate-keeper/internal/features/handlers/application/configure-client-credentials"
 	createapplication "github.com/gate-keeper/internal/features/handlers/application/create-application"
 	getapplicationauthdata "github.com/gate-keeper/internal/features/handlers/application/get-application-auth-data"
 	getapplicationbyid "github.com/gate-keeper/internal/features/handlers/application/get-application-by-id"
+	getclientcredentials "github.com/gate-keeper/internal/features/handlers/application/get-client-credentials"
 	listapplications "github.com/gate-keeper/internal/features/handlers/application/list-applications"
 	removeapplication "github.com/gate-keeper/internal/features/handlers/application/remove-application"
+	removeclientcredentials "github.com/gate-keeper/internal/features/handlers/application/remove-client-credentials"
 	updateapplication "github.com/gate-keeper/internal/features/handlers/application/update-application"
+	exportauditlogs "github.com/gate-keeper/internal/features/handlers/audit-log/export-audit-logs"
+	listauditlogcheckpoints "github.com/gate-keeper/internal/features/handlers/audit-log/list-audit-log-checkpoints"
+	listauditlogs "github.com/gate-keeper/internal/features/handlers/audit-log/list-audit-logs"
+	verifyauditlogchain "github.com/gate-keeper/internal/features/handlers/audit-log/verify-audit-log-chain"
 	"github.com/gate-keeper/internal/features/handlers/authentication/authorize"
 	beginwebauthnregistration "github.com/gate-keeper/internal/features/handlers/authentication/begin-webauthn-registration"
 	changepassword "github.com/gate-keeper/internal/features/handlers/authentication/change-password"
@@ -28,6 +42,7 @@ import (
 	confirmuseremail "github.com/gate-keeper/internal/features/handlers/authentication/confirm-user-email"
 	forgotpassword "github.com/gate-keeper/internal/features/handlers/authentication/forgot-password"
 	generateauthappsecret "github.com/gate-keeper/internal/features/handlers/authentication/generate-auth-app-secret"
+	"github.com/gate-keeper/internal/features/handlers/authentication/jwks"
 	login "github.com/gate-keeper/internal/features/handlers/authentication/login"
 	oidcdiscovery "github.com/gate-keeper/internal/features/handlers/authentication/oidc-discovery"
 	resendemailconfirmation "github.com/gate-keeper/internal/features/handlers/authentication/resend-email-confirmation"
@@ -37,16 +52,32 @@ import (
 	signupcredential "github.com/gate-keeper/internal/features/handlers/authentication/sign-up-credential"
 	userinfo "github.com/gate-keeper/internal/features/handlers/authentication/userinfo"
 	verifyappmfa "github.com/gate-keeper/internal/features/handlers/authentication/verify-app-mfa"
+	verifybackupcodemfa "github.com/gate-keeper/internal/features/handlers/authentication/verify-backup-code-mfa"
 	verifyemailmfa "github.com/gate-keeper/internal/features/handlers/authentication/verify-email-mfa"
 	verifypasskeyauth "github.com/gate-keeper/internal/features/handlers/authentication/verify-passkey-authentication"
 	verifypasskeyregistration "github.com/gate-keeper/internal/features/handlers/authentication/verify-passkey-registration"
+	oauth2authorize "github.com/gate-keeper/internal/features/handlers/oauth2/authorize"
+	oauth2authorizecallback "github.com/gate-keeper/internal/features/handlers/oauth2/authorize-callback"
+	oauth2introspect "github.com/gate-keeper/internal/features/handlers/oauth2/introspect"
+	oauth2logout "github.com/gate-keeper/internal/features/handlers/oauth2/logout"
+	oauth2revoke "github.com/gate-keeper/internal/features/handlers/oauth2/revoke"
+	oauth2token "github.com/gate-keeper/internal/features/handlers/oauth2/token"
+	listsigningkeys "github.com/gate-keeper/internal/features/handlers/signing-key/list-signing-keys"
+	rotatesigningkeys "github.com/gate-keeper/internal/features/handlers/signing-key/rotate-signing-keys"
+	addtenantadmin "github.com/gate-keeper/internal/features/handlers/tenant-admin/add-tenant-admin"
+	listtenantadmins "github.com/gate-keeper/internal/features/handlers/tenant-admin/list-tenant-admins"
+	removetenantadmin "github.com/gate-keeper/internal/features/handlers/tenant-admin/remove-tenant-admin"
 	createtenantuser "github.com/gate-keeper/internal/features/handlers/tenant-user/create-tenant-user"
 	deletetenantuser "github.com/gate-keeper/internal/features/handlers/tenant-user/delete-tenant-user"
 	edittenantuser "github.com/gate-keeper/internal/features/handlers/tenant-user/edit-tenant-user"
+	exporttenantusers "github.com/gate-keeper/internal/features/handlers/tenant-user/export-tenant-users"
 	gettenantuser "github.com/gate-keeper/internal/features/handlers/tenant-user/get-tenant-user-by-id"
+	gettenantuserimport "github.com/gate-keeper/internal/features/handlers/tenant-user/get-tenant-user-import"
+	importtenantusers "github.com/gate-keeper/internal/features/handlers/tenant-user/import-tenant-users"
 	listtenantusers "github.com/gate-keeper/internal/features/handlers/tenant-user/list-tenant-users"
 	listusersessions "github.com/gate-keeper/internal/features/handlers/tenant-user/list-user-sessions"
 	revokeusersession "github.com/gate-keeper/internal/features/handlers/tenant-user/revoke-user-session"
+	unlocktenantuser "github.com/gate-keeper/internal/features/handlers/tenant-user/unlock-tenant-user"
 
 	accountchangepassword "github.com/gate-keeper/internal/features/handlers/account/change-password"
 	accountconfirmemailchange "github.com/gate-keeper/internal/features/handlers/account/confirm-email-change"
@@ -55,6 +86,7 @@ import (
 	accountgeneratebackupcodes "github.com/gate-keeper/internal/features/handlers/account/generate-backup-codes"
 	accountgetlastmfatotpsecret "github.com/gate-keeper/internal/features/handlers/account/get-last-mfa-totp-secret-validation-by-user"
 	accountlistmfamethods "github.com/gate-keeper/internal/features/handlers/account/list-mfa-methods"
+	accountlistrecentactivity "github.com/gate-keeper/internal/features/handlers/account/list-recent-activity"
 	accountlistsessions "github.com/gate-keeper/internal/features/handlers/account/list-sessions"
 	accountme "github.com/gate-keeper/internal/features/handlers/account/me"
 	"github.com/gate-keeper/internal/features/handlers/account/reauthenticate"
@@ -70,6 +102,7 @@ import (
 	gettenantbyid "github.com/gate-keeper/internal/features/handlers/tenant/get-tenant-by-id"
 	listtenants "github.com/gate-keeper/internal/features/handlers/tenant/list-tenants"
 	removetenant "github.com/gate-keeper/internal/features/handlers/tenant/remove-tenant"
+	"github.com/gate-keeper/internal/infra/ratelimit"
 	http_middlewares "github.com/gate-keeper/internal/presentation/http/middlewares"
 
 	"github.com/go-chi/chi"
@@ -79,7 +112,7 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
-func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
+func SetHttpRoutes(pool *pgxpool.Pool, rateLimitStore ratelimit.Store) http.Handler {
 	listApplicationsEndpoint := listapplications.Endpoint{DbPool: pool}
 	updateApplicationEndpoint := updateapplication.Endpoint{DbPool: pool}
 	removeApplicationEndpoint := removeapplication.Endpoint{DbPool: pool}
@@ -87,6 +120,10 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 	getApplicationByIdEndpoint := getapplicationbyid.Endpoint{DbPool: pool}
 	getApplicationAuthDataEndpoint := getapplicationauthdata.Endpoint{DbPool: pool}
 
+	getClientCredentialsEndpoint := getclientcredentials.Endpoint{DbPool: pool}
+	configureClientCredentialsEndpoint := configureclientcredentials.Endpoint{DbPool: pool}
+	removeClientCredentialsEndpoint := removeclientcredentials.Endpoint{DbPool: pool}
+
 	configureOauthProviderEndPoint := configureoauthprovider.Endpoint{DbPool: pool}
 	getProviderDataByIDEndpoint := getproviderdatabyid.Endpoint{DbPool: pool}
 	getProvidersDataByApplicationIDEndpoint := getprovidersdatabyapplicationid.Endpoint{DbPool: pool}
@@ -94,6 +131,13 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 	listRolesEndpoint := listroles.Endpoint{DbPool: pool}
 	createRoleEndpoint := createrole.Endpoint{DbPool: pool}
 	deleteRoleEndpoint := deleterole.Endpoint{DbPool: pool}
+	listRolePermissionsEndpoint := listrolepermissions.Endpoint{DbPool: pool}
+	setRolePermissionsEndpoint := setrolepermissions.Endpoint{DbPool: pool}
+
+	listPermissionsEndpoint := listpermissions.Endpoint{DbPool: pool}
+	createPermissionEndpoint := createpermission.Endpoint{DbPool: pool}
+	updatePermissionEndpoint := updatepermission.Endpoint{DbPool: pool}
+	deletePermissionEndpoint := deletepermission.Endpoint{DbPool: pool}
 
 	createEndpoint := createsecret.Endpoint{DbPool: pool}
 	deleteSecretEndpoint := deletesecret.Endpoint{DbPool: pool}
@@ -104,6 +148,13 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 	removeTenantEndpoint := removetenant.Endpoint{DbPool: pool}
 	editTenantEndpoint := edittenant.Endpoint{DbPool: pool}
 
+	listTenantAdminsEndpoint := listtenantadmins.Endpoint{DbPool: pool}
+	addTenantAdminEndpoint := addtenantadmin.Endpoint{DbPool: pool}
+	removeTenantAdminEndpoint := removetenantadmin.Endpoint{DbPool: pool}
+
+	listSigningKeysEndpoint := listsigningkeys.Endpoint{DbPool: pool}
+	rotateSigningKeysEndpoint := rotatesigningkeys.Endpoint{DbPool: pool}
+
 	createTenantUserEndpoint := createtenantuser.Endpoint{DbPool: pool}
 	updateTenantUserEndpoint := edittenantuser.Endpoint{DbPool: pool}
 	deleteTenantUserEndpoint := deletetenantuser.Endpoint{DbPool: pool}
@@ -111,6 +162,15 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 	listTenantUsersEndpoint := listtenantusers.Endpoint{DbPool: pool}
 	listUserSessionsEndpoint := listusersessions.Endpoint{DbPool: pool}
 	revokeUserSessionEndpoint := revokeusersession.Endpoint{DbPool: pool}
+	unlockTenantUserEndpoint := unlocktenantuser.Endpoint{DbPool: pool}
+	importTenantUsersEndpoint := importtenantusers.Endpoint{DbPool: pool}
+	getTenantUserImportEndpoint := gettenantuserimport.Endpoint{DbPool: pool}
+	exportTenantUsersEndpoint := exporttenantusers.Endpoint{DbPool: pool}
+
+	listAuditLogsEndpoint := listauditlogs.Endpoint{DbPool: pool}
+	exportAuditLogsEndpoint := exportauditlogs.Endpoint{DbPool: pool}
+	verifyAuditLogChainEndpoint := verifyauditlogchain.Endpoint{DbPool: pool}
+	listAuditLogCheckpointsEndpoint := listauditlogcheckpoints.Endpoint{DbPool: pool}
 
 	authorizeEndpoint := authorize.Endpoint{DbPool: pool}
 	changePasswordEndpoint := changepassword.Endpoint{DbPool: pool}
@@ -125,6 +185,7 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 	signUpCredentialEndpoint := signupcredential.Endpoint{DbPool: pool}
 	verfifyEmailMfaEndpoint := verifyemailmfa.Endpoint{DbPool: pool}
 	verfifyAppMfaEndpoint := verifyappmfa.Endpoint{DbPool: pool}
+	verifyBackupCodeMfaEndpoint := verifybackupcodemfa.Endpoint{DbPool: pool}
 	confirmMfaAuthAppSecretEndpoint := confirmmfaauthappsecret.Endpoint{DbPool: pool}
 	beginWebAuthnRegistrationEndpoint := beginwebauthnregistration.Endpoint{DbPool: pool}
 	verifypasskeynRegistrationEndpoint := verifypasskeyregistration.Endpoint{DbPool: pool}
@@ -137,7 +198,14 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 	googleCallbackEndpoint := googlecallback.Endpoint{DbPool: pool}
 
 	oidcDiscoveryEndpoint := oidcdiscovery.Endpoint{}
+	jwksEndpoint := jwks.Endpoint{}
 	userinfoEndpoint := userinfo.Endpoint{DbPool: pool}
+	oauth2AuthorizeEndpoint := oauth2authorize.Endpoint{DbPool: pool}
+	oauth2AuthorizeCallbackEndpoint := oauth2authorizecallback.Endpoint{DbPool: pool}
+	oauth2TokenEndpoint := oauth2token.Endpoint{DbPool: pool}
+	oauth2IntrospectEndpoint := oauth2introspect.Endpoint{DbPool: pool}
+	oauth2RevokeEndpoint := oauth2revoke.Endpoint{DbPool: pool}
+	oauth2LogoutEndpoint := oauth2logout.Endpoint{DbPool: pool}
 
 	// Account (Self-Service Portal)
 	reauthenticateEndpoint := reauthenticate.Endpoint{DbPool: pool}
@@ -156,6 +224,16 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 	accountMeEndpoint := accountme.Endpoint{DbPool: pool}
 	accountUpdateProfileEndpoint := accountupdateprofile.Endpoint{DbPool: pool}
 	accountRefreshTokenEndpoint := accountrefreshtoken.Endpoint{DbPool: pool}
+	accountListRecentActivityEndpoint := accountlistrecentactivity.Endpoint{DbPool: pool}
+
+	rateLimit := func(policies ...ratelimit.Policy) func(http.Handler) http.Handler {
+		return func(next http.Handler) http.Handler {
+			for i := len(policies) - 1; i >= 0; i-- {
+				next = http_middlewares.RateLimitHandler(rateLimitStore, policies[i])(next)
+			}
+			return next
+		}
+	}
 
 	r := chi.NewRouter()
 
@@ -169,7 +247,7 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
 		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Step-Up-Token"},
-		ExposedHeaders:   []string{"Link"},
+		ExposedHeaders:   []string{"Link", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", "Retry-After"},
 		AllowCredentials: false,
 		MaxAge:           300, // 5 minutes
 	}))
@@ -185,6 +263,18 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 
 	// OIDC Discovery document
 	r.Get("/.well-known/openid-configuration", oidcDiscoveryEndpoint.Http)
+	r.Get("/.well-known/jwks.json", jwksEndpoint.Http)
+
+	// OAuth 2.0 endpoints (RFC 6749)
+	r.Route("/oauth2", func(r chi.Router) {
+		r.Get("/authorize", oauth2AuthorizeEndpoint.Http)
+		r.Post("/authorize/callback", oauth2AuthorizeCallbackEndpoint.Http)
+		r.With(rateLimit(tokenRateLimit)).Post("/token", oauth2TokenEndpoint.Http)
+		r.With(rateLimit(tokenRateLimit)).Post("/introspect", oauth2IntrospectEndpoint.Http)
+		r.With(rateLimit(tokenRateLimit)).Post("/revoke", oauth2RevokeEndpoint.Http)
+		r.Get("/logout", oauth2LogoutEndpoint.Http)
+		r.Post("/logout", oauth2LogoutEndpoint.Http)
+	})
 
 	// Routes v1
 	r.Route("/v1", func(r chi.Router) {
@@ -195,19 +285,25 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 			})
 
 			r.Post("/authorize", authorizeEndpoint.Http)
-			r.Post("/sign-in", signInCredentialEndpoint.Http)
-			r.Get("/userinfo", userinfoEndpoint.Http)
-			r.Post("/login", loginEndpoint.Http)
+			r.With(rateLimit(tokenRateLimit)).Post("/sign-in", signInCredentialEndpoint.Http)
+			r.With(rateLimit(userinfoRateLimit)).Get("/userinfo", userinfoEndpoint.Http)
+			r.With(rateLimit(loginIPRateLimit, loginRateLimit)).Post("/login", loginEndpoint.Http)
 			r.Post("/generate-auth-secret", generateAuthAppSecretEndpoint.Http)
-			r.Post("/verify-mfa/email", verfifyEmailMfaEndpoint.Http)
-			r.Post("/verify-mfa/app", verfifyAppMfaEndpoint.Http)
-			r.Post("/verify-mfa/webauthn", verifypasskeynAuthEndpoint.Http)
-			r.Post("/sign-up", signUpCredentialEndpoint.Http)
+
+			r.Group(func(r chi.Router) {
+				r.Use(rateLimit(verifyMfaIPRateLimit, verifyMfaRateLimit))
+				r.Post("/verify-mfa/email", verfifyEmailMfaEndpoint.Http)
+				r.Post("/verify-mfa/app", verfifyAppMfaEndpoint.Http)
+				r.Post("/verify-mfa/webauthn", verifypasskeynAuthEndpoint.Http)
+				r.Post("/verify-mfa/backup-code", verifyBackupCodeMfaEndpoint.Http)
+			})
+
+			r.With(rateLimit(signUpRateLimit)).Post("/sign-up", signUpCredentialEndpoint.Http)
 			r.Post("/confirm-email", confirmUserEmailEndpoint.Http)
-			r.Post("/reset-password", resetRepositoryEndpoint.Http)
-			r.Post("/change-password", changePasswordEndpoint.Http)
-			r.Post("/forgot-password", forgotPasswordEndpoint.Http)
-			r.Post("/confirm-email/resend", resendEmailConfirmationEndpoint.Http)
+			r.With(rateLimit(resetPasswordRateLimit)).Post("/reset-password", resetRepositoryEndpoint.Http)
+			r.With(rateLimit(resetPasswordRateLimit)).Post("/change-password", changePasswordEndpoint.Http)
+			r.With(rateLimit(forgotPasswordRateLimit)).Post("/forgot-password", forgotPasswordEndpoint.Http)
+			r.With(rateLimit(resendConfirmationRateLimit)).Post("/confirm-email/resend", resendEmailConfirmationEndpoint.Http)
 			r.Post("/confirm-mfa-auth-app-secret", confirmMfaAuthAppSecretEndpoint.Http)
 
 			r.Route("/webauthn", func(r chi.Router) {
@@ -230,6 +326,7 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 
 		r.Route("/account", func(r chi.Router) {
 			r.Use(http_middlewares.JwtHandler)
+			r.Use(rateLimit(accountRateLimit))
 
 			// Get current user profile
 			r.Get("/me", accountMeEndpoint.Http)
@@ -238,7 +335,7 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 			r.Put("/profile", accountUpdateProfileEndpoint.Http)
 
 			// Reauthenticate — issues a step-up token
-			r.Post("/reauthenticate", reauthenticateEndpoint.Http)
+			r.With(rateLimit(reauthenticateRateLimit)).Post("/reauthenticate", reauthenticateEndpoint.Http)
 
 			// Change password — requires step-up
 			r.Route("/change-password", func(r chi.Router) {
@@ -258,6 +355,9 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 				})
 			})
 
+			// Recent security activity
+			r.Get("/activity", accountListRecentActivityEndpoint.Http)
+
 			// Session management
 			r.Route("/sessions", func(r chi.Router) {
 				r.Group(func(r chi.Router) {
@@ -293,48 +393,101 @@ func SetHttpRoutes(pool *pgxpool.Pool) http.Handler {
 		r.Post("/account/email/confirm", accountConfirmEmailChangeEndpoint.Http)
 
 		r.Route("/tenants", func(r chi.Router) {
-			// r.Use(http_middlewares.JwtHandler)
+			r.Use(http_middlewares.JwtHandler)
+			r.Use(http_middlewares.DashboardTokenHandler(os.Getenv("DASHBOARD_CLIENT_ID")))
 
+			// Tenant administrators only get the tenants they administer
 			r.Get("/", listTenantsEndpoint.Http)
-			r.Post("/", createTenantEndpoint.Http)
+			r.With(http_middlewares.PlatformAdminHandler(pool)).Post("/", createTenantEndpoint.Http)
 
 			r.Route("/{tenantID}", func(r chi.Router) {
+				r.Use(http_middlewares.TenantAdminHandler(pool))
+
 				r.Get("/", getTenantByIdEndpoint.Http)
-				r.Delete("/", removeTenantEndpoint.Http)
+				r.With(http_middlewares.PlatformAdminHandler(pool)).Delete("/", removeTenantEndpoint.Http)
 				r.Put("/", editTenantEndpoint.Http)
 
+				r.Route("/admins", func(r chi.Router) {
+					r.Get("/", listTenantAdminsEndpoint.Http)
+					r.Post("/", addTenantAdminEndpoint.Http)
+					r.Delete("/{userID}", removeTenantAdminEndpoint.Http)
+				})
+
+				r.Route("/audit-logs", func(r chi.Router) {
+					r.Get("/", listAuditLogsEndpoint.Http)
+					r.Get("/export", exportAuditLogsEndpoint.Http)
+					r.Get("/verify", verifyAuditLogChainEndpoint.Http)
+					r.Get("/checkpoints", listAuditLogCheckpointsEndpoint.Http)
+				})
+
+				r.Route("/signing-keys", func(r chi.Router) {
+					r.Get("/", listSigningKeysEndpoint.Http)
+					r.Post("/rotate", rotateSigningKeysEndpoint.Http)
+				})
+
 				r.Route("/users", func(r chi.Router) {
 					r.Get("/", listTenantUsersEndpoint.Http)
 					r.Post("/", createTenantUserEndpoint.Http)
-					r.Put("/{userID}", updateTenantUserEndpoint.Http)
-					r.Get("/{userID}", getTenantUserByIdEndpoint.Http)
-					r.Delete("/{userID}", deleteTenantUserEndpoint.Http)
 
-					r.Get("/{userID}/sessions", listUserSessionsEndpoint.Http)
-					r.Delete("/{userID}/sessions/{sessionID}", revokeUserSessionEndpoint.Http)
+					r.Get("/export", exportTenantUsersEndpoint.Http)
+					r.Post("/imports", importTenantUsersEndpoint.Http)
+					r.Get("/imports/{importID}", getTenantUserImportEndpoint.Http)
+
+					r.Route("/{userID}", func(r chi.Router) {
+						r.Use(http_middlewares.TenantUserScopeHandler(pool))
+
+						r.Put("/", updateTenantUserEndpoint.Http)
+						r.Get("/", getTenantUserByIdEndpoint.Http)
+						r.Delete("/", deleteTenantUserEndpoint.Http)
+
+						r.Get("/sessions", listUserSessionsEndpoint.Http)
+						r.Delete("/sessions/{sessionID}", revokeUserSessionEndpoint.Http)
+
+						r.Post("/unlock", unlockTenantUserEndpoint.Http)
+					})
 				})
 
 				r.Route("/applications", func(r chi.Router) {
 					r.Get("/", listApplicationsEndpoint.Http)
 					r.Post("/", createApplicationEndpoint.Http)
-					r.Put("/{applicationID}", updateApplicationEndpoint.Http)
-					r.Get("/{applicationID}", getApplicationByIdEndpoint.Http)
-					r.Delete("/{applicationID}", removeApplicationEndpoint.Http)
-
-					r.Route("/{applicationID}/roles", func(r chi.Router) {
-						r.Get("/", listRolesEndpoint.Http)
-						r.Post("/", createRoleEndpoint.Http)
-						r.Delete("/{roleID}", deleteRoleEndpoint.Http)
-					})
-
-					r.Route("/{applicationID}/secrets", func(r chi.Router) {
-						r.Post("/", createEndpoint.Http)
-						r.Delete("/{secretID}", deleteSecretEndpoint.Http)
-					})
 
-					r.Route("/{applicationID}/oauth-provider", func(r chi.Router) {
-						r.Get("/", getProvidersDataByApplicationIDEndpoint.Http)
-						r.Put("/", configureOauthProviderEndPoint.Http)
+					r.Route("/{applicationID}", func(r chi.Router) {
+						r.Use(http_middlewares.ApplicationScopeHandler(pool))
+
+						r.Put("/", updateApplicationEndpoint.Http)
+						r.Get("/", getApplicationByIdEndpoint.Http)
+						r.Delete("/", removeApplicationEndpoint.Http)
+
+						r.Route("/roles", func(r chi.Router) {
+							r.Get("/", listRolesEndpoint.Http)
+							r.Post("/", createRoleEndpoint.Http)
+							r.Delete("/{roleID}", deleteRoleEndpoint.Http)
+							r.Get("/{roleID}/permissions", listRolePermissionsEndpoint.Http)
+							r.Put("/{roleID}/permissions", setRolePermissionsEndpoint.Http)
+						})
+
+						r.Route("/permissions", func(r chi.Router) {
+							r.Get("/", listPermissionsEndpoint.Http)
+							r.Post("/", createPermissionEndpoint.Http)
+							r.Put("/{permissionID}", updatePermissionEndpoint.Http)
+							r.Delete("/{permissionID}", deletePermissionEndpoint.Http)
+						})
+
+						r.Route("/secrets", func(r chi.Router) {
+							r.Post("/", createEndpoint.Http)
+							r.Delete("/{secretID}", deleteSecretEndpoint.Http)
+						})
+
+						r.Route("/client-credentials", func(r chi.Router) {
+							r.Get("/", getClientCredentialsEndpoint.Http)
+							r.Put("/", configureClientCredentialsEndpoint.Http)
+							r.Delete("/", removeClientCredentialsEndpoint.Http)
+						})
+
+						r.Route("/oauth-provider", func(r chi.Router) {
+							r.Get("/", getProvidersDataByApplicationIDEndpoint.Http)
+							r.Put("/", configureOauthProviderEndPoint.Http)
+						})
 					})
 				})
 			})